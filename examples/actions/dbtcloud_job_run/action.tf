@@ -0,0 +1,37 @@
+// run the seed job right after the environment is created and wait for the
+// run to succeed (requires Terraform >= 1.14)
+resource "dbtcloud_environment" "prod_environment" {
+  dbt_version     = "latest"
+  name            = "Prod"
+  project_id      = dbtcloud_project.dbt_project.id
+  type            = "deployment"
+  credential_id   = dbtcloud_snowflake_credential.prod_credential.credential_id
+  deployment_type = "production"
+  connection_id   = dbtcloud_global_connection.my_global_connection.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.dbtcloud_job_run.seed_prod]
+    }
+  }
+}
+
+action "dbtcloud_job_run" "seed_prod" {
+  config {
+    job_id          = dbtcloud_job.seed_job.id
+    cause           = "Seeding the new production environment"
+    timeout_seconds = 1800
+  }
+}
+
+// the action can also be invoked on demand with
+// terraform apply -invoke=action.dbtcloud_job_run.full_refresh
+action "dbtcloud_job_run" "full_refresh" {
+  config {
+    job_id              = dbtcloud_job.daily_job.id
+    git_branch          = "main"
+    schema_override     = "dbt_full_refresh"
+    wait_for_completion = false
+  }
+}
@@ -0,0 +1,28 @@
+data "dbtcloud_effective_permissions" "contractor" {
+  user_id = dbtcloud_user_invite.contractor.user_id
+}
+
+data "dbtcloud_effective_permissions" "ci_token" {
+  service_token_id = dbtcloud_service_token.ci_token.id
+}
+
+// contractors must not get any permission on all projects
+check "contractor_least_privilege" {
+  assert {
+    condition = alltrue([
+      for project in data.dbtcloud_effective_permissions.contractor.projects : !project.all_projects
+    ])
+    error_message = "The contractor has permissions on all projects."
+  }
+}
+
+// the CI token must not be able to write to production environments
+check "ci_token_no_production" {
+  assert {
+    condition = alltrue([
+      for project in data.dbtcloud_effective_permissions.ci_token.projects :
+      length(setintersection(project.writable_environment_categories, ["all", "production"])) == 0
+    ])
+    error_message = "The CI service token can write to production environments."
+  }
+}
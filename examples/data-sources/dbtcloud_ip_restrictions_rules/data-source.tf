@@ -0,0 +1,10 @@
+data "dbtcloud_ip_restrictions_rules" "all" {}
+
+# all the CIDR ranges allowed, including the ones of the rules not managed by Terraform
+output "allowed_cidrs" {
+  value = flatten([
+    for rule in data.dbtcloud_ip_restrictions_rules.all.rules : [
+      for cidr in rule.cidrs : coalesce(cidr.cidr, cidr.cidr_ipv6)
+    ] if rule.type == "allow"
+  ])
+}
@@ -0,0 +1,17 @@
+// fail the plan when job completion triggers form a loop
+data "dbtcloud_job_graph" "account" {
+  fail_on_cycle = true
+}
+
+// the jobs in the order they trigger each other
+output "job_chain" {
+  value = data.dbtcloud_job_graph.account.topological_order
+}
+
+// the jobs triggered when the daily job completes
+output "daily_job_downstream" {
+  value = [
+    for edge in data.dbtcloud_job_graph.account.edges : edge.downstream_job_id
+    if edge.upstream_job_id == dbtcloud_job.daily_job.id
+  ]
+}
@@ -0,0 +1,25 @@
+// preview what the steps of the production job would select before applying a change
+data "dbtcloud_job_plan_check" "prod_job" {
+  environment_id           = dbtcloud_environment.prod_environment.environment_id
+  execute_steps            = dbtcloud_job.prod_job.execute_steps
+  deferring_environment_id = dbtcloud_job.prod_job.deferring_environment_id
+  // fail the plan when a selector matches no node, e.g. a typo in --select
+  fail_on_unmatched = true
+}
+
+output "prod_job_selected_nodes" {
+  value = { for step in data.dbtcloud_job_plan_check.prod_job.steps : step.step => step.selected_nodes }
+}
+
+// check the steps before they are set on the job
+locals {
+  nightly_steps = [
+    "dbt source freshness",
+    "dbt build --select tag:nightly+ --exclude config.materialized:view",
+  ]
+}
+
+data "dbtcloud_job_plan_check" "nightly" {
+  environment_id = dbtcloud_environment.prod_environment.environment_id
+  execute_steps  = local.nightly_steps
+}
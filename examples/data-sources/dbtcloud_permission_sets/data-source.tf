@@ -0,0 +1,25 @@
+data "dbtcloud_permission_sets" "all" {
+}
+
+// the permission sets that can be restricted to some environment categories
+locals {
+  environment_level_permission_sets = [
+    for permission_set in data.dbtcloud_permission_sets.all.permission_sets :
+    permission_set.name if permission_set.supports_writable_environment_categories
+  ]
+}
+
+// the project level permission sets available without an Enterprise plan
+data "dbtcloud_permission_sets" "team_projects" {
+  project_scoped = true
+  enterprise     = false
+}
+
+variable "project_permission_set" {
+  type = string
+
+  validation {
+    condition     = contains(data.dbtcloud_permission_sets.team_projects.names, var.project_permission_set)
+    error_message = "The permission set must be a project level one available on Team plans."
+  }
+}
@@ -0,0 +1,31 @@
+// retrieve the dbt version used by the latest successful run of the production job
+data "dbtcloud_run_artifact" "prod_manifest_version" {
+  job_id    = dbtcloud_job.prod_job.id
+  path      = "manifest.json"
+  json_path = "$.metadata.dbt_version"
+}
+
+output "prod_dbt_version" {
+  value = jsondecode(data.dbtcloud_run_artifact.prod_manifest_version.result)
+}
+
+// retrieve the status of each node executed by the second step of a given run
+data "dbtcloud_run_artifact" "run_results" {
+  run_id    = 123456
+  step      = 2
+  path      = "run_results.json"
+  json_path = "$.results[*].status"
+}
+
+// retrieve the materialization of a model, keys containing dots need to use the bracket notation
+data "dbtcloud_run_artifact" "orders_materialization" {
+  job_id    = dbtcloud_job.prod_job.id
+  path      = "manifest.json"
+  json_path = "$.nodes[\"model.analytics.orders\"].config.materialized"
+}
+
+// download the whole artifact
+data "dbtcloud_run_artifact" "sources" {
+  run_id = 123456
+  path   = "sources.json"
+}
@@ -0,0 +1,30 @@
+// retrieve the last successful run of the production job
+data "dbtcloud_runs" "last_prod_success" {
+  filter = {
+    job_definition_id = dbtcloud_job.prod_job.id
+    status            = 10
+    order_by          = "-finished_at"
+    limit             = 1
+  }
+}
+
+// warn when the production job has not succeeded in the last 24 hours
+check "prod_freshness" {
+  assert {
+    condition = length(data.dbtcloud_runs.last_prod_success.runs) == 1 && timecmp(
+      one(data.dbtcloud_runs.last_prod_success.runs).finished_at,
+      timeadd(plantimestamp(), "-24h"),
+    ) > 0
+    error_message = "The production job has not run successfully in the last 24 hours"
+  }
+}
+
+// retrieve the last 10 runs of an environment with the details of their steps
+data "dbtcloud_runs" "recent" {
+  filter = {
+    environment_id = dbtcloud_environment.prod_environment.environment_id
+    order_by       = "-id"
+    limit          = 10
+  }
+  include_run_steps = true
+}
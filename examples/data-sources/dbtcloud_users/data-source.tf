@@ -8,3 +8,14 @@ locals {
   user_details = [for user in data.dbtcloud_users.all.users : user if user.email == "example@amail.com"]
   user_exist   = length(local.user_details) == 1
 }
+
+// the developers who didn't log in for 90 days, to review their licenses
+data "dbtcloud_users" "idle_developers" {
+  license_type           = "developer"
+  not_logged_in_for_days = 90
+}
+
+// the users getting account admin rights from their groups
+data "dbtcloud_users" "account_admins" {
+  permission_set = "account_admin"
+}
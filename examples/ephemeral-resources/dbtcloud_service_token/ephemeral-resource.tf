@@ -0,0 +1,19 @@
+// the token is created when Terraform opens the ephemeral resource and is
+// deleted at the end of the run, its secret is never stored in the state
+ephemeral "dbtcloud_service_token" "run_scoped" {
+  name = "Terraform run - job runner"
+
+  service_token_permissions {
+    permission_set = "job_runner"
+    all_projects   = false
+    project_id     = dbtcloud_project.dbt_project.id
+  }
+}
+
+// the token can then be used to configure other providers for the duration of the run
+provider "dbtcloud" {
+  alias      = "job_runner"
+  account_id = var.dbt_account_id
+  token      = ephemeral.dbtcloud_service_token.run_scoped.token_string
+  host_url   = "https://cloud.getdbt.com/api"
+}
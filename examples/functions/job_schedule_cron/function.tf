@@ -0,0 +1,15 @@
+// returns "0 6,18 * * 1,5"
+output "job_cron" {
+  value = provider::dbtcloud::job_schedule_cron(
+    dbtcloud_job.daily.schedule_type,
+    dbtcloud_job.daily.schedule_interval,
+    dbtcloud_job.daily.schedule_hours,
+    dbtcloud_job.daily.schedule_days,
+    dbtcloud_job.daily.schedule_cron,
+  )
+}
+
+// arguments not used by the schedule type can be set to null
+output "every_4_hours" {
+  value = provider::dbtcloud::job_schedule_cron("every_day", 4, null, null, null)
+}
@@ -0,0 +1,6 @@
+// generate import blocks for existing environment variables
+import {
+  for_each = toset(["DBT_MY_VAR", "DBT_OTHER_VAR"])
+  to       = dbtcloud_environment_variable.imported[each.key]
+  id       = provider::dbtcloud::join_id(var.project_id, each.key)
+}
@@ -0,0 +1,22 @@
+locals {
+  // returns { schedule_type = "days_of_week", schedule_interval = 2, schedule_days = [1, 2, 3, 4, 5], schedule_hours = null, schedule_cron = null }
+  schedule = provider::dbtcloud::parse_job_schedule("0 */2 * * 1-5")
+}
+
+resource "dbtcloud_job" "weekdays" {
+  environment_id = dbtcloud_environment.prod.environment_id
+  execute_steps  = ["dbt build"]
+  name           = "Weekdays job"
+  project_id     = dbtcloud_project.dbt_project.id
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : true,
+    "on_merge" : false
+  }
+  schedule_type     = local.schedule.schedule_type
+  schedule_interval = local.schedule.schedule_interval
+  schedule_hours    = local.schedule.schedule_hours
+  schedule_days     = local.schedule.schedule_days
+  schedule_cron     = local.schedule.schedule_cron
+}
@@ -0,0 +1,8 @@
+// returns ["123", "DBT_MY_VAR"]
+output "env_var_id_parts" {
+  value = provider::dbtcloud::split_id(dbtcloud_environment_variable.my_var.id)
+}
+
+locals {
+  env_var_name = provider::dbtcloud::split_id(dbtcloud_environment_variable.my_var.id)[1]
+}
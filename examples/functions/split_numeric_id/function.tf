@@ -0,0 +1,4 @@
+// returns [123, 456] for the ID of an environment, which is <project_id>:<environment_id>
+output "environment_id_parts" {
+  value = provider::dbtcloud::split_numeric_id(dbtcloud_environment.prod.id)
+}
@@ -0,0 +1,11 @@
+list "dbtcloud_environment" "all" {
+  provider = dbtcloud
+}
+
+list "dbtcloud_environment" "project_environments" {
+  provider = dbtcloud
+
+  config {
+    project_id = 12345
+  }
+}
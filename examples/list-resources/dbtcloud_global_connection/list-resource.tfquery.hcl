@@ -0,0 +1,3 @@
+list "dbtcloud_global_connection" "all" {
+  provider = dbtcloud
+}
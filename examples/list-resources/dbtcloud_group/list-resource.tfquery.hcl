@@ -0,0 +1,4 @@
+list "dbtcloud_group" "all" {
+  provider         = dbtcloud
+  include_resource = true
+}
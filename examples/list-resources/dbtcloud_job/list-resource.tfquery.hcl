@@ -0,0 +1,19 @@
+# list all the jobs of a project, `terraform query` can then generate the
+# matching resource and import blocks with -generate-config-out
+list "dbtcloud_job" "project_jobs" {
+  provider = dbtcloud
+
+  config {
+    project_id = 12345
+  }
+}
+
+# include the full job configuration in the results
+list "dbtcloud_job" "prod_jobs" {
+  provider         = dbtcloud
+  include_resource = true
+
+  config {
+    environment_id = 6789
+  }
+}
@@ -0,0 +1,7 @@
+list "dbtcloud_project" "analytics" {
+  provider = dbtcloud
+
+  config {
+    name_contains = "analytics"
+  }
+}
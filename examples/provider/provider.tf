@@ -17,4 +17,5 @@ provider "dbtcloud" {
   disable_retry = false
   skip_credentials_validation = false
   retriable_status_codes = ["429", "500", "502", "503", "504"]
+  max_requests_per_second = 5
 }
@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_connection_ssh_tunnel.my_ssh_tunnel
+  id = "ssh_tunnel_id"
+}
+
+import {
+  to = dbtcloud_connection_ssh_tunnel.my_ssh_tunnel
+  id = "12345"
+}
+
+# using the older import command
+terraform import dbtcloud_connection_ssh_tunnel.my_ssh_tunnel "ssh_tunnel_id"
+terraform import dbtcloud_connection_ssh_tunnel.my_ssh_tunnel 12345
@@ -0,0 +1,26 @@
+resource "dbtcloud_global_connection" "postgres" {
+  name = "Postgres behind a bastion host"
+
+  postgres = {
+    hostname = "postgres.internal.example.com"
+    port     = 5432
+    dbname   = "analytics"
+    // ssh_tunnel is not set as it is managed with dbtcloud_connection_ssh_tunnel
+  }
+}
+
+resource "dbtcloud_connection_ssh_tunnel" "postgres" {
+  connection_id = dbtcloud_global_connection.postgres.id
+  hostname      = "bastion.example.com"
+  port          = 22
+  username      = "dbt_cloud"
+
+  // change the value to rotate the key pair generated by dbt Cloud
+  key_rotation_trigger = "2026-10"
+}
+
+// the public key can then be authorized on the bastion host, e.g. by a module
+// managing the bastion
+output "dbt_cloud_ssh_public_key" {
+  value = dbtcloud_connection_ssh_tunnel.postgres.public_key
+}
@@ -9,6 +9,15 @@ import {
   id = "12345:6789"
 }
 
+# using an identity in import blocks (requires Terraform >= 1.12)
+import {
+  to = dbtcloud_environment.prod_environment
+  identity = {
+    project_id     = 12345
+    environment_id = 6789
+  }
+}
+
 # using the older import command
 terraform import dbtcloud_environment.prod_environment "project_id:environment_id"
 terraform import dbtcloud_environment.prod_environment 12345:6789
@@ -13,4 +13,24 @@ resource "dbtcloud_environment_variable" "dbt_my_env_var" {
     dbtcloud_environment.ci_env,
     dbtcloud_environment.prod_env,
   ]
-}
\ No newline at end of file
+}
+// Using write-only values for a secret (not stored in state, requires Terraform >= 1.11)
+//
+// The environment_values_wo values are never persisted in the Terraform state file.
+// Use environment_values_wo_version to trigger an update when the secret changes.
+variable "dbt_api_key" {
+  type      = string
+  ephemeral = true
+}
+
+resource "dbtcloud_environment_variable" "dbt_my_secret_env_var" {
+  name       = "DBT_ENV_SECRET_API_KEY"
+  project_id = dbtcloud_project.dbt_project.id
+  environment_values_wo = {
+    "project" : var.dbt_api_key,
+  }
+  environment_values_wo_version = 1
+  depends_on = [
+    dbtcloud_project.dbt_project,
+  ]
+}
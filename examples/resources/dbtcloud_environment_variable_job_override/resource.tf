@@ -3,4 +3,20 @@ resource "dbtcloud_environment_variable_job_override" "my_env_var_job_override"
   project_id        = dbtcloud_project.dbt_project.id
   job_definition_id = dbtcloud_job.daily_job.id
   raw_value         = "my_override_value"
-}
\ No newline at end of file
+}
+// Using a write-only value for a secret (not stored in state, requires Terraform >= 1.11)
+//
+// The raw_value_wo value is never persisted in the Terraform state file.
+// Use raw_value_wo_version to trigger an update when the secret changes.
+variable "dbt_job_api_key" {
+  type      = string
+  ephemeral = true
+}
+
+resource "dbtcloud_environment_variable_job_override" "my_secret_job_override" {
+  name                 = dbtcloud_environment_variable.dbt_my_secret_env_var.name
+  project_id           = dbtcloud_project.dbt_project.id
+  job_definition_id    = dbtcloud_job.daily_job.id
+  raw_value_wo         = var.dbt_job_api_key
+  raw_value_wo_version = 1
+}
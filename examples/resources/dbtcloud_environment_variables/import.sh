@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_environment_variables.dbt_project_env_vars
+  id = "project_id"
+}
+
+import {
+  to = dbtcloud_environment_variables.dbt_project_env_vars
+  id = "12345"
+}
+
+# using the older import command
+terraform import dbtcloud_environment_variables.dbt_project_env_vars "project_id"
+terraform import dbtcloud_environment_variables.dbt_project_env_vars 12345
@@ -0,0 +1,20 @@
+// all the environment variables of the project are managed by this resource,
+// the ones created in the dbt Cloud UI are deleted on the next apply
+resource "dbtcloud_environment_variables" "dbt_project_env_vars" {
+  project_id = dbtcloud_project.dbt_project.id
+  variables = {
+    DBT_TARGET = {
+      "project" : "dev",
+      "CI" : "ci",
+      "Prod" : "prod"
+    }
+    DBT_THREADS = {
+      "project" : "4"
+    }
+  }
+  depends_on = [
+    dbtcloud_environment.dev_env,
+    dbtcloud_environment.ci_env,
+    dbtcloud_environment.prod_env,
+  ]
+}
@@ -16,6 +16,14 @@ import {
   id = "1234"
 }
 
+# using an identity in import blocks (requires Terraform >= 1.12)
+import {
+  to = dbtcloud_global_connection.my_connection
+  identity = {
+    id = 1234
+  }
+}
+
 # using the older import command
 terraform import dbtcloud_global_connection.my_connection "connection_id"
 terraform import dbtcloud_global_connection.my_connection 1234
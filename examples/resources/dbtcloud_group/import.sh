@@ -9,6 +9,14 @@ import {
   id = "12345"
 }
 
+# using an identity in import blocks (requires Terraform >= 1.12)
+import {
+  to = dbtcloud_group.my_group
+  identity = {
+    id = 12345
+  }
+}
+
 # using the older import command
 terraform import dbtcloud_group.my_group "group_id"
 terraform import dbtcloud_group.my_group 12345
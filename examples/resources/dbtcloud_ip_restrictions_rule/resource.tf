@@ -11,4 +11,17 @@ resource "dbtcloud_ip_restrictions_rule" "test" {
   ]
   type             = "deny"
   rule_set_enabled = false
-}
\ No newline at end of file
+}
+# enabling the IP restrictions fails the plan if the IP address Terraform reaches
+# dbt Cloud from (the provider `egress_ip` or detected) would be blocked
+resource "dbtcloud_ip_restrictions_rule" "runners" {
+  name = "Terraform runners"
+  cidrs = [
+    {
+      cidr = "203.0.113.0/24"
+    }
+  ]
+  type             = "allow"
+  rule_set_enabled = true
+  lockout_check    = "error" # or "warn" or "off"
+}
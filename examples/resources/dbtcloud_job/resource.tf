@@ -112,4 +112,60 @@ resource "dbtcloud_job" "daily_job" {
   schedule_type  = "interval_cron"
   schedule_days = [0, 1, 2, 3, 4, 5, 6]
   schedule_interval = 5
+}
+
+# a job running at 7:00 and 19:00 on week days in Paris time
+# dbt Cloud schedules are in UTC: the schedule is converted to `schedule_cron_utc`,
+# updated when daylight saving time starts or ends, and the plan previews the
+# next runs in `schedule_next_runs`
+resource "dbtcloud_job" "regional_job" {
+  environment_id = dbtcloud_environment.prod_environment.environment_id
+  execute_steps = [
+    "dbt build"
+  ]
+  name       = "Paris job"
+  project_id = dbtcloud_project.dbt_project.id
+  triggers = {
+    "github_webhook" : false
+    "git_provider_webhook" : false
+    "schedule" : true
+    "on_merge" : false
+  }
+
+  schedule_type     = "days_of_week"
+  schedule_days     = [1, 2, 3, 4, 5]
+  schedule_hours    = [7, 19]
+  schedule_timezone = "Europe/Paris"
+}
+
+# a job running at 7:00 on week days and on Saturdays in New York time, except
+# during the end of year code freeze. dbt Cloud doesn't know about the blackout:
+# it only disables the schedule trigger when the job is applied during the
+# freeze, and enables it again when it is applied after the freeze, e.g. from a
+# pipeline scheduled at the start and the end of the freeze
+resource "dbtcloud_job" "frozen_job" {
+  environment_id = dbtcloud_environment.prod_environment.environment_id
+  execute_steps = [
+    "dbt build"
+  ]
+  name       = "New York job"
+  project_id = dbtcloud_project.dbt_project.id
+  triggers = {
+    "github_webhook" : false
+    "git_provider_webhook" : false
+    "schedule" : true
+    "on_merge" : false
+  }
+
+  schedule_type     = "days_of_week"
+  schedule_days     = [1, 2, 3, 4, 5]
+  schedule_hours    = [7]
+  schedule_timezone = "America/New_York"
+  schedule_windows  = ["0 7 * * 6"]
+  schedule_apply_blackouts = [
+    {
+      start = "2026-12-21T00:00:00-05:00"
+      end   = "2027-01-04T00:00:00-05:00"
+    }
+  ]
 }
\ No newline at end of file
@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_notification_rule.my_rule
+  id = "notification_id"
+}
+
+import {
+  to = dbtcloud_notification_rule.my_rule
+  id = "12345"
+}
+
+# using the older import command
+terraform import dbtcloud_notification_rule.my_rule "notification_id"
+terraform import dbtcloud_notification_rule.my_rule 12345
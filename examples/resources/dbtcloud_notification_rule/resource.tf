@@ -0,0 +1,28 @@
+// a Slack notification for the failures of all the scheduled jobs of the
+// production environments, including the jobs created later on
+resource "dbtcloud_notification_rule" "prod_failures" {
+  user_id            = 100
+  notification_type  = 2
+  slack_channel_id   = "C12345ABCDE"
+  slack_channel_name = "#data-alerts"
+  events             = ["failure", "cancel"]
+  environment_ids = [
+    dbtcloud_environment.prod_environment.environment_id,
+    dbtcloud_environment.finance_prod_environment.environment_id,
+  ]
+  job_types = ["scheduled"]
+}
+
+// an email for the jobs of a project with a name starting with "Finance"
+resource "dbtcloud_notification_rule" "finance_jobs" {
+  user_id           = 100
+  notification_type = 4
+  external_email    = "finance-data@example.com"
+  events            = ["failure", "warning", "success"]
+  project_ids       = [dbtcloud_project.finance_project.id]
+  job_name_regex    = "^Finance"
+
+  // when the jobs are managed in the same config, depend on them so that they
+  // exist when the rule resolves them
+  depends_on = [dbtcloud_job.finance_daily_job]
+}
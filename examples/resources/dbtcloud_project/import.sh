@@ -9,6 +9,14 @@ import {
   id = "12345"
 }
 
+# using an identity in import blocks (requires Terraform >= 1.12)
+import {
+  to = dbtcloud_project.my_project
+  identity = {
+    id = 12345
+  }
+}
+
 # using the older import command
 terraform import dbtcloud_project.my_project "project_id"
 terraform import dbtcloud_project.my_project 12345
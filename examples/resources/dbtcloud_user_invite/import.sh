@@ -0,0 +1,14 @@
+# using  import blocks (requires Terraform >= 1.5)
+import {
+  to = dbtcloud_user_invite.my_invite
+  id = "invite_id"
+}
+
+import {
+  to = dbtcloud_user_invite.my_invite
+  id = "12345"
+}
+
+# using the older import command
+terraform import dbtcloud_user_invite.my_invite "invite_id"
+terraform import dbtcloud_user_invite.my_invite 12345
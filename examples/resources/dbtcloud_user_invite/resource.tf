@@ -0,0 +1,17 @@
+// invite a contractor with a developer license, adding them to a group once
+// they accept the invitation
+resource "dbtcloud_user_invite" "contractor" {
+  email        = "contractor@example.com"
+  license_type = "developer"
+  group_ids    = [dbtcloud_group.contractors.id]
+}
+
+// change resend_trigger to send the invitation email again while pending
+resource "dbtcloud_user_invite" "analyst" {
+  email          = "analyst@example.com"
+  license_type   = "analyst"
+  resend_trigger = "2026-10-18"
+
+  // keep the user in the account when the resource is destroyed
+  deactivate_user_on_destroy = false
+}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,8 +29,9 @@ type AccountFeatureUpdateRequest struct {
 	Value   bool   `json:"value"`
 }
 
-func (c *Client) GetAccountFeatures() (*AccountFeatures, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetAccountFeatures(ctx context.Context) (*AccountFeatures, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf("%s/private/accounts/%d/features/", c.HostURL, c.AccountID),
 		nil,
@@ -52,7 +54,7 @@ func (c *Client) GetAccountFeatures() (*AccountFeatures, error) {
 	return &featuresResponse.Data, nil
 }
 
-func (c *Client) UpdateAccountFeature(feature string, value bool) error {
+func (c *Client) UpdateAccountFeature(ctx context.Context, feature string, value bool) error {
 	updateRequest := AccountFeatureUpdateRequest{
 		Feature: feature,
 		Value:   value,
@@ -63,7 +65,8 @@ func (c *Client) UpdateAccountFeature(feature string, value bool) error {
 		return err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/private/accounts/%d/features/", c.HostURL, c.AccountID),
 		strings.NewReader(string(updateData)),
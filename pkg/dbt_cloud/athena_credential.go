@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -52,10 +53,12 @@ type AthenaCredentialRequest struct {
 }
 
 func (c *Client) GetAthenaCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*AthenaCredentialData, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -85,6 +88,7 @@ func (c *Client) GetAthenaCredential(
 }
 
 func (c *Client) CreateAthenaCredential(
+	ctx context.Context,
 	projectId int,
 	awsAccessKeyId string,
 	awsSecretAccessKey string,
@@ -116,7 +120,8 @@ func (c *Client) CreateAthenaCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -145,6 +150,7 @@ func (c *Client) CreateAthenaCredential(
 }
 
 func (c *Client) UpdateAthenaCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	athenaCredential AthenaCredentialRequest,
@@ -154,7 +160,8 @@ func (c *Client) UpdateAthenaCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -51,8 +52,9 @@ type AuthProviderResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetAuthProvider(authProviderID int64) (*AuthProvider, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetAuthProvider(ctx context.Context, authProviderID int64) (*AuthProvider, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/auth-provider/%d/",
@@ -79,7 +81,7 @@ func (c *Client) GetAuthProvider(authProviderID int64) (*AuthProvider, error) {
 	return &resp.Data, nil
 }
 
-func (c *Client) CreateAuthProvider(authProvider AuthProvider) (*AuthProvider, error) {
+func (c *Client) CreateAuthProvider(ctx context.Context, authProvider AuthProvider) (*AuthProvider, error) {
 	authProvider.AccountID = c.AccountID
 
 	payload, err := json.Marshal(authProvider)
@@ -87,7 +89,8 @@ func (c *Client) CreateAuthProvider(authProvider AuthProvider) (*AuthProvider, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/auth-provider/",
@@ -113,7 +116,7 @@ func (c *Client) CreateAuthProvider(authProvider AuthProvider) (*AuthProvider, e
 	return &resp.Data, nil
 }
 
-func (c *Client) UpdateAuthProvider(authProviderID int64, authProvider AuthProvider) (*AuthProvider, error) {
+func (c *Client) UpdateAuthProvider(ctx context.Context, authProviderID int64, authProvider AuthProvider) (*AuthProvider, error) {
 	authProvider.AccountID = c.AccountID
 
 	payload, err := json.Marshal(authProvider)
@@ -121,7 +124,8 @@ func (c *Client) UpdateAuthProvider(authProviderID int64, authProvider AuthProvi
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/auth-provider/%d/",
@@ -148,8 +152,9 @@ func (c *Client) UpdateAuthProvider(authProviderID int64, authProvider AuthProvi
 	return &resp.Data, nil
 }
 
-func (c *Client) DeleteAuthProvider(authProviderID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteAuthProvider(ctx context.Context, authProviderID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/auth-provider/%d/",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -33,8 +34,9 @@ type AzureADApplicationListResponse struct {
 
 // GetAzureADApplicationForAccount fetches the single Azure AD application for
 // this account via the list endpoint. Returns nil if none exists yet.
-func (c *Client) GetAzureADApplicationForAccount() (*AzureADApplication, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetAzureADApplicationForAccount(ctx context.Context) (*AzureADApplication, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/azure-ad-applications/",
@@ -63,8 +65,9 @@ func (c *Client) GetAzureADApplicationForAccount() (*AzureADApplication, error)
 	return &listResp.Data[0], nil
 }
 
-func (c *Client) GetAzureADApplication(applicationID int64) (*AzureADApplication, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetAzureADApplication(ctx context.Context, applicationID int64) (*AzureADApplication, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/azure-ad-applications/%d/",
@@ -91,13 +94,14 @@ func (c *Client) GetAzureADApplication(applicationID int64) (*AzureADApplication
 	return &resp.Data, nil
 }
 
-func (c *Client) CreateAzureADApplication(app AzureADApplication) (*AzureADApplication, error) {
+func (c *Client) CreateAzureADApplication(ctx context.Context, app AzureADApplication) (*AzureADApplication, error) {
 	payload, err := json.Marshal(app)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/azure-ad-applications/",
@@ -123,7 +127,7 @@ func (c *Client) CreateAzureADApplication(app AzureADApplication) (*AzureADAppli
 	return &resp.Data, nil
 }
 
-func (c *Client) UpdateAzureADApplication(applicationID int64, app AzureADApplication) (*AzureADApplication, error) {
+func (c *Client) UpdateAzureADApplication(ctx context.Context, applicationID int64, app AzureADApplication) (*AzureADApplication, error) {
 	// account_id is passed via the URL path; zero it out to avoid API rejection.
 	app.AccountID = 0
 
@@ -132,7 +136,8 @@ func (c *Client) UpdateAzureADApplication(applicationID int64, app AzureADApplic
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/azure-ad-applications/%d/",
@@ -159,8 +164,9 @@ func (c *Client) UpdateAzureADApplication(applicationID int64, app AzureADApplic
 	return &resp.Data, nil
 }
 
-func (c *Client) DeleteAzureADApplication(applicationID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteAzureADApplication(ctx context.Context, applicationID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/azure-ad-applications/%d/",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,9 +23,10 @@ type AzureDevOpsProjectsResponse struct {
 	Status ResponseStatus          `json:"status"`
 }
 
-func (c *Client) GetAzureDevOpsProjects() ([]AzureDevOpsProject, error) {
+func (c *Client) GetAzureDevOpsProjects(ctx context.Context) ([]AzureDevOpsProject, error) {
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf("%s/v3/integrations/azure-ad/projects/?account_id=%d", c.HostURL, c.AccountID),
 		nil,
@@ -49,10 +51,11 @@ func (c *Client) GetAzureDevOpsProjects() ([]AzureDevOpsProject, error) {
 }
 
 func (c *Client) GetAzureDevOpsProject(
+	ctx context.Context,
 	projectName string,
 ) (*AzureDevOpsProject, error) {
 
-	listAzureDevOpsProjects, err := c.GetAzureDevOpsProjects()
+	listAzureDevOpsProjects, err := c.GetAzureDevOpsProjects(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,10 +27,12 @@ type AzureDevOpsRepositoriesResponse struct {
 }
 
 func (c *Client) GetAzureDevOpsRepositories(
+	ctx context.Context,
 	azureDevOpsProjectID string,
 ) ([]AzureDevOpsRepository, error) {
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/integrations/azure-ad/projects/%s/repositories/?account_id=%d",
@@ -59,11 +62,12 @@ func (c *Client) GetAzureDevOpsRepositories(
 }
 
 func (c *Client) GetAzureDevOpsRepository(
+	ctx context.Context,
 	repositoryName string,
 	azureDevOpsProjectID string,
 ) (*AzureDevOpsRepository, error) {
 
-	listAzureDevOpsRepositories, err := c.GetAzureDevOpsRepositories(azureDevOpsProjectID)
+	listAzureDevOpsRepositories, err := c.GetAzureDevOpsRepositories(ctx, azureDevOpsProjectID)
 	if err != nil {
 		return nil, err
 	}
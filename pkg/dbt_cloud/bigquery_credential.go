@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -64,10 +65,12 @@ type BigQueryCredentialGlobConn struct {
 }
 
 func (c *Client) GetBigQueryCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*BigQueryCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -97,6 +100,7 @@ func (c *Client) GetBigQueryCredential(
 }
 
 func (c *Client) CreateBigQueryCredential(
+	ctx context.Context,
 	projectId int,
 	type_ string,
 	isActive bool,
@@ -144,7 +148,8 @@ func (c *Client) CreateBigQueryCredential(
 		}
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -232,6 +237,7 @@ func GenerateBigQueryCredentialDetails(dataset string, numThreads int) (AdapterC
 }
 
 func (c *Client) UpdateBigQueryCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	BigQueryCredential BigQueryCredential,
@@ -241,7 +247,8 @@ func (c *Client) UpdateBigQueryCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -0,0 +1,536 @@
+package dbt_cloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// CassetteModeEnvVar enables the cassettes when set to CassetteModeRecord
+	// or CassetteModeReplay
+	CassetteModeEnvVar = "DBT_CLOUD_CASSETTE_MODE"
+	// CassetteDirEnvVar is the directory of the cassette files, relative to
+	// the package of the test, testdata/cassettes by default
+	CassetteDirEnvVar = "DBT_CLOUD_CASSETTE_DIR"
+
+	// CassetteModeRecord sends the requests to the API and records them
+	CassetteModeRecord = "record"
+	// CassetteModeReplay answers the requests from the recorded cassettes,
+	// without any network access
+	CassetteModeReplay = "replay"
+
+	defaultCassetteDir = "testdata/cassettes"
+	// cassetteRedacted replaces the secrets in the cassettes
+	cassetteRedacted = "REDACTED"
+)
+
+// cassetteSensitiveKeys are the substrings of the JSON keys whose string
+// values are redacted from the cassettes.
+var cassetteSensitiveKeys = []string{
+	"token",
+	"secret",
+	"password",
+	"passphrase",
+	"private_key",
+	"hmac",
+	"api_key",
+}
+
+// cassetteSecretEnvironmentVariablePrefix is the prefix of the names of the
+// secret environment variables, whose values are redacted from the cassettes.
+// Their values are sent keyed by environment name in the env_var and env_vars
+// objects of the bulk endpoint, and as raw_value for the job overrides.
+const cassetteSecretEnvironmentVariablePrefix = "DBT_ENV_SECRET"
+
+// accountPathPattern matches the account ID in the URLs, which is not
+// recorded so that the cassettes can be replayed with any account.
+var accountPathPattern = regexp.MustCompile(`/accounts/\d+(/|$)`)
+
+// CassetteInteraction is a request to the API and its response, as recorded in
+// a cassette.
+type CassetteInteraction struct {
+	Method       string `json:"method"`
+	URL          string `json:"url"`
+	RequestBody  string `json:"request_body,omitempty"`
+	StatusCode   int    `json:"status_code"`
+	ResponseBody string `json:"response_body,omitempty"`
+}
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+// cassette is the cassette being recorded or replayed.
+type cassette struct {
+	path string
+	mode string
+
+	mu           sync.Mutex
+	interactions []CassetteInteraction
+	used         []bool
+	// replacements maps the strings of the recording to the ones of the
+	// replay, like the random names generated by the tests
+	replacements map[string]string
+}
+
+// activeCassette is the cassette of the test running, the acceptance tests
+// need to run one at a time (-parallel 1) to be recorded or replayed.
+var activeCassette atomic.Pointer[cassette]
+
+// StartCassette starts recording or replaying the cassette named after the
+// test, depending on DBT_CLOUD_CASSETTE_MODE. It does nothing when the
+// variable is not set.
+func StartCassette(name string) error {
+	mode := os.Getenv(CassetteModeEnvVar)
+	if mode == "" {
+		return nil
+	}
+	if mode != CassetteModeRecord && mode != CassetteModeReplay {
+		return fmt.Errorf(
+			"%s must be %q or %q, got %q",
+			CassetteModeEnvVar,
+			CassetteModeRecord,
+			CassetteModeReplay,
+			mode,
+		)
+	}
+
+	dir := os.Getenv(CassetteDirEnvVar)
+	if dir == "" {
+		dir = defaultCassetteDir
+	}
+	c := &cassette{
+		path:         filepath.Join(dir, cassetteFileName(name)),
+		mode:         mode,
+		replacements: map[string]string{},
+	}
+
+	if mode == CassetteModeReplay {
+		content, err := os.ReadFile(c.path)
+		if err != nil {
+			return fmt.Errorf("the cassette of %s can't be replayed: %w", name, err)
+		}
+		var recorded Cassette
+		if err := json.Unmarshal(content, &recorded); err != nil {
+			return fmt.Errorf("the cassette %s is not valid: %w", c.path, err)
+		}
+		c.interactions = recorded.Interactions
+		c.used = make([]bool, len(recorded.Interactions))
+	}
+
+	activeCassette.Store(c)
+	return nil
+}
+
+// StopCassette stops the active cassette, writing it when recording.
+func StopCassette() error {
+	c := activeCassette.Swap(nil)
+	if c == nil || c.mode != CassetteModeRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	content, err := json.MarshalIndent(Cassette{Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(content, '\n'), 0o644)
+}
+
+// cassetteFileName returns the file name of the cassette of a test, the
+// subtests being separated by a slash.
+func cassetteFileName(name string) string {
+	return strings.NewReplacer("/", "__", " ", "_").Replace(name) + ".json"
+}
+
+// NewCassetteTransport returns the transport recording or replaying the
+// requests in the active cassette when DBT_CLOUD_CASSETTE_MODE is set, or
+// next otherwise.
+func NewCassetteTransport(next http.RoundTripper) http.RoundTripper {
+	mode := os.Getenv(CassetteModeEnvVar)
+	if mode == "" {
+		return next
+	}
+	return &cassetteTransport{next: next, mode: mode}
+}
+
+type cassetteTransport struct {
+	next http.RoundTripper
+	mode string
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := activeCassette.Load()
+	if c == nil {
+		if t.mode == CassetteModeReplay {
+			return nil, fmt.Errorf(
+				"no cassette is active to replay %s %s, the test must call acctest_helper.TestAccPreCheck",
+				req.Method,
+				redactURL(req.URL),
+			)
+		}
+		return t.next.RoundTrip(req)
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if c.mode == CassetteModeReplay {
+		return c.replay(req, body)
+	}
+	return c.record(t.next, req, body)
+}
+
+// readRequestBody returns the body of the request, leaving it readable.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func (c *cassette) record(next http.RoundTripper, req *http.Request, body []byte) (*http.Response, error) {
+	res, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	// the transient errors are retried, replaying them would only slow the
+	// tests down
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusBadGateway {
+		return res, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, CassetteInteraction{
+		Method:       req.Method,
+		URL:          cassetteURL(req.URL),
+		RequestBody:  string(scrubCassetteBody(body)),
+		StatusCode:   res.StatusCode,
+		ResponseBody: string(scrubCassetteBody(responseBody)),
+	})
+	return res, nil
+}
+
+// replay answers with the response of the recorded request matching the
+// request, the one requiring the fewest new replacements when several match.
+func (c *cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	requestURL := cassetteURL(req.URL)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	match := -1
+	var matchLearned map[string]string
+	for i, interaction := range c.interactions {
+		if c.used[i] || interaction.Method != req.Method {
+			continue
+		}
+		learned := map[string]string{}
+		if !c.matchURL(interaction.URL, requestURL, learned) ||
+			!c.matchBody(interaction.RequestBody, string(body), learned) {
+			continue
+		}
+		if match == -1 || len(learned) < len(matchLearned) {
+			match, matchLearned = i, learned
+			if len(learned) == 0 {
+				break
+			}
+		}
+	}
+	if match == -1 {
+		return nil, fmt.Errorf(
+			"the cassette %s has no recorded request matching %s %s, it needs to be recorded again",
+			c.path,
+			req.Method,
+			requestURL,
+		)
+	}
+
+	c.used[match] = true
+	for recorded, replayed := range matchLearned {
+		c.replacements[recorded] = replayed
+	}
+
+	interaction := c.interactions[match]
+	responseBody := c.substitute(interaction.ResponseBody)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       req,
+	}, nil
+}
+
+// learn reports whether a recorded string can be the replayed one, adding
+// the replacement to learned when they differ for the first time. Numbers
+// are never replaced, as the IDs of the replay come from the cassette.
+func (c *cassette) learn(recorded, replayed string, learned map[string]string) bool {
+	if recorded == replayed || recorded == cassetteRedacted {
+		return true
+	}
+	if known, ok := c.replacements[recorded]; ok {
+		return known == replayed
+	}
+	if known, ok := learned[recorded]; ok {
+		return known == replayed
+	}
+	if recorded == "" || replayed == "" || isNumber(recorded) || isNumber(replayed) {
+		return false
+	}
+	learned[recorded] = replayed
+	return true
+}
+
+func isNumber(value string) bool {
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+func (c *cassette) matchURL(recorded, replayed string, learned map[string]string) bool {
+	recordedURL, err := url.Parse(recorded)
+	if err != nil {
+		return false
+	}
+	replayedURL, err := url.Parse(replayed)
+	if err != nil {
+		return false
+	}
+
+	recordedSegments := strings.Split(recordedURL.Path, "/")
+	replayedSegments := strings.Split(replayedURL.Path, "/")
+	if len(recordedSegments) != len(replayedSegments) {
+		return false
+	}
+	for i := range recordedSegments {
+		if !c.learn(recordedSegments[i], replayedSegments[i], learned) {
+			return false
+		}
+	}
+
+	recordedQuery := recordedURL.Query()
+	replayedQuery := replayedURL.Query()
+	if len(recordedQuery) != len(replayedQuery) {
+		return false
+	}
+	for key, values := range recordedQuery {
+		if len(replayedQuery[key]) != len(values) {
+			return false
+		}
+		for i := range values {
+			if !c.learn(values[i], replayedQuery[key][i], learned) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (c *cassette) matchBody(recorded, replayed string, learned map[string]string) bool {
+	if recorded == "" || replayed == "" {
+		return recorded == replayed
+	}
+
+	var recordedValue, replayedValue any
+	if decodeJSONNumbers([]byte(recorded), &recordedValue) != nil ||
+		decodeJSONNumbers([]byte(replayed), &replayedValue) != nil {
+		return c.substitute(recorded) == replayed
+	}
+	return c.matchValue(recordedValue, replayedValue, learned)
+}
+
+func (c *cassette) matchValue(recorded, replayed any, learned map[string]string) bool {
+	switch recordedValue := recorded.(type) {
+	case map[string]any:
+		replayedValue, ok := replayed.(map[string]any)
+		if !ok || len(recordedValue) != len(replayedValue) {
+			return false
+		}
+		for key, value := range recordedValue {
+			other, ok := replayedValue[key]
+			if !ok {
+				return false
+			}
+			// the account of the replay is not the one of the recording
+			if key == "account_id" {
+				continue
+			}
+			if !c.matchValue(value, other, learned) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		replayedValue, ok := replayed.([]any)
+		if !ok || len(recordedValue) != len(replayedValue) {
+			return false
+		}
+		for i := range recordedValue {
+			if !c.matchValue(recordedValue[i], replayedValue[i], learned) {
+				return false
+			}
+		}
+		return true
+	case string:
+		replayedValue, ok := replayed.(string)
+		return ok && c.learn(recordedValue, replayedValue, learned)
+	default:
+		return fmt.Sprint(recorded) == fmt.Sprint(replayed)
+	}
+}
+
+// substitute applies the replacements learned to a recorded content, the
+// longest strings first so that they are not partially replaced.
+func (c *cassette) substitute(content string) string {
+	recordedStrings := make([]string, 0, len(c.replacements))
+	for recorded := range c.replacements {
+		recordedStrings = append(recordedStrings, recorded)
+	}
+	sort.Slice(recordedStrings, func(i, j int) bool {
+		return len(recordedStrings[i]) > len(recordedStrings[j])
+	})
+
+	pairs := make([]string, 0, 2*len(recordedStrings))
+	for _, recorded := range recordedStrings {
+		pairs = append(pairs, recorded, c.replacements[recorded])
+	}
+	return strings.NewReplacer(pairs...).Replace(content)
+}
+
+// cassetteURL returns the URL as recorded: without the host and the account
+// ID, and with the sensitive query parameters redacted.
+func cassetteURL(u *url.URL) string {
+	redacted, err := url.Parse(redactURL(u))
+	if err != nil {
+		return ""
+	}
+	path := accountPathPattern.ReplaceAllString(redacted.EscapedPath(), "/accounts/{account_id}$1")
+	if redacted.RawQuery != "" {
+		return path + "?" + redacted.RawQuery
+	}
+	return path
+}
+
+// scrubCassetteBody redacts the secrets of a JSON body, other bodies are
+// returned as is.
+func scrubCassetteBody(body []byte) []byte {
+	var value any
+	if len(body) == 0 || decodeJSONNumbers(body, &value) != nil {
+		return body
+	}
+	scrubbed, err := json.Marshal(scrubCassetteValue(value))
+	if err != nil {
+		return body
+	}
+	return scrubbed
+}
+
+func scrubCassetteValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		secretVariable := isSecretEnvironmentVariable(v)
+		for key, field := range v {
+			if text, ok := field.(string); ok && text != "" &&
+				(isSensitiveKey(key) || secretVariable && key == "raw_value") {
+				v[key] = cassetteRedacted
+				continue
+			}
+			if values, ok := field.(map[string]any); ok &&
+				(key == "env_var" || key == "env_vars") && isSecretEnvironmentVariable(values) {
+				scrubEnvironmentValues(values)
+				continue
+			}
+			v[key] = scrubCassetteValue(field)
+		}
+	case []any:
+		for i := range v {
+			v[i] = scrubCassetteValue(v[i])
+		}
+	}
+	return value
+}
+
+// isSecretEnvironmentVariable returns true when the object is a secret
+// environment variable, named with name or with new_name when created.
+func isSecretEnvironmentVariable(object map[string]any) bool {
+	for _, key := range []string{"name", "new_name"} {
+		if name, ok := object[key].(string); ok &&
+			strings.HasPrefix(name, cassetteSecretEnvironmentVariablePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrubEnvironmentValues redacts the values of an environment variable keyed
+// by environment name, keeping its name.
+func scrubEnvironmentValues(values map[string]any) {
+	for key, value := range values {
+		if key == "name" || key == "new_name" {
+			continue
+		}
+		if text, ok := value.(string); ok && text != "" {
+			values[key] = cassetteRedacted
+		}
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+	for _, sensitive := range cassetteSensitiveKeys {
+		if strings.Contains(lowerKey, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeJSONNumbers decodes JSON keeping the numbers as they were written.
+func decodeJSONNumbers(data []byte, value *any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(value); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return fmt.Errorf("unexpected content after the JSON value")
+	}
+	return nil
+}
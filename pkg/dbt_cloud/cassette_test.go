@@ -0,0 +1,270 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// cassetteTestAPI answers like the API for the creation and the listing of
+// groups, returning a secret in the responses.
+func cassetteTestAPI(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			var group map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+				t.Errorf("unexpected body: %v", err)
+			}
+			group["id"] = 7
+			group["token_string"] = "dbtc_secret"
+			json.NewEncoder(w).Encode(map[string]any{"data": group})
+		default:
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": []map[string]any{{"id": 7, "name": r.URL.Query().Get("name")}},
+			})
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func doCassetteRequest(t *testing.T, client *http.Client, method, url, body string) (int, string, error) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Token dbtc_secret_token")
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer res.Body.Close()
+	content, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, string(content), nil
+}
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(CassetteDirEnvVar, dir)
+	srv := cassetteTestAPI(t)
+
+	// record with the random name of the first run
+	t.Setenv(CassetteModeEnvVar, CassetteModeRecord)
+	client := &http.Client{Transport: NewCassetteTransport(http.DefaultTransport)}
+	if err := StartCassette("TestAccGroup/basic"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := doCassetteRequest(t, client, http.MethodPost, srv.URL+"/v3/accounts/42/groups/", `{"account_id":42,"name":"group_abcdef","password":"hunter2"}`); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := doCassetteRequest(t, client, http.MethodGet, srv.URL+"/v3/accounts/42/groups/?name=group_abcdef", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := StopCassette(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "TestAccGroup__basic.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"dbtc_secret", "hunter2", srv.URL, "/accounts/42/"} {
+		if strings.Contains(string(content), secret) {
+			t.Errorf("expected %q to be removed from the cassette, got %s", secret, content)
+		}
+	}
+	var recorded Cassette
+	if err := json.Unmarshal(content, &recorded); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded.Interactions) != 2 {
+		t.Fatalf("expected 2 interactions, got %d", len(recorded.Interactions))
+	}
+	if got := recorded.Interactions[1].URL; got != "/v3/accounts/{account_id}/groups/?name=group_abcdef" {
+		t.Errorf("unexpected URL recorded: %s", got)
+	}
+
+	// replay with another random name and account, without the server
+	srv.Close()
+	t.Setenv(CassetteModeEnvVar, CassetteModeReplay)
+	client = &http.Client{Transport: NewCassetteTransport(http.DefaultTransport)}
+	if err := StartCassette("TestAccGroup/basic"); err != nil {
+		t.Fatal(err)
+	}
+	defer StopCassette()
+
+	status, body, err := doCassetteRequest(t, client, http.MethodPost, srv.URL+"/v3/accounts/1/groups/", `{"account_id":1,"name":"group_zyxwvu","password":"other"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != http.StatusOK || !strings.Contains(body, `"name":"group_zyxwvu"`) || !strings.Contains(body, `"token_string":"REDACTED"`) {
+		t.Errorf("unexpected replayed response %d: %s", status, body)
+	}
+
+	_, body, err = doCassetteRequest(t, client, http.MethodGet, srv.URL+"/v3/accounts/1/groups/?name=group_zyxwvu", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, `"name":"group_zyxwvu"`) {
+		t.Errorf("expected the replayed name in the response, got %s", body)
+	}
+
+	// every interaction is replayed once
+	_, _, err = doCassetteRequest(t, client, http.MethodGet, srv.URL+"/v3/accounts/1/groups/?name=group_zyxwvu", "")
+	if err == nil || !strings.Contains(err.Error(), "has no recorded request matching") {
+		t.Errorf("expected an error for the request not recorded, got %v", err)
+	}
+}
+
+func TestCassetteRecordSecretEnvironmentVariables(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(CassetteDirEnvVar, dir)
+	t.Setenv(CassetteModeEnvVar, CassetteModeRecord)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": 9}})
+	}))
+	t.Cleanup(srv.Close)
+	hostURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{
+		HostURL:    hostURL,
+		HTTPClient: &http.Client{Transport: NewCassetteTransport(http.DefaultTransport)},
+		Token:      "dbtc_secret_token",
+		AccountID:  42,
+		MaxRetries: 1,
+	}
+
+	if err := StartCassette("TestAccEnvironmentVariable/secret"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := client.CreateEnvironmentVariable(ctx, 3, "DBT_ENV_SECRET_PASSWORD", map[string]string{
+		"project":    "project-cleartext",
+		"Production": "production-cleartext",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.UpdateEnvironmentVariable(ctx, 3, AbstractedEnvironmentVariable{
+		Name:              "DBT_ENV_SECRET_PASSWORD",
+		EnvironmentValues: map[string]string{"project": "updated-cleartext"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateEnvironmentVariableJobOverride(ctx, 3, "DBT_ENV_SECRET_PASSWORD", "override-cleartext", 5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateEnvironmentVariable(ctx, 3, "DBT_TARGET", map[string]string{"project": "dev"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := StopCassette(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "TestAccEnvironmentVariable__secret.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), "cleartext") {
+		t.Errorf("expected the values of the secret variable to be redacted, got %s", content)
+	}
+	for _, kept := range []string{"DBT_ENV_SECRET_PASSWORD", `\"project\":\"dev\"`} {
+		if !strings.Contains(string(content), kept) {
+			t.Errorf("expected %s to be kept in the cassette, got %s", kept, content)
+		}
+	}
+}
+
+func TestCassetteReplayMismatch(t *testing.T) {
+	c := &cassette{
+		mode: CassetteModeReplay,
+		interactions: []CassetteInteraction{{
+			Method:      http.MethodPost,
+			URL:         "/v3/accounts/{account_id}/projects/",
+			RequestBody: `{"name":"project_abc","state":1}`,
+			StatusCode:  http.StatusCreated,
+		}},
+		used:         []bool{false},
+		replacements: map[string]string{},
+	}
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "different number", body: `{"name":"project_abc","state":2}`},
+		{name: "missing field", body: `{"name":"project_abc"}`},
+		{name: "different type", body: `{"name":1,"state":1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if c.matchBody(c.interactions[0].RequestBody, tt.body, map[string]string{}) {
+				t.Errorf("expected %s not to match the recorded body", tt.body)
+			}
+		})
+	}
+
+	learned := map[string]string{}
+	if !c.matchBody(c.interactions[0].RequestBody, `{"name":"project_xyz","state":1}`, learned) {
+		t.Fatal("expected a different name to match the recorded body")
+	}
+	if learned["project_abc"] != "project_xyz" {
+		t.Errorf("expected the name replacement to be learned, got %v", learned)
+	}
+}
+
+func TestCassetteReplayWithoutCassette(t *testing.T) {
+	t.Setenv(CassetteModeEnvVar, CassetteModeReplay)
+	client := &http.Client{Transport: NewCassetteTransport(http.DefaultTransport)}
+
+	_, _, err := doCassetteRequest(t, client, http.MethodGet, "http://127.0.0.1:1/v2/accounts/", "")
+	if err == nil || !strings.Contains(err.Error(), "no cassette is active") {
+		t.Errorf("expected an error without an active cassette, got %v", err)
+	}
+}
+
+func TestScrubCassetteBody(t *testing.T) {
+	body := `{"data":{"id":3,"token_string":"dbtc_abc","service_token_id":5,"credentials":{"private_key":"key","user":"me"},"hmac_secret":"s"}}`
+	got := string(scrubCassetteBody([]byte(body)))
+	want := `{"data":{"credentials":{"private_key":"REDACTED","user":"me"},"hmac_secret":"REDACTED","id":3,"service_token_id":5,"token_string":"REDACTED"}}`
+	if got != want {
+		t.Errorf("unexpected scrubbed body\n got: %s\nwant: %s", got, want)
+	}
+
+	body = `{"env_vars":{"name":"DBT_ENV_SECRET_KEY","project":"abc","Prod":""},"raw_value":"kept"}`
+	got = string(scrubCassetteBody([]byte(body)))
+	want = `{"env_vars":{"Prod":"","name":"DBT_ENV_SECRET_KEY","project":"REDACTED"},"raw_value":"kept"}`
+	if got != want {
+		t.Errorf("unexpected scrubbed body\n got: %s\nwant: %s", got, want)
+	}
+
+	body = `{"name":"DBT_ENV_SECRET_KEY","raw_value":"abc","type":"job"}`
+	got = string(scrubCassetteBody([]byte(body)))
+	want = `{"name":"DBT_ENV_SECRET_KEY","raw_value":"REDACTED","type":"job"}`
+	if got != want {
+		t.Errorf("unexpected scrubbed body\n got: %s\nwant: %s", got, want)
+	}
+
+	if got := string(scrubCassetteBody([]byte("not json"))); got != "not json" {
+		t.Errorf("expected a non JSON body to be kept, got %s", got)
+	}
+}
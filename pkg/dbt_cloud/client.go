@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +10,8 @@ import (
 	"os"
 	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var versionString = "dev"
@@ -41,6 +44,11 @@ type Client struct {
 	RetriableStatusCodes []string
 	DisableRetry         bool
 	TimeoutSeconds       int
+	// EgressIP is the public IP address the requests are sent from, detected
+	// when empty
+	EgressIP string
+
+	rateLimiter *rateLimiter
 }
 
 type ResponseStatus struct {
@@ -115,8 +123,8 @@ type APIError struct {
 	} `json:"status"`
 }
 
-// NewClient -
-func NewClient(account_id *int64, token *string, host_url *string, maxRetries *int, retryIntervalSeconds *int, retriableStatusCodes []string, skipCredentialsValidation bool, timeoutSeconds *int) (*Client, error) {
+// NewClient - ctx is only used to validate the credentials
+func NewClient(ctx context.Context, account_id *int64, token *string, host_url *string, maxRetries *int, retryIntervalSeconds *int, retriableStatusCodes []string, skipCredentialsValidation bool, timeoutSeconds *int) (*Client, error) {
 
 	if (token == nil) || (*token == "") {
 		return nil, fmt.Errorf("token is set but it is empty")
@@ -129,7 +137,10 @@ func NewClient(account_id *int64, token *string, host_url *string, maxRetries *i
 	}
 
 	c := Client{
-		HTTPClient:           &http.Client{Timeout: time.Duration(*timeoutSeconds) * time.Second},
+		HTTPClient: &http.Client{
+			Timeout:   time.Duration(*timeoutSeconds) * time.Second,
+			Transport: NewCassetteTransport(http.DefaultTransport),
+		},
 		HostURL:              parsedURL,
 		Token:                *token,
 		AccountID:            *account_id,
@@ -137,6 +148,7 @@ func NewClient(account_id *int64, token *string, host_url *string, maxRetries *i
 		MaxRetries:           *maxRetries,
 		RetriableStatusCodes: retriableStatusCodes,
 		TimeoutSeconds:       *timeoutSeconds,
+		rateLimiter:          newRateLimiter(0),
 	}
 
 	_, runningAcceptanceTests := os.LookupEnv("TF_ACC")
@@ -144,7 +156,7 @@ func NewClient(account_id *int64, token *string, host_url *string, maxRetries *i
 		url := c.BuildV2URL(ResourceAccounts)
 
 		// authenticate
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -202,10 +214,15 @@ func (c *Client) doRequestWithRetry(req *http.Request) ([]byte, error) {
 	}
 
 	setRequestHeaders(req, c.Token)
+	logCtx := newLogContext(req.Context(), c.Token)
 
 	var lastErr error
 	for attempt := 0; attempt < c.MaxRetries; attempt++ {
-		body, statusCode, attemptErr := c.attemptRequest(req)
+		if err := c.rateLimiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		body, statusCode, retryAfter, attemptErr := c.attemptRequest(logCtx, req, attempt+1)
 		if attemptErr == nil {
 			return body, nil
 		}
@@ -225,16 +242,24 @@ func (c *Client) doRequestWithRetry(req *http.Request) ([]byte, error) {
 			return nil, attemptErr
 		}
 
-		waitDuration := time.Duration(c.RetryIntervalSeconds) * time.Second
-		if attempt > 0 {
-			// Exponential backoff after the first retry.
-			waitDuration = time.Duration(c.RetryIntervalSeconds) * time.Second * (1 << attempt)
+		// The wait requested by the API via Retry-After or X-RateLimit-Reset
+		// takes precedence over our own backoff.
+		waitDuration := retryAfter
+		if waitDuration == 0 {
+			waitDuration = backoffWithJitter(time.Duration(c.RetryIntervalSeconds)*time.Second, attempt)
+		}
+		tflog.Warn(logCtx, "Retrying dbt Cloud API request after a retriable error", map[string]any{
+			"http_method":      req.Method,
+			"http_url":         redactURL(req.URL),
+			"http_status_code": statusCode,
+			"attempt":          attempt + 1,
+			"max_attempts":     c.MaxRetries,
+			"wait":             waitDuration.String(),
+			"error":            strings.ReplaceAll(attemptErr.Error(), req.URL.String(), redactURL(req.URL)),
+		})
+		if err := sleepWithContext(req.Context(), waitDuration); err != nil {
+			return nil, err
 		}
-		fmt.Printf(
-			"Request to %s failed with retriable error (attempt %d/%d, status %d): %v. Waiting %v before retrying...\n",
-			req.URL, attempt+1, c.MaxRetries, statusCode, attemptErr, waitDuration,
-		)
-		time.Sleep(waitDuration)
 	}
 
 	if lastErr != nil {
@@ -244,77 +269,151 @@ func (c *Client) doRequestWithRetry(req *http.Request) ([]byte, error) {
 }
 
 // attemptRequest performs a single HTTP attempt and returns the body on success
-// or a fully formatted terminal error on failure. The error message formats
-// here are preserved verbatim from the pre-retry-fix implementation so that
-// callers matching on substrings such as "internal-server-error:",
-// "resource-not-found", "unauthorized:", "forbidden:" or "unexpected status
-// code" continue to behave the same once retries are exhausted.
+// or a fully formatted terminal error on failure. The errors can be matched
+// with errors.As against NotFoundError, PermissionError, ValidationError,
+// ConflictError, RateLimitError or RequestError; their messages are preserved verbatim from
+// the pre-retry-fix implementation so that callers matching on substrings such
+// as "internal-server-error:", "resource-not-found", "unauthorized:",
+// "forbidden:" or "unexpected status code" keep working.
 //
 // statusCode is 0 when the request failed at the transport layer; the caller
-// in doRequestWithRetry treats those as retriable.
-func (c *Client) attemptRequest(req *http.Request) ([]byte, int, error) {
+// in doRequestWithRetry treats those as retriable. retryAfter is the wait
+// requested by the API through its rate limit headers, if any; all the
+// requests of the client are paused for that long.
+func (c *Client) attemptRequest(logCtx context.Context, req *http.Request, attempt int) (body []byte, statusCode int, retryAfter time.Duration, err error) {
+	logFields := map[string]any{
+		"http_method": req.Method,
+		"http_url":    redactURL(req.URL),
+		"attempt":     attempt,
+	}
+	tflog.Debug(logCtx, "Sending dbt Cloud API request", logFields)
+
+	start := time.Now()
 	res, err := c.HTTPClient.Do(req)
+	logFields["latency_ms"] = time.Since(start).Milliseconds()
 	if err != nil {
-		return nil, 0, err
+		logFields["error"] = strings.ReplaceAll(err.Error(), req.URL.String(), redactURL(req.URL))
+		tflog.Debug(logCtx, "dbt Cloud API request failed", logFields)
+		return nil, 0, 0, err
 	}
 	defer res.Body.Close()
 
+	logFields["http_status_code"] = res.StatusCode
+	if requestID := res.Header.Get("X-Request-Id"); requestID != "" {
+		logFields["request_id"] = requestID
+	}
+	tflog.Debug(logCtx, "Received dbt Cloud API response", logFields)
+
+	now := time.Now()
+	if retryAfter = rateLimitWait(res.Header, now); retryAfter > 0 {
+		tflog.Info(logCtx, "dbt Cloud API rate limit reached, pausing the requests", map[string]any{
+			"wait": retryAfter.String(),
+		})
+		c.rateLimiter.pauseUntil(now.Add(retryAfter))
+	}
+
+	body, err = c.readResponse(req, res, retryAfter)
+	return body, res.StatusCode, retryAfter, err
+}
+
+// readResponse reads the body of the response, returning the typed error
+// matching the status code for unsuccessful responses.
+func (c *Client) readResponse(req *http.Request, res *http.Response, retryAfter time.Duration) ([]byte, error) {
 	body, readErr := io.ReadAll(res.Body)
 	statusCode := res.StatusCode
 
+	if statusCode >= 200 && statusCode < 300 {
+		if readErr != nil {
+			return nil, readErr
+		}
+		return body, nil
+	}
+
+	requestErr := RequestError{
+		StatusCode: statusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+	}
+	isResourceNotFound, apiErr, parseErr := parseAPIError(body)
+	if parseErr == nil {
+		requestErr.UserMessage = apiErr.Status.UserMessage
+		requestErr.DeveloperMessage = apiErr.Status.DeveloperMessage
+	}
+
+	switch statusCode {
 	// Handle 404 errors - check if it's a true not found or a permissions issue
-	if statusCode == 404 {
-		isResourceNotFound, apiErr, parseErr := parseAPIError(body)
+	case http.StatusNotFound:
 		if parseErr != nil {
 			// If we can't parse the error, return a generic 404
-			return nil, statusCode, fmt.Errorf("resource-not-found (status 404): URL: %s, Response: %s", req.URL, body)
+			requestErr.message = fmt.Sprintf("resource-not-found (status 404): URL: %s, Response: %s", req.URL, body)
+			return nil, &NotFoundError{RequestError: requestErr}
 		}
 
 		if isResourceNotFound {
 			// Check if the error message mentions permissions - this is a common pattern in dbt Cloud API
 			userMsg := strings.ToLower(apiErr.Status.UserMessage)
 			if strings.Contains(userMsg, "permission") || strings.Contains(userMsg, "proper permissions") {
-				return nil, statusCode, fmt.Errorf("resource-not-found-permissions: The resource was not found, but this may be due to insufficient permissions. The API token may not have access to this resource or the environment it belongs to.\n\nStatus: 404\nURL: %s\nMessage: %s", req.URL, apiErr.Status.UserMessage)
+				requestErr.message = fmt.Sprintf("resource-not-found-permissions: The resource was not found, but this may be due to insufficient permissions. The API token may not have access to this resource or the environment it belongs to.\n\nStatus: 404\nURL: %s\nMessage: %s", req.URL, apiErr.Status.UserMessage)
+				return nil, &NotFoundError{RequestError: requestErr, MissingPermissions: true}
 			}
 
 			// For GET requests or DELETE operations, this is typically a legitimate not-found
 			// (DELETE gets 404 when resource already deleted, which is fine)
 			if req.Method == "GET" || req.Method == "DELETE" {
-				return nil, statusCode, fmt.Errorf("resource-not-found: %s", req.URL)
+				requestErr.message = fmt.Sprintf("resource-not-found: %s", req.URL)
+				return nil, &NotFoundError{RequestError: requestErr}
 			}
 
 			// For POST/PUT on non-permission 404s, provide additional context
 			// This helps with update/create operations that fail due to permissions
-			return nil, statusCode, fmt.Errorf("resource-not-found: The resource was not found. If you are updating a resource, this may indicate insufficient permissions.\n\nStatus: 404\nURL: %s\nMessage: %s", req.URL, apiErr.Status.UserMessage)
+			requestErr.message = fmt.Sprintf("resource-not-found: The resource was not found. If you are updating a resource, this may indicate insufficient permissions.\n\nStatus: 404\nURL: %s\nMessage: %s", req.URL, apiErr.Status.UserMessage)
+			return nil, &NotFoundError{RequestError: requestErr}
 		}
-	}
-
-	if statusCode == 400 {
-		return nil, statusCode, fmt.Errorf("resource-not-found: %s", body)
-	}
 
-	// Handle permission errors (401 Unauthorized, 403 Forbidden)
-	if statusCode == 401 {
-		return nil, statusCode, fmt.Errorf("unauthorized: The API token does not have permission to access this resource. Status: 401, URL: %s, Response: %s", req.URL, body)
-	}
+	case http.StatusBadRequest:
+		// Some endpoints answer with a 400 instead of a 404 when reading an
+		// object that doesn't exist anymore
+		if req.Method == "GET" {
+			requestErr.message = fmt.Sprintf("resource-not-found: %s", body)
+			return nil, &NotFoundError{RequestError: requestErr}
+		}
+		requestErr.message = fmt.Sprintf("bad-request: The request was rejected by the dbt Cloud API. Status: 400, URL: %s, Response: %s", req.URL, body)
+		if isDuplicateKeyError(body) {
+			return nil, &ConflictError{RequestError: requestErr}
+		}
+		return nil, &ValidationError{RequestError: requestErr}
 
-	if statusCode == 403 {
-		return nil, statusCode, fmt.Errorf("forbidden: The API token does not have permission to perform this action. This may be due to environment-level permissions or other access restrictions. Status: 403, URL: %s, Response: %s", req.URL, body)
-	}
+	case http.StatusConflict:
+		requestErr.message = fmt.Sprintf("conflict: The request conflicts with an existing object. Status: 409, URL: %s, Response: %s", req.URL, body)
+		return nil, &ConflictError{RequestError: requestErr}
 
-	if statusCode == 500 {
-		return nil, statusCode, fmt.Errorf("internal-server-error: %s", body)
-	}
+	case http.StatusUnprocessableEntity:
+		requestErr.message = fmt.Sprintf("bad-request: The request was rejected by the dbt Cloud API. Status: 422, URL: %s, Response: %s", req.URL, body)
+		return nil, &ValidationError{RequestError: requestErr}
 
-	// Check for other non-2xx status codes
-	if statusCode < 200 || statusCode >= 300 {
-		return nil, statusCode, fmt.Errorf("unexpected status code %d: %s, URL: %s", statusCode, body, req.URL)
+	// Handle permission errors (401 Unauthorized, 403 Forbidden)
+	case http.StatusUnauthorized:
+		requestErr.message = fmt.Sprintf("unauthorized: The API token does not have permission to access this resource. Status: 401, URL: %s, Response: %s", req.URL, body)
+		return nil, &PermissionError{RequestError: requestErr}
+
+	case http.StatusForbidden:
+		requestErr.message = fmt.Sprintf("forbidden: The API token does not have permission to perform this action. This may be due to environment-level permissions or other access restrictions. Status: 403, URL: %s, Response: %s", req.URL, body)
+		return nil, &PermissionError{RequestError: requestErr}
+
+	case http.StatusTooManyRequests:
+		requestErr.message = fmt.Sprintf("unexpected status code %d: %s, URL: %s", statusCode, body, req.URL)
+		return nil, &RateLimitError{RequestError: requestErr, RetryAfter: retryAfter}
+
+	case http.StatusInternalServerError:
+		requestErr.message = fmt.Sprintf("internal-server-error: %s", body)
+		if isDuplicateKeyError(body) {
+			return nil, &ConflictError{RequestError: requestErr}
+		}
+		return nil, &requestErr
 	}
 
-	if readErr != nil {
-		return nil, statusCode, readErr
-	}
-	return body, statusCode, nil
+	requestErr.message = fmt.Sprintf("unexpected status code %d: %s, URL: %s", statusCode, body, req.URL)
+	return nil, &requestErr
 }
 
 // isHTTPCodeRetriable reports whether statusCode should trigger a retry. The
@@ -1,12 +1,15 @@
 package dbt_cloud
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // newRetryTestClient builds a Client pointed at the provided httptest.Server
@@ -22,6 +25,7 @@ func newRetryTestClient(t *testing.T, serverURL string, maxRetries int, retriabl
 	skipValidation := true
 
 	c, err := NewClient(
+		context.Background(),
 		&accountID,
 		&token,
 		&serverURL,
@@ -248,6 +252,36 @@ func TestDoRequestWithRetry_RetriesTransportError(t *testing.T) {
 	}
 }
 
+// TestDoRequestWithRetry_CancelledDuringBackoff ensures that cancelling the
+// context of the request, e.g. on Ctrl-C, interrupts the wait between retries
+// instead of sleeping until the next attempt.
+func TestDoRequestWithRetry_CancelledDuringBackoff(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "60")
+		http.Error(w, "slow down", http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 3, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/cancel/", nil)
+
+	start := time.Now()
+	_, err := c.doRequestWithRetry(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the backoff to be interrupted, waited %v", elapsed)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Fatalf("expected 1 attempt, got %d", got)
+	}
+}
+
 // TestDoRequestWithRetry_DisableRetryStillReturnsSuccess sanity-checks that
 // disabling retry produces exactly one attempt and returns the body
 // unchanged.
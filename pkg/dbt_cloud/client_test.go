@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"strings"
 	"testing"
 )
@@ -304,6 +305,7 @@ func TestNewClientTimeout(t *testing.T) {
 
 			// Create client
 			client, err := NewClient(
+				context.Background(),
 				&accountID,
 				&token,
 				&hostURL,
@@ -373,6 +375,7 @@ func TestNewClientValidation(t *testing.T) {
 			skipValidation := true
 
 			_, err := NewClient(
+				context.Background(),
 				&accountID,
 				tt.token,
 				tt.hostURL,
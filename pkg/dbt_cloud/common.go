@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -16,34 +17,8 @@ const (
 )
 
 var (
-	PermissionSets = []string{
-		"owner",
-		"member",
-		"account_admin",
-		"security_admin",
-		"billing_admin",
-		"admin",
-		"database_admin",
-		"git_admin",
-		"team_admin",
-		"job_admin",
-		"job_runner",
-		"job_viewer",
-		"analyst",
-		"developer",
-		"stakeholder",
-		"readonly",
-		"project_creator",
-		"account_viewer",
-		"metadata_only",
-		"semantic_layer_only",
-		"webhooks_only",
-		"fusion_admin",
-		"cost_management_viewer",
-		"cost_management_admin",
-		"manage_marketplace_apps",
-		"notification_manager",
-	}
+	// PermissionSets are the names of the permission sets of the catalog
+	PermissionSets = permissionSetNames(PermissionSetCatalog)
 )
 
 // This is not used now but allows us to find the list of permission sets allowed
@@ -57,8 +32,9 @@ type Constants struct {
 	PermissionSets map[string]string `json:"permissions_sets"`
 }
 
-func (c *Client) GetConstants() (*Constants, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetConstants(ctx context.Context) (*Constants, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf("%s/v2/constants/", c.HostURL),
 		nil,
@@ -81,8 +57,8 @@ func (c *Client) GetConstants() (*Constants, error) {
 	return &constantsResponse.Data, nil
 }
 
-func (c *Client) GetPermissionIDs() ([]string, error) {
-	constants, err := c.GetConstants()
+func (c *Client) GetPermissionIDs(ctx context.Context) ([]string, error) {
+	constants, err := c.GetConstants(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,8 +30,9 @@ type ConnectionCatalogConfig struct {
 }
 
 // GetConnectionCatalogConfig retrieves the catalog configuration for a connection
-func (c *Client) GetConnectionCatalogConfig(connectionID int64) (*ConnectionCatalogConfig, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetConnectionCatalogConfig(ctx context.Context, connectionID int64) (*ConnectionCatalogConfig, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/connections/%d/catalog-configs/",
@@ -61,6 +63,7 @@ func (c *Client) GetConnectionCatalogConfig(connectionID int64) (*ConnectionCata
 // UpdateConnectionCatalogConfig updates the catalog configuration for a connection
 // This is used for both Create and Update operations since there's no POST endpoint
 func (c *Client) UpdateConnectionCatalogConfig(
+	ctx context.Context,
 	connectionID int64,
 	config ConnectionCatalogConfig,
 ) (*ConnectionCatalogConfig, error) {
@@ -69,7 +72,8 @@ func (c *Client) UpdateConnectionCatalogConfig(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/connections/%d/catalog-configs/",
@@ -99,11 +103,12 @@ func (c *Client) UpdateConnectionCatalogConfig(
 
 // DeleteConnectionCatalogConfig "deletes" the catalog config by setting all fields to empty arrays
 // There's no DELETE endpoint, so we PATCH with empty arrays to clear the configuration
-func (c *Client) DeleteConnectionCatalogConfig(connectionID int64) error {
+func (c *Client) DeleteConnectionCatalogConfig(ctx context.Context, connectionID int64) error {
 	// Send empty arrays for all filter fields to clear the configuration
 	payload := `{"database_allow":[],"database_deny":[],"schema_allow":[],"schema_deny":[],"table_allow":[],"table_deny":[],"view_allow":[],"view_deny":[]}`
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/connections/%d/catalog-configs/",
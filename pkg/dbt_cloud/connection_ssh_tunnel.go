@@ -0,0 +1,100 @@
+package dbt_cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetConnectionSSHTunnel returns the SSH tunnel with the given ID. A
+// NotFoundError is returned when it doesn't exist or has been deleted.
+func (c *Client) GetConnectionSSHTunnel(
+	ctx context.Context,
+	encryptionID int64,
+) (*GlobalConnectionEncryptionPayload, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf(
+			"%s/v2/accounts/%d/encryptions/%d/",
+			c.HostURL,
+			c.AccountID,
+			encryptionID,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(globalConnectionEncryptionResponse)
+	err = json.Unmarshal(body, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Data.State == STATE_DELETED {
+		return nil, newNotFoundError("the SSH tunnel %d has been deleted", encryptionID)
+	}
+
+	return &resp.Data, nil
+}
+
+// DeleteConnectionSSHTunnel deletes the SSH tunnel, by updating it with
+// state=2 like the API expects
+func (c *Client) DeleteConnectionSSHTunnel(
+	ctx context.Context,
+	sshTunnel GlobalConnectionEncryptionPayload,
+) error {
+	sshTunnel.AccountID = int64(c.AccountID)
+	sshTunnel.State = STATE_DELETED
+	_, err := c.CreateUpdateEncryption(ctx, sshTunnel)
+	return err
+}
+
+// SetGlobalConnectionSSHTunnelEnabled sets whether the connection uses its SSH
+// tunnel, without changing the rest of its config
+func (c *Client) SetGlobalConnectionSSHTunnelEnabled(
+	ctx context.Context,
+	connectionID int64,
+	enabled bool,
+) error {
+	payload := struct {
+		AccountID          int64 `json:"account_id"`
+		IsSshTunnelEnabled bool  `json:"is_ssh_tunnel_enabled"`
+	}{
+		AccountID:          int64(c.AccountID),
+		IsSshTunnelEnabled: enabled,
+	}
+
+	buffer := new(bytes.Buffer)
+	err := json.NewEncoder(buffer).Encode(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PATCH",
+		fmt.Sprintf(
+			"%s/v3/accounts/%d/connections/%d/",
+			c.HostURL,
+			c.AccountID,
+			connectionID,
+		),
+		buffer,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequestWithRetry(req)
+	return err
+}
@@ -1,12 +1,14 @@
 package dbt_cloud
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) DeleteCredential(credentialId, projectId string) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteCredential(ctx context.Context, credentialId, projectId string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%s/credentials/%s/",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -55,10 +56,12 @@ type DatabricksCredentialGLobConnPatch struct {
 }
 
 func (c *Client) GetDatabricksCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*DatabricksCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -89,6 +92,7 @@ func (c *Client) GetDatabricksCredential(
 }
 
 func (c *Client) CreateDatabricksCredential(
+	ctx context.Context,
 	projectId int,
 	token string,
 	schema string,
@@ -122,7 +126,8 @@ func (c *Client) CreateDatabricksCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -151,6 +156,7 @@ func (c *Client) CreateDatabricksCredential(
 }
 
 func (c *Client) UpdateDatabricksCredentialGlobConn(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	databricksCredential DatabricksCredentialGLobConnPatch,
@@ -160,7 +166,8 @@ func (c *Client) UpdateDatabricksCredentialGlobConn(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
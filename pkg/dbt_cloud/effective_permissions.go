@@ -0,0 +1,134 @@
+package dbt_cloud
+
+import (
+	"cmp"
+	"context"
+	"slices"
+)
+
+// PermissionGrant is a permission set granted to a user through one of their
+// groups, or to a service token
+type PermissionGrant struct {
+	// GroupID is the group granting the permission, 0 for service tokens
+	GroupID                       int
+	Set                           string
+	ProjectID                     int
+	AllProjects                   bool
+	WritableEnvironmentCategories []EnvironmentCategory
+}
+
+// EffectivePermission is the result of merging all the grants applying to a
+// project, or to all projects when AllProjects is true
+type EffectivePermission struct {
+	ProjectID                     int
+	AllProjects                   bool
+	PermissionSets                []string
+	WritableEnvironmentCategories []EnvironmentCategory
+}
+
+// MergePermissionGrants merges the grants per project. The grants on all
+// projects are returned first, with AllProjects set, and are included in the
+// permissions of each project since they apply to them as well. The other
+// permissions are sorted by project ID, the permission sets and the
+// environment categories are sorted and deduplicated, and the environment
+// categories are reduced to `all` when it is one of them.
+func MergePermissionGrants(grants []PermissionGrant) []EffectivePermission {
+	allProjects := EffectivePermission{AllProjects: true}
+	projects := map[int]*EffectivePermission{}
+	for _, grant := range grants {
+		if grant.AllProjects {
+			allProjects.add(grant)
+			continue
+		}
+		if projects[grant.ProjectID] == nil {
+			projects[grant.ProjectID] = &EffectivePermission{ProjectID: grant.ProjectID}
+		}
+		projects[grant.ProjectID].add(grant)
+	}
+
+	permissions := []EffectivePermission{}
+	if len(allProjects.PermissionSets) > 0 {
+		permissions = append(permissions, allProjects.normalized())
+	}
+	for _, project := range projects {
+		project.PermissionSets = append(project.PermissionSets, allProjects.PermissionSets...)
+		project.WritableEnvironmentCategories = append(project.WritableEnvironmentCategories, allProjects.WritableEnvironmentCategories...)
+		permissions = append(permissions, project.normalized())
+	}
+	slices.SortFunc(permissions, func(a, b EffectivePermission) int {
+		if a.AllProjects != b.AllProjects {
+			if a.AllProjects {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.ProjectID, b.ProjectID)
+	})
+	return permissions
+}
+
+func (p *EffectivePermission) add(grant PermissionGrant) {
+	p.PermissionSets = append(p.PermissionSets, grant.Set)
+	p.WritableEnvironmentCategories = append(p.WritableEnvironmentCategories, grant.WritableEnvironmentCategories...)
+}
+
+func (p EffectivePermission) normalized() EffectivePermission {
+	p.PermissionSets = slices.Compact(slices.Sorted(slices.Values(p.PermissionSets)))
+	if slices.Contains(p.WritableEnvironmentCategories, EnvironmentCategory_All) {
+		p.WritableEnvironmentCategories = []EnvironmentCategory{EnvironmentCategory_All}
+	} else {
+		p.WritableEnvironmentCategories = slices.Compact(slices.Sorted(slices.Values(p.WritableEnvironmentCategories)))
+	}
+	if p.WritableEnvironmentCategories == nil {
+		p.WritableEnvironmentCategories = []EnvironmentCategory{}
+	}
+	return p
+}
+
+// GetUserPermissionGrants returns the permissions granted to the user by the
+// groups they are a member of in the account. A NotFoundError is returned
+// when the user is not an active member of the account.
+func (c *Client) GetUserPermissionGrants(ctx context.Context, userID int) ([]PermissionGrant, error) {
+	permission, err := c.GetUserPermission(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.GetAllGroups(ctx, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	grants := []PermissionGrant{}
+	for groupID, groupPermission := range permission.groupPermissions(groups) {
+		grants = append(grants, PermissionGrant{
+			GroupID:                       groupID,
+			Set:                           groupPermission.Set,
+			ProjectID:                     groupPermission.ProjectID,
+			AllProjects:                   groupPermission.AllProjects,
+			WritableEnvironmentCategories: groupPermission.WritableEnvironmentCategories,
+		})
+	}
+	return grants, nil
+}
+
+// GetServiceTokenPermissionGrants returns the permissions of the service token
+func (c *Client) GetServiceTokenPermissionGrants(ctx context.Context, serviceTokenID int) ([]PermissionGrant, error) {
+	serviceToken, err := c.GetServiceToken(ctx, serviceTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := []PermissionGrant{}
+	for _, permission := range serviceToken.Permissions {
+		if permission.State == STATE_DELETED {
+			continue
+		}
+		grants = append(grants, PermissionGrant{
+			Set:                           permission.Set,
+			ProjectID:                     permission.ProjectID,
+			AllProjects:                   permission.AllProjects,
+			WritableEnvironmentCategories: permission.WritableEnvs,
+		})
+	}
+	return grants, nil
+}
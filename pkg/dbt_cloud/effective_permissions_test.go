@@ -0,0 +1,63 @@
+package dbt_cloud_test
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergePermissionGrants(t *testing.T) {
+	tests := []struct {
+		name     string
+		grants   []dbt_cloud.PermissionGrant
+		expected []dbt_cloud.EffectivePermission
+	}{
+		{
+			name:     "no grants",
+			grants:   nil,
+			expected: []dbt_cloud.EffectivePermission{},
+		},
+		{
+			name: "grants of several groups on the same project",
+			grants: []dbt_cloud.PermissionGrant{
+				{GroupID: 1, Set: "developer", ProjectID: 100, WritableEnvironmentCategories: []string{"development"}},
+				{GroupID: 2, Set: "analyst", ProjectID: 100},
+				{GroupID: 2, Set: "developer", ProjectID: 100, WritableEnvironmentCategories: []string{"staging", "development"}},
+			},
+			expected: []dbt_cloud.EffectivePermission{
+				{ProjectID: 100, PermissionSets: []string{"analyst", "developer"}, WritableEnvironmentCategories: []string{"development", "staging"}},
+			},
+		},
+		{
+			name: "grants on all projects apply to each project",
+			grants: []dbt_cloud.PermissionGrant{
+				{GroupID: 1, Set: "job_admin", ProjectID: 200},
+				{GroupID: 1, Set: "job_viewer", AllProjects: true},
+				{GroupID: 2, Set: "developer", ProjectID: 100, WritableEnvironmentCategories: []string{"development"}},
+			},
+			expected: []dbt_cloud.EffectivePermission{
+				{AllProjects: true, PermissionSets: []string{"job_viewer"}, WritableEnvironmentCategories: []string{}},
+				{ProjectID: 100, PermissionSets: []string{"developer", "job_viewer"}, WritableEnvironmentCategories: []string{"development"}},
+				{ProjectID: 200, PermissionSets: []string{"job_admin", "job_viewer"}, WritableEnvironmentCategories: []string{}},
+			},
+		},
+		{
+			name: "all the environment categories",
+			grants: []dbt_cloud.PermissionGrant{
+				{Set: "developer", AllProjects: true, WritableEnvironmentCategories: []string{"all"}},
+				{Set: "developer", ProjectID: 100, WritableEnvironmentCategories: []string{"production"}},
+			},
+			expected: []dbt_cloud.EffectivePermission{
+				{AllProjects: true, PermissionSets: []string{"developer"}, WritableEnvironmentCategories: []string{"all"}},
+				{ProjectID: 100, PermissionSets: []string{"developer"}, WritableEnvironmentCategories: []string{"all"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, dbt_cloud.MergePermissionGrants(tt.grants))
+		})
+	}
+}
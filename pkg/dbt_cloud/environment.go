@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -38,8 +39,9 @@ type Environment struct {
 	PrimaryProfileID             *int                 `json:"primary_profile_id,omitempty"`
 }
 
-func (c *Client) GetEnvironment(projectId int, environmentId int) (*Environment, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetEnvironment(ctx context.Context, projectId int, environmentId int) (*Environment, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environments/%d/",
@@ -70,6 +72,7 @@ func (c *Client) GetEnvironment(projectId int, environmentId int) (*Environment,
 }
 
 func (c *Client) CreateEnvironment(
+	ctx context.Context,
 	isActive bool,
 	projectId int,
 	name string,
@@ -122,7 +125,8 @@ func (c *Client) CreateEnvironment(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environments/",
@@ -152,6 +156,7 @@ func (c *Client) CreateEnvironment(
 }
 
 func (c *Client) UpdateEnvironment(
+	ctx context.Context,
 	projectId int,
 	environmentId int,
 	environment Environment,
@@ -166,7 +171,8 @@ func (c *Client) UpdateEnvironment(
 	}
 
 	var payload = strings.NewReader(string(environmentData))
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environments/%d/",
@@ -196,8 +202,9 @@ func (c *Client) UpdateEnvironment(
 	return &environmentResponse.Data, nil
 }
 
-func (c *Client) DeleteEnvironment(projectId, environmentId int) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteEnvironment(ctx context.Context, projectId, environmentId int) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environments/%d/",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -44,11 +45,12 @@ type CreateEnvironmentVariableResponse struct {
 	Status ResponseStatus                           `json:"status"`
 }
 
-func (c *Client) GetEnvironmentVariable(
+func (c *Client) GetEnvironmentVariables(
+	ctx context.Context,
 	projectID int,
-	environmentVariableName string,
-) (*FullEnvironmentVariable, error) {
-	req, err := http.NewRequest(
+) (*EnvironmentVariablesGet, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environment-variables/environment/",
@@ -73,9 +75,22 @@ func (c *Client) GetEnvironmentVariable(
 		return nil, err
 	}
 
-	environmentsVariables, _ := environmentVariableResponse.Data.Variables[environmentVariableName]
+	return &environmentVariableResponse.Data, nil
+}
+
+func (c *Client) GetEnvironmentVariable(
+	ctx context.Context,
+	projectID int,
+	environmentVariableName string,
+) (*FullEnvironmentVariable, error) {
+	environmentVariables, err := c.GetEnvironmentVariables(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentsVariables, _ := environmentVariables.Variables[environmentVariableName]
 	if environmentsVariables == nil {
-		return nil, fmt.Errorf(
+		return nil, newNotFoundError(
 			"resource-not-found: Environment variables %s not found in project ID %d",
 			environmentVariableName,
 			projectID,
@@ -92,6 +107,7 @@ func (c *Client) GetEnvironmentVariable(
 }
 
 func (c *Client) CreateEnvironmentVariable(
+	ctx context.Context,
 	projectID int,
 	name string,
 	environmentValues map[string]string,
@@ -106,7 +122,8 @@ func (c *Client) CreateEnvironmentVariable(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environment-variables/bulk/",
@@ -140,6 +157,7 @@ func (c *Client) CreateEnvironmentVariable(
 }
 
 func (c *Client) UpdateEnvironmentVariable(
+	ctx context.Context,
 	projectID int,
 	environmentVariable AbstractedEnvironmentVariable,
 ) (*AbstractedEnvironmentVariable, error) {
@@ -154,7 +172,8 @@ func (c *Client) UpdateEnvironmentVariable(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environment-variables/bulk/",
@@ -189,6 +208,7 @@ func (c *Client) UpdateEnvironmentVariable(
 }
 
 func (c *Client) DeleteEnvironmentVariable(
+	ctx context.Context,
 	environmentVariableName string,
 	projectID int,
 ) (string, error) {
@@ -198,7 +218,8 @@ func (c *Client) DeleteEnvironmentVariable(
 	}
 
 	environmentVariableData, _ := json.Marshal(map[string]string{"name": environmentVariableName})
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environment-variables/bulk/",
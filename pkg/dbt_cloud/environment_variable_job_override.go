@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,11 +29,13 @@ type EnvironmentVariableJobOverrideAllResponse struct {
 }
 
 func (c *Client) GetEnvironmentVariableJobOverride(
+	ctx context.Context,
 	projectID int,
 	jobDefinitionID int,
 	environmentVariableOverrideID int,
 ) (*EnvironmentVariableJobOverride, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environment-variables/job/?job_definition_id=%d",
@@ -90,13 +93,14 @@ func (c *Client) GetEnvironmentVariableJobOverride(
 
 	}
 
-	return nil, fmt.Errorf(
+	return nil, newNotFoundError(
 		"resource-not-found: Did not find the override %d",
 		environmentVariableOverrideID,
 	)
 }
 
 func (c *Client) CreateEnvironmentVariableJobOverride(
+	ctx context.Context,
 	projectID int,
 	name string,
 	rawValue string,
@@ -118,7 +122,8 @@ func (c *Client) CreateEnvironmentVariableJobOverride(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environment-variables/",
@@ -147,6 +152,7 @@ func (c *Client) CreateEnvironmentVariableJobOverride(
 }
 
 func (c *Client) UpdateEnvironmentVariableJobOverride(
+	ctx context.Context,
 	projectID int,
 	environmentVariableJobOverrideID int,
 	environmentVariableJobOverride EnvironmentVariableJobOverride,
@@ -157,7 +163,8 @@ func (c *Client) UpdateEnvironmentVariableJobOverride(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environment-variables/%d/",
@@ -187,10 +194,12 @@ func (c *Client) UpdateEnvironmentVariableJobOverride(
 }
 
 func (c *Client) DeleteEnvironmentVariableJobOverride(
+	ctx context.Context,
 	projectID int,
 	environmentVariableJobOverrideID int,
 ) (string, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/environment-variables/%d/",
@@ -0,0 +1,108 @@
+package dbt_cloud
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RequestError holds the details of an unsuccessful dbt Cloud API request. It
+// is returned as is for the status codes without a dedicated error type and
+// is embedded in NotFoundError, PermissionError, ValidationError,
+// ConflictError and RateLimitError, which can be matched with errors.As.
+type RequestError struct {
+	StatusCode       int
+	Method           string
+	URL              string
+	UserMessage      string
+	DeveloperMessage string
+
+	message string
+}
+
+func (e *RequestError) Error() string {
+	return e.message
+}
+
+// NotFoundError is returned when the requested object does not exist, either
+// because the API answered with a 404, with a 400 to a GET request, or
+// because the object was not part of the listing it was looked up in.
+type NotFoundError struct {
+	RequestError
+
+	// MissingPermissions is set when the API reported that the object might
+	// exist but is not accessible with the token used.
+	MissingPermissions bool
+}
+
+// PermissionError is returned when the token used is not valid (401) or is not
+// allowed to perform the request (403).
+type PermissionError struct {
+	RequestError
+}
+
+// ValidationError is returned when the API rejected the content of a request
+// creating or updating an object (400 or 422).
+type ValidationError struct {
+	RequestError
+}
+
+// ConflictError is returned when the request conflicts with an existing
+// object, either with a 409 or because the API reported the violation of a
+// unique constraint, e.g. when creating an object that can only exist once.
+type ConflictError struct {
+	RequestError
+}
+
+// RateLimitError is returned when the API still rate limited the request
+// (429) once all the retries were used.
+type RateLimitError struct {
+	RequestError
+
+	// RetryAfter is the wait requested by the API before sending another
+	// request, it is 0 when the API did not send it.
+	RetryAfter time.Duration
+}
+
+// IsNotFound reports whether err, or any error it wraps, is a NotFoundError.
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr)
+}
+
+// IsPermissionError reports whether err is a PermissionError or a
+// NotFoundError the API attributed to missing permissions.
+func IsPermissionError(err error) bool {
+	var permissionErr *PermissionError
+	if errors.As(err, &permissionErr) {
+		return true
+	}
+	var notFoundErr *NotFoundError
+	return errors.As(err, &notFoundErr) && notFoundErr.MissingPermissions
+}
+
+// IsConflict reports whether err, or any error it wraps, is a ConflictError.
+func IsConflict(err error) bool {
+	var conflictErr *ConflictError
+	return errors.As(err, &conflictErr)
+}
+
+// isDuplicateKeyError reports whether the body of a response is the unique
+// constraint violation of the database, that some endpoints answer with a 400
+// or a 500 instead of a 409
+func isDuplicateKeyError(body []byte) bool {
+	return bytes.Contains(body, []byte("duplicate key value"))
+}
+
+// newNotFoundError returns the NotFoundError used when an object could not be
+// found in an otherwise successful response.
+func newNotFoundError(format string, args ...any) *NotFoundError {
+	return &NotFoundError{
+		RequestError: RequestError{
+			StatusCode: http.StatusNotFound,
+			message:    fmt.Sprintf(format, args...),
+		},
+	}
+}
@@ -0,0 +1,286 @@
+package dbt_cloud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestReadResponse_TypedErrors checks that each unsuccessful status is
+// returned as the matching error type, carrying the details of the request and
+// the messages of the API, while keeping the legacy error messages.
+func TestReadResponse_TypedErrors(t *testing.T) {
+	apiBody := func(code int, userMessage string) string {
+		return fmt.Sprintf(
+			`{"status":{"code":%d,"is_success":false,"user_message":%q,"developer_message":"dev details"},"data":null}`,
+			code,
+			userMessage,
+		)
+	}
+
+	cases := []struct {
+		name           string
+		method         string
+		status         int
+		body           string
+		check          func(t *testing.T, err error) *RequestError
+		errorSubstring string
+		userMessage    string
+	}{
+		{
+			name:   "404_get",
+			method: http.MethodGet,
+			status: http.StatusNotFound,
+			body:   apiBody(404, "Not found."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *NotFoundError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a NotFoundError, got %T", err)
+				}
+				if target.MissingPermissions {
+					t.Error("expected MissingPermissions to be false")
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "resource-not-found:",
+			userMessage:    "Not found.",
+		},
+		{
+			name:   "404_permissions",
+			method: http.MethodGet,
+			status: http.StatusNotFound,
+			body:   apiBody(404, "You do not have the proper permissions."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *NotFoundError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a NotFoundError, got %T", err)
+				}
+				if !target.MissingPermissions {
+					t.Error("expected MissingPermissions to be true")
+				}
+				if !IsPermissionError(err) {
+					t.Error("expected IsPermissionError to be true")
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "resource-not-found-permissions:",
+			userMessage:    "You do not have the proper permissions.",
+		},
+		{
+			name:   "404_unparsable",
+			method: http.MethodGet,
+			status: http.StatusNotFound,
+			body:   "not json",
+			check: func(t *testing.T, err error) *RequestError {
+				var target *NotFoundError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a NotFoundError, got %T", err)
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "resource-not-found (status 404)",
+		},
+		{
+			name:   "400_get",
+			method: http.MethodGet,
+			status: http.StatusBadRequest,
+			body:   apiBody(400, "Invalid id."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *NotFoundError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a NotFoundError, got %T", err)
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "resource-not-found:",
+			userMessage:    "Invalid id.",
+		},
+		{
+			name:   "400_post",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			body:   apiBody(400, "Name is required."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *ValidationError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a ValidationError, got %T", err)
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "bad-request:",
+			userMessage:    "Name is required.",
+		},
+		{
+			name:   "422_post",
+			method: http.MethodPost,
+			status: http.StatusUnprocessableEntity,
+			body:   apiBody(422, "Invalid cron."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *ValidationError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a ValidationError, got %T", err)
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "bad-request:",
+			userMessage:    "Invalid cron.",
+		},
+		{
+			name:   "409",
+			method: http.MethodPost,
+			status: http.StatusConflict,
+			body:   apiBody(409, "Already exists."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *ConflictError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a ConflictError, got %T", err)
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "conflict:",
+			userMessage:    "Already exists.",
+		},
+		{
+			name:   "400_duplicate_key",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			body:   apiBody(400, `duplicate key value violates unique constraint "azure_ad_application_account_id_key"`),
+			check: func(t *testing.T, err error) *RequestError {
+				if !IsConflict(err) {
+					t.Fatalf("expected a ConflictError, got %T", err)
+				}
+				var target *ConflictError
+				errors.As(err, &target)
+				return &target.RequestError
+			},
+			errorSubstring: "bad-request:",
+			userMessage:    `duplicate key value violates unique constraint "azure_ad_application_account_id_key"`,
+		},
+		{
+			name:   "401",
+			method: http.MethodGet,
+			status: http.StatusUnauthorized,
+			body:   apiBody(401, "Invalid token."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *PermissionError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a PermissionError, got %T", err)
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "unauthorized:",
+			userMessage:    "Invalid token.",
+		},
+		{
+			name:   "403",
+			method: http.MethodPost,
+			status: http.StatusForbidden,
+			body:   apiBody(403, "Forbidden."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *PermissionError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a PermissionError, got %T", err)
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "forbidden:",
+			userMessage:    "Forbidden.",
+		},
+		{
+			name:   "429",
+			method: http.MethodGet,
+			status: http.StatusTooManyRequests,
+			body:   apiBody(429, "Slow down."),
+			check: func(t *testing.T, err error) *RequestError {
+				var target *RateLimitError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a RateLimitError, got %T", err)
+				}
+				if target.RetryAfter != 2*time.Second {
+					t.Errorf("expected RetryAfter of 2s, got %s", target.RetryAfter)
+				}
+				return &target.RequestError
+			},
+			errorSubstring: "unexpected status code 429",
+			userMessage:    "Slow down.",
+		},
+		{
+			name:   "500",
+			method: http.MethodGet,
+			status: http.StatusInternalServerError,
+			body:   "boom",
+			check: func(t *testing.T, err error) *RequestError {
+				var target *RequestError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected a RequestError, got %T", err)
+				}
+				if IsNotFound(err) || IsPermissionError(err) || IsConflict(err) {
+					t.Error("expected a 500 to be neither a not found, a permission nor a conflict error")
+				}
+				return target
+			},
+			errorSubstring: "internal-server-error:",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.status == http.StatusTooManyRequests {
+					w.Header().Set("Retry-After", "2")
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			// a single attempt so that the 429 and 500 are not retried
+			c := newRetryTestClient(t, srv.URL, 1, nil)
+			req, _ := http.NewRequest(tc.method, srv.URL+"/objects/1/", nil)
+
+			_, err := c.doRequestWithRetry(req)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tc.errorSubstring) {
+				t.Errorf("expected error containing %q, got: %v", tc.errorSubstring, err)
+			}
+
+			// the typed errors must also be found when wrapped by the callers
+			requestErr := tc.check(t, fmt.Errorf("wrapped: %w", err))
+			if requestErr.StatusCode != tc.status {
+				t.Errorf("expected status code %d, got %d", tc.status, requestErr.StatusCode)
+			}
+			if requestErr.Method != tc.method {
+				t.Errorf("expected method %s, got %s", tc.method, requestErr.Method)
+			}
+			if requestErr.URL != srv.URL+"/objects/1/" {
+				t.Errorf("unexpected URL %s", requestErr.URL)
+			}
+			if requestErr.UserMessage != tc.userMessage {
+				t.Errorf("expected user message %q, got %q", tc.userMessage, requestErr.UserMessage)
+			}
+			if tc.userMessage != "" && requestErr.DeveloperMessage != "dev details" {
+				t.Errorf("expected developer message %q, got %q", "dev details", requestErr.DeveloperMessage)
+			}
+		})
+	}
+}
+
+func TestNewNotFoundError(t *testing.T) {
+	err := fmt.Errorf("reading: %w", newNotFoundError("resource-not-found: object %d not found", 12))
+
+	if !IsNotFound(err) {
+		t.Fatal("expected IsNotFound to be true")
+	}
+	if IsPermissionError(err) {
+		t.Error("expected IsPermissionError to be false")
+	}
+	if !strings.Contains(err.Error(), "resource-not-found: object 12 not found") {
+		t.Errorf("unexpected message: %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,8 +21,8 @@ type ExtendedAttributes struct {
 	ExtendedAttributes json.RawMessage `json:"extended_attributes"`
 }
 
-func (c *Client) GetExtendedAttributes(projectId int, extendedAttributesID int) (*ExtendedAttributes, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectId, extendedAttributesID), nil)
+func (c *Client) GetExtendedAttributes(ctx context.Context, projectId int, extendedAttributesID int) (*ExtendedAttributes, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectId, extendedAttributesID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +42,7 @@ func (c *Client) GetExtendedAttributes(projectId int, extendedAttributesID int)
 }
 
 func (c *Client) CreateExtendedAttributes(
+	ctx context.Context,
 	state int,
 	projectId int,
 	extendedAttributes json.RawMessage,
@@ -58,7 +60,7 @@ func (c *Client) CreateExtendedAttributes(
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/", c.HostURL, c.AccountID, projectId), strings.NewReader(string(newExtendedAttributesData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/", c.HostURL, c.AccountID, projectId), strings.NewReader(string(newExtendedAttributesData)))
 	if err != nil {
 		return nil, err
 	}
@@ -77,14 +79,14 @@ func (c *Client) CreateExtendedAttributes(
 	return &extendedAttributesResponse.Data, nil
 }
 
-func (c *Client) UpdateExtendedAttributes(projectId int, extendedAttributesID int, extendedAttributes ExtendedAttributes) (*ExtendedAttributes, error) {
+func (c *Client) UpdateExtendedAttributes(ctx context.Context, projectId int, extendedAttributesID int, extendedAttributes ExtendedAttributes) (*ExtendedAttributes, error) {
 
 	extendedAttributesData, err := json.Marshal(extendedAttributes)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectId, extendedAttributesID), strings.NewReader(string(extendedAttributesData)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectId, extendedAttributesID), strings.NewReader(string(extendedAttributesData)))
 	if err != nil {
 		return nil, err
 	}
@@ -103,8 +105,8 @@ func (c *Client) UpdateExtendedAttributes(projectId int, extendedAttributesID in
 	return &extendedAttributesResponse.Data, nil
 }
 
-func (c *Client) DeleteExtendedAttributes(projectId, extendedAttributesID int) (string, error) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectId, extendedAttributesID), nil)
+func (c *Client) DeleteExtendedAttributes(ctx context.Context, projectId, extendedAttributesID int) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v3/accounts/%d/projects/%d/extended-attributes/%d/", c.HostURL, c.AccountID, projectId, extendedAttributesID), nil)
 	if err != nil {
 		return "", err
 	}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -39,10 +40,12 @@ type FabricCredential struct {
 }
 
 func (c *Client) GetFabricCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*FabricCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -72,6 +75,7 @@ func (c *Client) GetFabricCredential(
 }
 
 func (c *Client) CreateFabricCredential(
+	ctx context.Context,
 	projectId int,
 	user string,
 	password string,
@@ -110,7 +114,8 @@ func (c *Client) CreateFabricCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -360,6 +365,7 @@ func GenerateFabricCredentialDetails(
 }
 
 func (c *Client) UpdateFabricCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	fabricCredential FabricCredential,
@@ -369,7 +375,8 @@ func (c *Client) UpdateFabricCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -0,0 +1,622 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// call is a request being handled by a route.
+type call struct {
+	w      http.ResponseWriter
+	r      *http.Request
+	params map[string]string
+	body   []byte
+}
+
+type route struct {
+	pattern *regexp.Regexp
+	handler func(s *Server, c *call)
+}
+
+// match returns the path parameters when the path matches the route, the
+// trailing slash is optional as the client is not consistent about it.
+func (rt route) match(path string) map[string]string {
+	matches := rt.pattern.FindStringSubmatch(path)
+	if matches == nil {
+		return nil
+	}
+	params := map[string]string{}
+	for i, name := range rt.pattern.SubexpNames() {
+		if name != "" {
+			params[name] = matches[i]
+		}
+	}
+	return params
+}
+
+// placeholder matches the {name} path parameters, once escaped by QuoteMeta.
+var placeholder = regexp.MustCompile(`\\\{(\w+)\\\}`)
+
+func newRoute(path string, handler func(s *Server, c *call)) route {
+	pattern := placeholder.ReplaceAllString(regexp.QuoteMeta(path), `(?P<$1>[^/]+)`)
+	return route{
+		pattern: regexp.MustCompile("^" + pattern + "/?$"),
+		handler: handler,
+	}
+}
+
+// crudRoutes returns the routes to list and create the objects of a
+// collection under path, and to read, update and delete them under path/{id}.
+// The other path parameters are stored in the objects and filter them.
+func crudRoutes(path, collection string) []route {
+	return []route{
+		newRoute(path, func(s *Server, c *call) {
+			switch c.r.Method {
+			case http.MethodGet:
+				s.listObjects(c, collection)
+			case http.MethodPost:
+				s.createObject(c, collection)
+			default:
+				writeMethodNotAllowed(c)
+			}
+		}),
+		newRoute(path+"/{id}", func(s *Server, c *call) {
+			switch c.r.Method {
+			case http.MethodGet:
+				s.getObject(c, collection)
+			case http.MethodPost, http.MethodPut, http.MethodPatch:
+				s.updateObject(c, collection)
+			case http.MethodDelete:
+				s.deleteObject(c, collection)
+			default:
+				writeMethodNotAllowed(c)
+			}
+		}),
+	}
+}
+
+// replaceRoute returns the route listing the objects of a collection linked
+// to a parent, and replacing all of them with the list sent with a POST, like
+// the permissions of the groups and of the service tokens.
+func replaceRoute(path, collection, parentField string) route {
+	return newRoute(path, func(s *Server, c *call) {
+		switch c.r.Method {
+		case http.MethodGet:
+			s.listObjects(c, collection)
+		case http.MethodPost:
+			s.replaceObjects(c, collection, parentField)
+		default:
+			writeMethodNotAllowed(c)
+		}
+	})
+}
+
+var routes = concatRoutes(
+	[]route{
+		newRoute("/v2/accounts", func(s *Server, c *call) {
+			writeData(c.w, http.StatusOK, []Object{{
+				"id":   s.AccountID,
+				"name": "Fake account",
+			}}, nil)
+		}),
+		newRoute("/v2/whoami", func(s *Server, c *call) {
+			users := s.store.objects[Users]
+			if len(users) == 0 {
+				writeError(c.w, http.StatusNotFound, "No user was seeded in the fake.")
+				return
+			}
+			writeData(c.w, http.StatusOK, Object{"user": clone(users[0])}, nil)
+		}),
+		newRoute("/v3/accounts/{account_id}/projects/{project_id}/environment-variables/environment", (*Server).getEnvironmentVariables),
+		newRoute("/v3/accounts/{account_id}/projects/{project_id}/environment-variables/bulk", (*Server).bulkEnvironmentVariables),
+		replaceRoute("/v3/accounts/{account_id}/group-permissions/{group_id}", GroupPermissions, "group_id"),
+		replaceRoute("/v3/accounts/{account_id}/service-tokens/{service_token_id}/permissions", ServiceTokenPermissions, "service_token_id"),
+		newRoute("/v3/accounts/{account_id}/invites", func(s *Server, c *call) {
+			switch c.r.Method {
+			case http.MethodGet:
+				s.listObjects(c, Invites)
+			case http.MethodPost:
+				s.createInvite(c)
+			default:
+				writeMethodNotAllowed(c)
+			}
+		}),
+		newRoute("/v3/accounts/{account_id}/invites/{id}/resend", (*Server).resendInvite),
+		newRoute("/v3/accounts/{account_id}/webhooks/subscriptions", func(s *Server, c *call) {
+			switch c.r.Method {
+			case http.MethodGet:
+				s.listObjects(c, Webhooks)
+			case http.MethodPost:
+				s.createObject(c, Webhooks)
+			default:
+				writeMethodNotAllowed(c)
+			}
+		}),
+	},
+	crudRoutes("/v3/accounts/{account_id}/projects", Projects),
+	crudRoutes("/v3/accounts/{account_id}/projects/{project_id}/environments", Environments),
+	crudRoutes("/v3/accounts/{account_id}/environments", Environments),
+	crudRoutes("/v3/accounts/{account_id}/projects/{project_id}/credentials", Credentials),
+	crudRoutes("/v3/accounts/{account_id}/projects/{project_id}/repositories", Repositories),
+	crudRoutes("/v2/accounts/{account_id}/jobs", Jobs),
+	crudRoutes("/v3/accounts/{account_id}/connections", Connections),
+	crudRoutes("/v2/accounts/{account_id}/encryptions", Encryptions),
+	crudRoutes("/v2/accounts/{account_id}/notifications", Notifications),
+	crudRoutes("/v3/accounts/{account_id}/groups", Groups),
+	crudRoutes("/v3/accounts/{account_id}/users", Users),
+	crudRoutes("/v2/accounts/{account_id}/users", Users)[1:],
+	crudRoutes("/v2/accounts/{account_id}/permissions", UserPermissions)[1:],
+	// the invites are created with createInvite
+	crudRoutes("/v3/accounts/{account_id}/invites", Invites)[1:],
+	crudRoutes("/v3/accounts/{account_id}/service-tokens", ServiceTokens),
+	// the webhooks are read, updated and deleted under the singular path
+	crudRoutes("/v3/accounts/{account_id}/webhooks/subscription", Webhooks)[1:],
+)
+
+func concatRoutes(groups ...[]route) []route {
+	all := []route{}
+	for _, group := range groups {
+		all = append(all, group...)
+	}
+	return all
+}
+
+func writeMethodNotAllowed(c *call) {
+	writeError(c.w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s is not allowed.", c.r.Method))
+}
+
+// pathFields returns the path parameters stored in the objects.
+func (c *call) pathFields() map[string]string {
+	fields := map[string]string{}
+	for name, value := range c.params {
+		if name != "account_id" && name != "id" {
+			fields[name] = value
+		}
+	}
+	return fields
+}
+
+// decodeObject decodes the body as a JSON object, writing a 400 when it is
+// not one.
+func (c *call) decodeObject() (Object, bool) {
+	var object Object
+	if err := json.Unmarshal(c.body, &object); err != nil || object == nil {
+		writeError(c.w, http.StatusBadRequest, "The request body must be a JSON object.")
+		return nil, false
+	}
+	return object, true
+}
+
+// lookup returns the object of the path, checking that it belongs to the
+// parents of the path, or writes a 404.
+func (s *Server) lookup(c *call, collection string) Object {
+	object := s.store.find(collection, c.params["id"])
+	if object != nil {
+		for name, value := range c.pathFields() {
+			if fieldString(object[name]) != value {
+				object = nil
+				break
+			}
+		}
+	}
+	if object == nil {
+		writeError(c.w, http.StatusNotFound, "The requested resource was not found.")
+	}
+	return object
+}
+
+// create stores a new object, setting the fields computed by the API.
+func (s *Server) create(collection string, object Object) Object {
+	object = s.store.insert(collection, object)
+	if onCreate := collectionConfigs[collection].onCreate; onCreate != nil {
+		onCreate(s, object)
+	}
+	return object
+}
+
+// render returns the object as sent in the responses, with its children and
+// the related objects requested.
+func (s *Server) render(c *call, collection string, object Object) Object {
+	rendered := clone(object)
+	config := collectionConfigs[collection]
+
+	for field, child := range config.children {
+		id := fieldString(object["id"])
+		children := []Object{}
+		for _, childObject := range s.store.filter(child.collection, func(o Object) bool {
+			return fieldString(o[child.field]) == id
+		}) {
+			children = append(children, clone(childObject))
+		}
+		rendered[field] = children
+	}
+
+	for _, name := range parseIncludeRelated(c.r.URL.Query().Get("include_related")) {
+		related, ok := config.related[name]
+		if !ok {
+			continue
+		}
+		if relatedObject := s.store.find(related.collection, fieldString(object[related.field])); relatedObject != nil {
+			rendered[name] = clone(relatedObject)
+		} else {
+			rendered[name] = nil
+		}
+	}
+	return rendered
+}
+
+func (s *Server) listObjects(c *call, collection string) {
+	query := c.r.URL.Query()
+	pathFields := c.pathFields()
+
+	objects := s.store.filter(collection, func(object Object) bool {
+		for name, value := range pathFields {
+			if fieldString(object[name]) != value {
+				return false
+			}
+		}
+		return matchesQuery(object, query)
+	})
+	sortObjects(objects, query.Get("order_by"))
+
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 || limit > s.pageSize {
+		limit = s.pageSize
+	}
+	total := len(objects)
+	offset = min(max(offset, 0), total)
+	page := objects[offset:min(offset+limit, total)]
+
+	data := []Object{}
+	for _, object := range page {
+		data = append(data, s.render(c, collection, object))
+	}
+	writeData(c.w, http.StatusOK, data, Object{
+		"filters": Object{"limit": limit, "offset": offset},
+		"pagination": Object{
+			"count":       len(data),
+			"total_count": total,
+		},
+	})
+}
+
+func (s *Server) createObject(c *call, collection string) {
+	object, ok := c.decodeObject()
+	if !ok {
+		return
+	}
+	object["account_id"] = s.AccountID
+	for name, value := range c.pathFields() {
+		object[name] = pathValue(value)
+	}
+	object = s.create(collection, object)
+	rendered := s.render(c, collection, object)
+	if onCreateResponse := collectionConfigs[collection].onCreateResponse; onCreateResponse != nil {
+		onCreateResponse(s, rendered)
+	}
+	writeData(c.w, http.StatusCreated, rendered, nil)
+}
+
+func (s *Server) getObject(c *call, collection string) {
+	if object := s.lookup(c, collection); object != nil {
+		writeData(c.w, http.StatusOK, s.render(c, collection, object), nil)
+	}
+}
+
+// updateObject merges the fields sent in the object, setting the state to 2
+// deletes it like with the real API.
+func (s *Server) updateObject(c *call, collection string) {
+	object := s.lookup(c, collection)
+	if object == nil {
+		return
+	}
+	update, ok := c.decodeObject()
+	if !ok {
+		return
+	}
+
+	// the identifiers can't be changed
+	for _, field := range []string{"id", "account_id", "created_at"} {
+		delete(update, field)
+	}
+	for name := range c.pathFields() {
+		delete(update, name)
+	}
+	merge(object, update)
+	object["updated_at"] = s.store.now()
+
+	rendered := s.render(c, collection, object)
+	if isDeleted(object) {
+		s.store.remove(collection, c.params["id"])
+	}
+	writeData(c.w, http.StatusOK, rendered, nil)
+}
+
+func (s *Server) deleteObject(c *call, collection string) {
+	object := s.lookup(c, collection)
+	if object == nil {
+		return
+	}
+	rendered := s.render(c, collection, object)
+	s.store.remove(collection, c.params["id"])
+	writeData(c.w, http.StatusOK, rendered, nil)
+}
+
+// replaceObjects replaces all the objects of the parent with the list sent.
+func (s *Server) replaceObjects(c *call, collection, parentField string) {
+	var objects []Object
+	if err := json.Unmarshal(c.body, &objects); err != nil {
+		writeError(c.w, http.StatusBadRequest, "The request body must be a JSON list.")
+		return
+	}
+
+	parentID := c.params[parentField]
+	for _, existing := range s.store.filter(collection, func(o Object) bool {
+		return fieldString(o[parentField]) == parentID
+	}) {
+		s.store.remove(collection, fieldString(existing["id"]))
+	}
+
+	data := []Object{}
+	for _, object := range objects {
+		if object == nil {
+			continue
+		}
+		delete(object, "id")
+		object["account_id"] = s.AccountID
+		object[parentField] = pathValue(parentID)
+		data = append(data, clone(s.create(collection, object)))
+	}
+	writeData(c.w, http.StatusOK, data, nil)
+}
+
+// getEnvironmentVariables returns the values of the environment variables of
+// the project, grouped by variable and then by environment name.
+func (s *Server) getEnvironmentVariables(c *call) {
+	if c.r.Method != http.MethodGet {
+		writeMethodNotAllowed(c)
+		return
+	}
+	projectID := c.params["project_id"]
+
+	environments := []string{}
+	for _, environment := range s.store.filter(Environments, func(o Object) bool {
+		return fieldString(o["project_id"]) == projectID
+	}) {
+		environments = append(environments, fieldString(environment["name"]))
+	}
+
+	variables := map[string]map[string]Object{}
+	for _, value := range s.projectEnvironmentVariables(projectID, "") {
+		name := fieldString(value["name"])
+		if variables[name] == nil {
+			variables[name] = map[string]Object{}
+		}
+		variables[name][fieldString(value["environment_name"])] = Object{
+			"id":    value["id"],
+			"value": value["value"],
+		}
+	}
+
+	writeData(c.w, http.StatusOK, Object{
+		"environments": environments,
+		"variables":    variables,
+	}, nil)
+}
+
+// bulkEnvironmentVariables creates (POST), updates (PUT) and deletes (DELETE)
+// all the values of an environment variable. Each value is stored as its own
+// object, with the name of its environment or "project" for the default.
+func (s *Server) bulkEnvironmentVariables(c *call) {
+	projectID := c.params["project_id"]
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(c.body, &body); err != nil {
+		writeError(c.w, http.StatusBadRequest, "The request body must be a JSON object.")
+		return
+	}
+
+	switch c.r.Method {
+	case http.MethodPost:
+		var values map[string]string
+		if err := json.Unmarshal(body["env_var"], &values); err != nil {
+			writeError(c.w, http.StatusBadRequest, "env_var must map environment names to values.")
+			return
+		}
+		name := values["new_name"]
+		delete(values, "new_name")
+		if !strings.HasPrefix(name, "DBT_") {
+			writeError(c.w, http.StatusBadRequest, "Environment variable names must start with DBT_.")
+			return
+		}
+		if len(s.projectEnvironmentVariables(projectID, name)) > 0 {
+			writeError(c.w, http.StatusBadRequest, fmt.Sprintf("Environment variable %s already exists.", name))
+			return
+		}
+		if !s.validEnvironmentNames(c, projectID, values) {
+			return
+		}
+		ids := []any{}
+		for environmentName, value := range values {
+			object := s.create(EnvironmentVariables, Object{
+				"account_id":       s.AccountID,
+				"project_id":       pathValue(projectID),
+				"name":             name,
+				"environment_name": environmentName,
+				"value":            value,
+			})
+			ids = append(ids, object["id"])
+		}
+		writeData(c.w, http.StatusCreated, Object{
+			"message":     "Environment variable created.",
+			"new_var_ids": ids,
+		}, nil)
+
+	case http.MethodPut:
+		var values map[string]string
+		if err := json.Unmarshal(body["env_vars"], &values); err != nil {
+			writeError(c.w, http.StatusBadRequest, "env_vars must map environment names to values.")
+			return
+		}
+		name := values["name"]
+		delete(values, "name")
+		existing := s.projectEnvironmentVariables(projectID, name)
+		if len(existing) == 0 {
+			writeError(c.w, http.StatusNotFound, fmt.Sprintf("Environment variable %s was not found.", name))
+			return
+		}
+		// the existing values can be sent by ID instead of environment name
+		byEnvironment := map[string]string{}
+		for key, value := range values {
+			for _, object := range existing {
+				if fieldString(object["id"]) == key {
+					key = fieldString(object["environment_name"])
+					break
+				}
+			}
+			byEnvironment[key] = value
+		}
+		if !s.validEnvironmentNames(c, projectID, byEnvironment) {
+			return
+		}
+		for environmentName, value := range byEnvironment {
+			updated := false
+			for _, object := range existing {
+				if fieldString(object["environment_name"]) != environmentName {
+					continue
+				}
+				updated = true
+				if value == "" {
+					s.store.remove(EnvironmentVariables, fieldString(object["id"]))
+				} else {
+					object["value"] = value
+				}
+			}
+			if !updated && value != "" {
+				s.create(EnvironmentVariables, Object{
+					"account_id":       s.AccountID,
+					"project_id":       pathValue(projectID),
+					"name":             name,
+					"environment_name": environmentName,
+					"value":            value,
+				})
+			}
+		}
+		writeData(c.w, http.StatusOK, Object{"message": "Environment variable updated."}, nil)
+
+	case http.MethodDelete:
+		var name string
+		if err := json.Unmarshal(body["name"], &name); err != nil {
+			writeError(c.w, http.StatusBadRequest, "name must be the name of the environment variable.")
+			return
+		}
+		existing := s.projectEnvironmentVariables(projectID, name)
+		if len(existing) == 0 {
+			writeError(c.w, http.StatusNotFound, fmt.Sprintf("Environment variable %s was not found.", name))
+			return
+		}
+		for _, object := range existing {
+			s.store.remove(EnvironmentVariables, fieldString(object["id"]))
+		}
+		writeData(c.w, http.StatusOK, Object{"message": "Environment variable deleted."}, nil)
+
+	default:
+		writeMethodNotAllowed(c)
+	}
+}
+
+// createInvite creates a pending invite, rejecting the emails of the members
+// of the account and of the other pending invites.
+func (s *Server) createInvite(c *call) {
+	object, ok := c.decodeObject()
+	if !ok {
+		return
+	}
+	email := fieldString(object["email"])
+	if email == "" {
+		writeError(c.w, http.StatusBadRequest, "email is required.")
+		return
+	}
+	for _, invite := range s.store.objects[Invites] {
+		if strings.EqualFold(fieldString(invite["email"]), email) && invite["status"] == "pending" {
+			writeError(c.w, http.StatusBadRequest, fmt.Sprintf("%s was already invited.", email))
+			return
+		}
+	}
+	if s.activeMember(email) {
+		writeError(c.w, http.StatusBadRequest, fmt.Sprintf("%s is already a member of the account.", email))
+		return
+	}
+
+	object["account_id"] = s.AccountID
+	object = s.create(Invites, object)
+	writeData(c.w, http.StatusCreated, s.render(c, Invites, object), nil)
+}
+
+// resendInvite counts the emails sent for a pending invite.
+func (s *Server) resendInvite(c *call) {
+	if c.r.Method != http.MethodPost {
+		writeMethodNotAllowed(c)
+		return
+	}
+	invite := s.lookup(c, Invites)
+	if invite == nil {
+		return
+	}
+	if invite["status"] != "pending" {
+		writeError(c.w, http.StatusBadRequest, "Only pending invites can be resent.")
+		return
+	}
+	sentCount, _ := strconv.Atoi(fieldString(invite["sent_count"]))
+	invite["sent_count"] = sentCount + 1
+	invite["updated_at"] = s.store.now()
+	writeData(c.w, http.StatusOK, s.render(c, Invites, invite), nil)
+}
+
+// activeMember reports whether a user with the email has an active permission
+// in the account.
+func (s *Server) activeMember(email string) bool {
+	for _, user := range s.store.objects[Users] {
+		if !strings.EqualFold(fieldString(user["email"]), email) {
+			continue
+		}
+		userID := fieldString(user["id"])
+		if len(s.store.filter(UserPermissions, func(o Object) bool {
+			return fieldString(o["user_id"]) == userID
+		})) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// projectEnvironmentVariables returns the values of the environment variables
+// of the project, only the ones of the variable name when not empty.
+func (s *Server) projectEnvironmentVariables(projectID, name string) []Object {
+	return s.store.filter(EnvironmentVariables, func(o Object) bool {
+		return fieldString(o["project_id"]) == projectID && (name == "" || o["name"] == name)
+	})
+}
+
+// validEnvironmentNames checks that the values are for "project" or for
+// environments of the project, writing a 400 otherwise.
+func (s *Server) validEnvironmentNames(c *call, projectID string, values map[string]string) bool {
+	for environmentName := range values {
+		if environmentName == "project" {
+			continue
+		}
+		environments := s.store.filter(Environments, func(o Object) bool {
+			return fieldString(o["project_id"]) == projectID && o["name"] == environmentName
+		})
+		if len(environments) == 0 {
+			writeError(c.w, http.StatusBadRequest, fmt.Sprintf("Environment %s does not exist in the project.", environmentName))
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,320 @@
+// Package fakeapi provides a stateful in-memory fake of the dbt Cloud v2 and
+// v3 APIs, so that the client and the provider resources can be tested
+// without network access or a dbt Cloud account.
+//
+// The objects created through the API are kept until they are deleted, either
+// with a DELETE request or by setting their state to 2, after which they are
+// reported as not found like the real API does. Listings are paginated and
+// filtered by their query parameters, and errors can be injected with
+// InjectFault and DenyAccess.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// DefaultAccountID is the ID of the account served by the fake
+	DefaultAccountID = 1
+	// DefaultToken is the only token accepted by the fake
+	DefaultToken = "fake-dbt-cloud-token"
+	// DefaultPageSize is the maximum number of objects returned per page,
+	// the same as the real API
+	DefaultPageSize = 100
+)
+
+// Request is a request received by the fake server.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Body   []byte
+}
+
+// Fault describes an error returned instead of the normal response.
+type Fault struct {
+	// Method only matches the requests with this method, all the methods
+	// match when empty
+	Method string
+	// Path only matches the requests whose path starts with it, all the
+	// paths match when empty
+	Path string
+	// StatusCode is the status code returned
+	StatusCode int
+	// UserMessage is returned in the status of the error body
+	UserMessage string
+	// RetryAfter sets the Retry-After header when not zero
+	RetryAfter time.Duration
+	// Times is the number of requests failing before the fault is removed,
+	// all the matching requests fail when 0
+	Times int
+}
+
+// Server is the fake dbt Cloud API, started with NewServer.
+type Server struct {
+	*httptest.Server
+
+	AccountID int64
+	Token     string
+
+	mu       sync.Mutex
+	pageSize int
+	store    *store
+	faults   []*Fault
+	requests []Request
+}
+
+// NewServer starts a fake serving an empty account, it is closed at the end
+// of the test.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		AccountID: DefaultAccountID,
+		Token:     DefaultToken,
+		pageSize:  DefaultPageSize,
+		store:     newStore(),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// ProviderConfig returns the provider block configuring the provider to use
+// the fake, to prepend to the configurations of the test steps.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "dbtcloud" {
+  account_id             = %d
+  token                  = %q
+  host_url               = %q
+  retry_interval_seconds = 0
+}
+`, s.AccountID, s.Token, s.URL)
+}
+
+// SetPageSize changes the number of objects returned per page, to test the
+// pagination with a few objects.
+func (s *Server) SetPageSize(pageSize int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pageSize = pageSize
+}
+
+// Seed adds an object to the collection, as if it had been created with the
+// API, and returns it with its ID.
+func (s *Server) Seed(collection string, object Object) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object = clone(object)
+	object["account_id"] = s.AccountID
+	return clone(s.create(collection, object))
+}
+
+// Get returns a copy of the object of the collection with the ID, or nil if
+// it doesn't exist.
+func (s *Server) Get(collection string, id any) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object := s.store.find(collection, fieldString(id))
+	if object == nil {
+		return nil
+	}
+	return clone(object)
+}
+
+// List returns a copy of all the objects of the collection.
+func (s *Server) List(collection string) []Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	objects := []Object{}
+	for _, object := range s.store.objects[collection] {
+		objects = append(objects, clone(object))
+	}
+	return objects
+}
+
+// AcceptInvite accepts the pending invite with the ID as the invited user
+// would, creating the user with the license type and the groups of the invite.
+// It returns the user, or nil when there is no pending invite with the ID.
+func (s *Server) AcceptInvite(inviteID any) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invite := s.store.find(Invites, fieldString(inviteID))
+	if invite == nil || invite["status"] != "pending" {
+		return nil
+	}
+
+	user := s.create(Users, Object{
+		"account_id": s.AccountID,
+		"email":      invite["email"],
+	})
+	groups := []any{}
+	if groupIDs, ok := invite["group_ids"].([]any); ok {
+		for _, groupID := range groupIDs {
+			groups = append(groups, Object{"id": groupID})
+		}
+	}
+	s.create(UserPermissions, Object{
+		"account_id":   s.AccountID,
+		"user_id":      user["id"],
+		"license_type": invite["license_type"],
+		"groups":       groups,
+	})
+
+	invite["status"] = "accepted"
+	invite["user_id"] = user["id"]
+	invite["updated_at"] = s.store.now()
+	return clone(user)
+}
+
+// InjectFault makes the matching requests fail.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// DenyAccess makes the matching requests fail with a 403, like when the token
+// doesn't have the permissions required.
+func (s *Server) DenyAccess(method, path string) {
+	s.InjectFault(Fault{
+		Method:      method,
+		Path:        path,
+		StatusCode:  http.StatusForbidden,
+		UserMessage: "You do not have permission to perform this action.",
+	})
+}
+
+// ClearFaults removes all the faults injected.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Could not read the request body.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Body:   body,
+	})
+
+	if r.Header.Get("Authorization") != "Token "+s.Token {
+		writeError(w, http.StatusUnauthorized, "Invalid token.")
+		return
+	}
+
+	if fault := s.matchFault(r); fault != nil {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter.Seconds())))
+		}
+		writeError(w, fault.StatusCode, fault.UserMessage)
+		return
+	}
+
+	for _, route := range routes {
+		params := route.match(r.URL.Path)
+		if params == nil {
+			continue
+		}
+		if accountID, ok := params["account_id"]; ok && accountID != strconv.FormatInt(s.AccountID, 10) {
+			writeError(w, http.StatusForbidden, "You do not have access to this account.")
+			return
+		}
+		route.handler(s, &call{w: w, r: r, params: params, body: body})
+		return
+	}
+
+	// the real API answers with a 404 without status when the URL is wrong,
+	// which the client doesn't report as a missing object
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"detail": fmt.Sprintf("fakeapi: no route for %s %s", r.Method, r.URL.Path),
+	})
+}
+
+// matchFault returns the first fault matching the request, consuming it.
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, fault := range s.faults {
+		if fault.Method != "" && fault.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, fault.Path) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// writeData writes a successful response with the API envelope.
+func writeData(w http.ResponseWriter, statusCode int, data any, extra any) {
+	response := map[string]any{
+		"data": data,
+		"status": map[string]any{
+			"code":              statusCode,
+			"is_success":        true,
+			"user_message":      "Success!",
+			"developer_message": "",
+		},
+	}
+	if extra != nil {
+		response["extra"] = extra
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// writeError writes an error response with the API envelope.
+func writeError(w http.ResponseWriter, statusCode int, userMessage string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"data": nil,
+		"status": map[string]any{
+			"code":              statusCode,
+			"is_success":        false,
+			"user_message":      userMessage,
+			"developer_message": "",
+		},
+	})
+}
@@ -0,0 +1,492 @@
+package fakeapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+)
+
+// newClient returns a client of the fake, retrying the errors immediately.
+func newClient(t *testing.T, s *fakeapi.Server) *dbt_cloud.Client {
+	t.Helper()
+	t.Setenv("TF_ACC", "1")
+
+	accountID := s.AccountID
+	token := s.Token
+	hostURL := s.URL
+	maxRetries := 3
+	retryInterval := 0
+	timeout := 5
+
+	client, err := dbt_cloud.NewClient(
+		context.Background(),
+		&accountID,
+		&token,
+		&hostURL,
+		&maxRetries,
+		&retryInterval,
+		nil,
+		true,
+		&timeout,
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return client
+}
+
+func TestServer_ProjectLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	created, err := client.CreateProject(ctx, "Analytics", "The analytics project", "", 0)
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	projectID := fmt.Sprint(*created.ID)
+
+	project, err := client.GetProject(ctx, projectID)
+	if err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+	if project.Name != "Analytics" || project.AccountID != s.AccountID {
+		t.Errorf("unexpected project %+v", project)
+	}
+
+	project.Description = "Updated"
+	if _, err := client.UpdateProject(ctx, projectID, *project); err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	if got := s.Get(fakeapi.Projects, projectID)["description"]; got != "Updated" {
+		t.Errorf("expected the description to be updated, got %v", got)
+	}
+
+	// the provider deletes the projects by setting their state
+	project.State = dbt_cloud.STATE_DELETED
+	if _, err := client.UpdateProject(ctx, projectID, *project); err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	_, err = client.GetProject(ctx, projectID)
+	if !dbt_cloud.IsNotFound(err) {
+		t.Fatalf("expected a NotFoundError once deleted, got %v", err)
+	}
+}
+
+func TestServer_EnvironmentsAreScopedToTheirProject(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	project := s.Seed(fakeapi.Projects, fakeapi.Object{"name": "Analytics"})
+	otherProject := s.Seed(fakeapi.Projects, fakeapi.Object{"name": "Marketing"})
+	projectID := project["id"].(float64)
+
+	environment, err := client.CreateEnvironment(ctx, true, int(projectID), "Production", "latest", "deployment", false, "", 0, "production", 0, 0, false, 0)
+	if err != nil {
+		t.Fatalf("CreateEnvironment: %v", err)
+	}
+	s.Seed(fakeapi.Environments, fakeapi.Object{"name": "Other", "project_id": otherProject["id"]})
+
+	environments, err := client.GetAllEnvironments(ctx, int(projectID))
+	if err != nil {
+		t.Fatalf("GetAllEnvironments: %v", err)
+	}
+	if len(environments) != 1 || environments[0].Name != "Production" {
+		t.Fatalf("expected only the environment of the project, got %+v", environments)
+	}
+
+	if _, err := client.GetEnvironment(ctx, int(otherProject["id"].(float64)), *environment.ID); !dbt_cloud.IsNotFound(err) {
+		t.Errorf("expected a NotFoundError when reading through another project, got %v", err)
+	}
+
+	if _, err := client.DeleteEnvironment(ctx, int(projectID), *environment.ID); err != nil {
+		t.Fatalf("DeleteEnvironment: %v", err)
+	}
+	if _, err := client.GetEnvironment(ctx, int(projectID), *environment.ID); !dbt_cloud.IsNotFound(err) {
+		t.Errorf("expected a NotFoundError once deleted, got %v", err)
+	}
+}
+
+func TestServer_PaginatesListings(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	s.SetPageSize(2)
+	client := newClient(t, s)
+
+	project := s.Seed(fakeapi.Projects, fakeapi.Object{"name": "Analytics"})
+	environment := s.Seed(fakeapi.Environments, fakeapi.Object{
+		"name":       "Production",
+		"project_id": project["id"],
+	})
+	for i := range 5 {
+		s.Seed(fakeapi.Jobs, fakeapi.Object{
+			"name":           fmt.Sprintf("Job %d", i),
+			"project_id":     project["id"],
+			"environment_id": environment["id"],
+		})
+	}
+
+	jobs, err := client.GetAllJobs(ctx, int(project["id"].(float64)), 0)
+	if err != nil {
+		t.Fatalf("GetAllJobs: %v", err)
+	}
+	if len(jobs) != 5 {
+		t.Fatalf("expected the 5 jobs across the pages, got %d", len(jobs))
+	}
+	if jobs[0].Environment.Name != "Production" {
+		t.Errorf("expected the environment to be included, got %+v", jobs[0].Environment)
+	}
+
+	pages := 0
+	for _, request := range s.Requests() {
+		if request.Method == http.MethodGet && request.Path == fmt.Sprintf("/v2/accounts/%d/jobs", s.AccountID) {
+			pages++
+		}
+	}
+	if pages != 3 {
+		t.Errorf("expected 3 pages to be requested, got %d", pages)
+	}
+}
+
+func TestServer_EnvironmentVariables(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	project := s.Seed(fakeapi.Projects, fakeapi.Object{"name": "Analytics"})
+	projectID := int(project["id"].(float64))
+	s.Seed(fakeapi.Environments, fakeapi.Object{"name": "Production", "project_id": project["id"]})
+
+	_, err := client.CreateEnvironmentVariable(ctx, projectID, "DBT_TARGET", map[string]string{
+		"project":    "dev",
+		"Production": "prod",
+	})
+	if err != nil {
+		t.Fatalf("CreateEnvironmentVariable: %v", err)
+	}
+
+	_, err = client.CreateEnvironmentVariable(ctx, projectID, "DBT_OTHER", map[string]string{"Staging": "x"})
+	var validationErr *dbt_cloud.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("expected a ValidationError for an unknown environment, got %v", err)
+	}
+
+	_, err = client.UpdateEnvironmentVariable(ctx, projectID, dbt_cloud.AbstractedEnvironmentVariable{
+		Name:              "DBT_TARGET",
+		ProjectID:         projectID,
+		EnvironmentValues: map[string]string{"Production": "production"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateEnvironmentVariable: %v", err)
+	}
+
+	variable, err := client.GetEnvironmentVariable(ctx, projectID, "DBT_TARGET")
+	if err != nil {
+		t.Fatalf("GetEnvironmentVariable: %v", err)
+	}
+	if variable.EnvironmentNameValues["project"].Value != "dev" ||
+		variable.EnvironmentNameValues["Production"].Value != "production" {
+		t.Errorf("unexpected values %+v", variable.EnvironmentNameValues)
+	}
+
+	if _, err := client.DeleteEnvironmentVariable(ctx, "DBT_TARGET", projectID); err != nil {
+		t.Fatalf("DeleteEnvironmentVariable: %v", err)
+	}
+	if _, err := client.GetEnvironmentVariable(ctx, projectID, "DBT_TARGET"); !dbt_cloud.IsNotFound(err) {
+		t.Errorf("expected a NotFoundError once deleted, got %v", err)
+	}
+}
+
+func TestServer_GroupPermissions(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	group, err := client.CreateGroup(ctx, "Analysts", false, []string{"analysts"})
+	if err != nil {
+		t.Fatalf("CreateGroup: %v", err)
+	}
+
+	permissions := []dbt_cloud.GroupPermission{
+		{GroupID: *group.ID, AccountID: s.AccountID, AllProjects: true, Set: "analyst"},
+		{GroupID: *group.ID, AccountID: s.AccountID, ProjectID: 12, Set: "developer"},
+	}
+	if _, err := client.UpdateGroupPermissions(ctx, *group.ID, permissions); err != nil {
+		t.Fatalf("UpdateGroupPermissions: %v", err)
+	}
+	// the permissions sent replace the existing ones
+	if _, err := client.UpdateGroupPermissions(ctx, *group.ID, permissions[1:]); err != nil {
+		t.Fatalf("UpdateGroupPermissions: %v", err)
+	}
+
+	retrieved, err := client.GetGroup(ctx, *group.ID)
+	if err != nil {
+		t.Fatalf("GetGroup: %v", err)
+	}
+	if len(retrieved.Permissions) != 1 || retrieved.Permissions[0].Set != "developer" {
+		t.Errorf("expected only the developer permission, got %+v", retrieved.Permissions)
+	}
+}
+
+func TestServer_ServiceTokensAndWebhooks(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	token, err := client.CreateServiceToken(ctx, "CI", dbt_cloud.STATE_ACTIVE)
+	if err != nil {
+		t.Fatalf("CreateServiceToken: %v", err)
+	}
+	if token.TokenString == nil || *token.TokenString == "" {
+		t.Errorf("expected the token string in the creation response")
+	}
+	_, err = client.UpdateServiceTokenPermissions(ctx, *token.ID, []dbt_cloud.ServiceTokenPermission{
+		{ServiceTokenID: *token.ID, AccountID: s.AccountID, AllProjects: true, Set: "job_admin"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateServiceTokenPermissions: %v", err)
+	}
+	retrieved, err := client.GetServiceToken(ctx, *token.ID)
+	if err != nil {
+		t.Fatalf("GetServiceToken: %v", err)
+	}
+	if retrieved.UID == "" || len(retrieved.Permissions) != 1 {
+		t.Errorf("unexpected service token %+v", retrieved)
+	}
+	if retrieved.TokenString != nil {
+		t.Errorf("expected the token string to only be returned on creation, got %q", *retrieved.TokenString)
+	}
+
+	webhook, err := client.CreateWebhook(ctx, "", "Slack", "", "https://example.com/hook", []string{"job.run.completed"}, nil, true)
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if webhook.HmacSecret == nil {
+		t.Error("expected the webhook to have an HMAC secret")
+	}
+	if _, err := client.DeleteWebhook(ctx, webhook.WebhookId); err != nil {
+		t.Fatalf("DeleteWebhook: %v", err)
+	}
+	if _, err := client.GetWebhook(ctx, webhook.WebhookId); !dbt_cloud.IsNotFound(err) {
+		t.Errorf("expected a NotFoundError once deleted, got %v", err)
+	}
+}
+
+func TestServer_Users(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	s.Seed(fakeapi.Users, fakeapi.Object{"email": "ada@example.com"})
+	s.Seed(fakeapi.Users, fakeapi.Object{"email": "grace@example.com"})
+
+	users, err := client.GetUsers(ctx)
+	if err != nil {
+		t.Fatalf("GetUsers: %v", err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+
+	me, err := client.GetConnectedUser(ctx)
+	if err != nil {
+		t.Fatalf("GetConnectedUser: %v", err)
+	}
+	if me.Email != "ada@example.com" {
+		t.Errorf("expected the first user to be the connected one, got %s", me.Email)
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+	project := s.Seed(fakeapi.Projects, fakeapi.Object{"name": "Analytics"})
+	projectID := fmt.Sprint(project["id"])
+	projectPath := fmt.Sprintf("/v3/accounts/%d/projects/", s.AccountID)
+
+	// transient errors are retried by the client
+	s.InjectFault(fakeapi.Fault{Method: http.MethodGet, Path: projectPath, StatusCode: http.StatusServiceUnavailable, Times: 2})
+	if _, err := client.GetProject(ctx, projectID); err != nil {
+		t.Fatalf("expected the request to succeed after the retries, got %v", err)
+	}
+
+	s.InjectFault(fakeapi.Fault{Path: projectPath, StatusCode: http.StatusTooManyRequests, RetryAfter: time.Second})
+	var rateLimitErr *dbt_cloud.RateLimitError
+	if _, err := client.GetProject(context.Background(), projectID); !errors.As(err, &rateLimitErr) {
+		t.Fatalf("expected a RateLimitError, got %v", err)
+	}
+	if rateLimitErr.RetryAfter != time.Second {
+		t.Errorf("expected the Retry-After of the fault, got %s", rateLimitErr.RetryAfter)
+	}
+	s.ClearFaults()
+
+	s.DenyAccess(http.MethodPost, projectPath)
+	var permissionErr *dbt_cloud.PermissionError
+	if _, err := client.CreateProject(ctx, "Denied", "", "", 0); !errors.As(err, &permissionErr) {
+		t.Fatalf("expected a PermissionError, got %v", err)
+	}
+	if _, err := client.GetProject(ctx, projectID); err != nil {
+		t.Errorf("expected the reads to still be allowed, got %v", err)
+	}
+
+	invalidClient := *client
+	invalidClient.Token = "another-token"
+	if _, err := invalidClient.GetProject(ctx, projectID); !errors.As(err, &permissionErr) || permissionErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected a 401 for an invalid token, got %v", err)
+	}
+}
+
+func TestServer_Notifications(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	email := "alerts@example.com"
+	notification, err := client.CreateNotification(ctx, 1, []int{}, []int{10}, []int{}, []int{}, dbt_cloud.STATE_ACTIVE, 4, &email, nil, nil)
+	if err != nil {
+		t.Fatalf("CreateNotification: %v", err)
+	}
+	notificationID := strconv.Itoa(*notification.Id)
+
+	notification.OnFailure = []int{10, 11}
+	if _, err := client.UpdateNotification(ctx, notificationID, *notification); err != nil {
+		t.Fatalf("UpdateNotification: %v", err)
+	}
+	retrieved, err := client.GetNotification(ctx, notificationID)
+	if err != nil {
+		t.Fatalf("GetNotification: %v", err)
+	}
+	if len(retrieved.OnFailure) != 2 {
+		t.Errorf("expected the notification to be updated, got %+v", retrieved)
+	}
+
+	retrieved.State = dbt_cloud.STATE_DELETED
+	if _, err := client.UpdateNotification(ctx, notificationID, *retrieved); err != nil {
+		t.Fatalf("UpdateNotification: %v", err)
+	}
+	if _, err := client.GetNotification(ctx, notificationID); !dbt_cloud.IsNotFound(err) {
+		t.Errorf("expected a NotFoundError once deleted, got %v", err)
+	}
+}
+
+func TestServer_UserInvites(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	invite, err := client.CreateUserInvite(ctx, "ada@example.com", "developer", []int{10})
+	if err != nil {
+		t.Fatalf("CreateUserInvite: %v", err)
+	}
+	if invite.Status != dbt_cloud.INVITE_STATUS_PENDING || invite.UserID != nil {
+		t.Fatalf("expected a pending invite, got %+v", invite)
+	}
+	if _, err := client.CreateUserInvite(ctx, "ADA@example.com", "developer", nil); err == nil {
+		t.Errorf("expected inviting the same email twice to fail")
+	}
+	if _, err := client.ResendUserInvite(ctx, *invite.ID); err != nil {
+		t.Fatalf("ResendUserInvite: %v", err)
+	}
+
+	user := s.AcceptInvite(*invite.ID)
+	userID, _ := strconv.Atoi(fmt.Sprint(user["id"]))
+	invite, err = client.GetUserInvite(ctx, *invite.ID)
+	if err != nil {
+		t.Fatalf("GetUserInvite: %v", err)
+	}
+	if invite.Status != dbt_cloud.INVITE_STATUS_ACCEPTED || invite.UserID == nil || *invite.UserID != userID {
+		t.Fatalf("expected the invite to be accepted by user %d, got %+v", userID, invite)
+	}
+	if _, err := client.ResendUserInvite(ctx, *invite.ID); err == nil {
+		t.Errorf("expected resending an accepted invite to fail")
+	}
+
+	if err := client.UpdateUserLicenseType(ctx, userID, "read_only"); err != nil {
+		t.Fatalf("UpdateUserLicenseType: %v", err)
+	}
+	permission, err := client.GetUserPermission(ctx, userID)
+	if err != nil {
+		t.Fatalf("GetUserPermission: %v", err)
+	}
+	if permission.LicenseType != "read_only" || len(permission.Groups) != 1 {
+		t.Errorf("expected a read_only license with the group of the invite, got %+v", permission)
+	}
+
+	if err := client.DeactivateUser(ctx, userID); err != nil {
+		t.Fatalf("DeactivateUser: %v", err)
+	}
+	if _, err := client.GetUserPermission(ctx, userID); !dbt_cloud.IsNotFound(err) {
+		t.Errorf("expected a NotFoundError once deactivated, got %v", err)
+	}
+	if err := client.DeactivateUser(ctx, userID); err != nil {
+		t.Errorf("expected deactivating a user twice to succeed, got %v", err)
+	}
+
+	pending, err := client.CreateUserInvite(ctx, "grace@example.com", "read_only", nil)
+	if err != nil {
+		t.Fatalf("CreateUserInvite: %v", err)
+	}
+	if err := client.RevokeUserInvite(ctx, *pending.ID); err != nil {
+		t.Fatalf("RevokeUserInvite: %v", err)
+	}
+	if _, err := client.GetUserInvite(ctx, *pending.ID); !dbt_cloud.IsNotFound(err) {
+		t.Errorf("expected a NotFoundError once revoked, got %v", err)
+	}
+}
+
+func TestServer_ConnectionSSHTunnels(t *testing.T) {
+	ctx := context.Background()
+	s := fakeapi.NewServer(t)
+	client := newClient(t, s)
+
+	connection := s.Seed(fakeapi.Connections, fakeapi.Object{
+		"name":                  "Postgres",
+		"adapter_version":       "postgres_v0",
+		"is_ssh_tunnel_enabled": false,
+	})
+	connectionID := int64(connection["id"].(float64))
+
+	sshTunnel, err := client.CreateUpdateEncryption(ctx, dbt_cloud.GlobalConnectionEncryptionPayload{
+		AccountID:    int64(s.AccountID),
+		ConnectionID: connectionID,
+		Username:     "dbt",
+		Port:         22,
+		HostName:     "bastion.example.com",
+	})
+	if err != nil {
+		t.Fatalf("CreateUpdateEncryption: %v", err)
+	}
+	if sshTunnel.ID == nil || sshTunnel.PublicKey == "" {
+		t.Fatalf("expected the SSH tunnel to get an ID and a public key, got %+v", sshTunnel)
+	}
+	if err := client.SetGlobalConnectionSSHTunnelEnabled(ctx, connectionID, true); err != nil {
+		t.Fatalf("SetGlobalConnectionSSHTunnelEnabled: %v", err)
+	}
+	if enabled := s.Get(fakeapi.Connections, connection["id"])["is_ssh_tunnel_enabled"]; enabled != true {
+		t.Errorf("expected the SSH tunnel to be enabled on the connection, got %v", enabled)
+	}
+
+	sshTunnels, err := client.GetEncryptionsForConnection(ctx, connectionID)
+	if err != nil {
+		t.Fatalf("GetEncryptionsForConnection: %v", err)
+	}
+	if len(*sshTunnels) != 1 {
+		t.Errorf("expected 1 SSH tunnel for the connection, got %+v", *sshTunnels)
+	}
+
+	if err := client.DeleteConnectionSSHTunnel(ctx, *sshTunnel); err != nil {
+		t.Fatalf("DeleteConnectionSSHTunnel: %v", err)
+	}
+	if _, err := client.GetConnectionSSHTunnel(ctx, *sshTunnel.ID); !dbt_cloud.IsNotFound(err) {
+		t.Errorf("expected a NotFoundError once deleted, got %v", err)
+	}
+}
@@ -0,0 +1,333 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Object is an API object as stored by the fake, decoded from the JSON sent
+// by the client.
+type Object = map[string]any
+
+// The collections of objects stored by the fake server.
+const (
+	Projects                = "projects"
+	Environments            = "environments"
+	Jobs                    = "jobs"
+	Credentials             = "credentials"
+	Connections             = "connections"
+	Encryptions             = "encryptions"
+	Repositories            = "repositories"
+	EnvironmentVariables    = "environment_variables"
+	Groups                  = "groups"
+	GroupPermissions        = "group_permissions"
+	Users                   = "users"
+	UserPermissions         = "user_permissions"
+	Invites                 = "invites"
+	ServiceTokens           = "service_tokens"
+	ServiceTokenPermissions = "service_token_permissions"
+	Webhooks                = "webhooks"
+	Notifications           = "notifications"
+)
+
+// stateDeleted is the state the dbt Cloud API uses for soft deleted objects.
+const stateDeleted = 2
+
+// firstID is the ID of the first object created, high enough to not be
+// confused with the account ID in the tests.
+const firstID = 1000
+
+// relation links an object to another one through one of its fields.
+type relation struct {
+	collection string
+	field      string
+}
+
+// collectionConfig customizes the objects of a collection.
+type collectionConfig struct {
+	// idPrefix makes the IDs strings, like for webhooks
+	idPrefix string
+	// onCreate sets the fields computed by the API when an object is created
+	onCreate func(s *Server, object Object)
+	// onCreateResponse sets the fields only returned in the response of the
+	// creation, like secrets that can't be read afterwards
+	onCreateResponse func(s *Server, rendered Object)
+	// children are the lists of objects embedded in the responses, keyed by
+	// the field they are returned in, the relation field is on the child
+	children map[string]relation
+	// related are the objects returned when listed in include_related, the
+	// relation field is on the object itself
+	related map[string]relation
+}
+
+var collectionConfigs = map[string]collectionConfig{
+	Projects: {
+		related: map[string]relation{
+			"repository": {collection: Repositories, field: "repository_id"},
+			"connection": {collection: Connections, field: "connection_id"},
+		},
+	},
+	Jobs: {
+		related: map[string]relation{
+			"environment": {collection: Environments, field: "environment_id"},
+		},
+	},
+	Groups: {
+		children: map[string]relation{
+			"group_permissions": {collection: GroupPermissions, field: "group_id"},
+		},
+	},
+	GroupPermissions: {
+		onCreate: defaultWritableEnvironments,
+	},
+	ServiceTokenPermissions: {
+		onCreate: defaultWritableEnvironments,
+	},
+	Users: {
+		children: map[string]relation{
+			"permissions": {collection: UserPermissions, field: "user_id"},
+		},
+	},
+	Encryptions: {
+		onCreate: func(s *Server, object Object) {
+			object["state"] = 1
+			object["public_key"] = fmt.Sprintf("ssh-rsa fake-public-key-%v dbt-cloud", object["id"])
+		},
+	},
+	Invites: {
+		onCreate: func(s *Server, object Object) {
+			object["status"] = "pending"
+			object["user_id"] = nil
+			object["sent_count"] = 1
+		},
+	},
+	ServiceTokens: {
+		onCreate: func(s *Server, object Object) {
+			object["uid"] = fmt.Sprintf("fake%d", object["id"])
+		},
+		onCreateResponse: func(s *Server, rendered Object) {
+			rendered["token_string"] = fmt.Sprintf("dbtc_fake-token-%v", rendered["id"])
+		},
+	},
+	Webhooks: {
+		idPrefix: "wsu_",
+		onCreate: func(s *Server, object Object) {
+			object["hmac_secret"] = fmt.Sprintf("fake-secret-%s", object["id"])
+			object["account_identifier"] = fmt.Sprintf("act_%d", s.AccountID)
+		},
+	},
+}
+
+// defaultWritableEnvironments returns an empty list of writable environments
+// when none is sent, like the API does for the permissions.
+func defaultWritableEnvironments(_ *Server, object Object) {
+	if object["writable_environment_categories"] == nil {
+		object["writable_environment_categories"] = []any{}
+	}
+}
+
+// store holds the objects of all the collections, in creation order.
+type store struct {
+	nextID  int
+	objects map[string][]Object
+}
+
+func newStore() *store {
+	return &store{
+		nextID:  firstID,
+		objects: map[string][]Object{},
+	}
+}
+
+// insert assigns an ID to the object and adds it to the collection.
+func (st *store) insert(collection string, object Object) Object {
+	id := st.nextID
+	st.nextID++
+
+	if prefix := collectionConfigs[collection].idPrefix; prefix != "" {
+		object["id"] = prefix + strconv.Itoa(id)
+	} else {
+		object["id"] = id
+	}
+	if _, ok := object["state"]; !ok || object["state"] == nil {
+		object["state"] = 1
+	}
+	object["created_at"] = st.now()
+	object["updated_at"] = st.now()
+
+	st.objects[collection] = append(st.objects[collection], object)
+	return object
+}
+
+// now returns the timestamp set in the created_at and updated_at fields.
+func (st *store) now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// find returns the object with the ID, or nil.
+func (st *store) find(collection, id string) Object {
+	for _, object := range st.objects[collection] {
+		if fieldString(object["id"]) == id {
+			return object
+		}
+	}
+	return nil
+}
+
+// remove deletes the object with the ID, it returns false when it doesn't exist.
+func (st *store) remove(collection, id string) bool {
+	objects := st.objects[collection]
+	for i, object := range objects {
+		if fieldString(object["id"]) == id {
+			st.objects[collection] = append(objects[:i:i], objects[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// filter returns the objects for which match returns true.
+func (st *store) filter(collection string, match func(Object) bool) []Object {
+	objects := []Object{}
+	for _, object := range st.objects[collection] {
+		if match(object) {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+// fieldString formats a field value the way it appears in a URL, so that
+// JSON numbers and path parameters can be compared.
+func fieldString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// isDeleted reports whether the object was soft deleted by setting its state.
+func isDeleted(object Object) bool {
+	return fieldString(object["state"]) == strconv.Itoa(stateDeleted)
+}
+
+// pathValue converts a path parameter to the JSON value stored in the
+// objects, a number when possible.
+func pathValue(value string) any {
+	if number, err := strconv.Atoi(value); err == nil {
+		return number
+	}
+	return value
+}
+
+// merge updates dst with the fields of src, recursing into the nested
+// objects so that partial updates (PATCH) keep the fields not sent.
+func merge(dst, src Object) {
+	for key, value := range src {
+		if srcObject, ok := value.(map[string]any); ok {
+			if dstObject, ok := dst[key].(map[string]any); ok {
+				merge(dstObject, srcObject)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+// clone returns a deep copy of the object, so that the responses can't be
+// modified by the callers of the server.
+func clone(object Object) Object {
+	data, err := json.Marshal(object)
+	if err != nil {
+		panic(fmt.Sprintf("fakeapi: can't encode object: %v", err))
+	}
+	var copied Object
+	if err := json.Unmarshal(data, &copied); err != nil {
+		panic(fmt.Sprintf("fakeapi: can't decode object: %v", err))
+	}
+	return copied
+}
+
+// reservedQueryParameters are the query parameters which are not filters.
+var reservedQueryParameters = map[string]bool{
+	"offset":          true,
+	"limit":           true,
+	"order_by":        true,
+	"include_related": true,
+}
+
+// matchesQuery reports whether the object matches the filters of the query,
+// either exact matches on a field or case insensitive substring matches for
+// the parameters ending with __icontains.
+func matchesQuery(object Object, query map[string][]string) bool {
+	for key, values := range query {
+		if reservedQueryParameters[key] || len(values) == 0 {
+			continue
+		}
+		if field, ok := strings.CutSuffix(key, "__icontains"); ok {
+			if !strings.Contains(strings.ToLower(fieldString(object[field])), strings.ToLower(values[0])) {
+				return false
+			}
+			continue
+		}
+		if fieldString(object[key]) != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// sortObjects orders the objects by the field of order_by, in descending
+// order when it starts with "-".
+func sortObjects(objects []Object, orderBy string) {
+	if orderBy == "" {
+		return
+	}
+	field, descending := strings.CutPrefix(orderBy, "-")
+	sort.SliceStable(objects, func(i, j int) bool {
+		comparison := compareFields(objects[i][field], objects[j][field])
+		if descending {
+			return comparison > 0
+		}
+		return comparison < 0
+	})
+}
+
+func compareFields(a, b any) int {
+	aNumber, aErr := strconv.ParseFloat(fieldString(a), 64)
+	bNumber, bErr := strconv.ParseFloat(fieldString(b), 64)
+	if aErr == nil && bErr == nil {
+		switch {
+		case aNumber < bNumber:
+			return -1
+		case aNumber > bNumber:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(fieldString(a), fieldString(b))
+}
+
+// parseIncludeRelated returns the names listed in include_related, which the
+// client sends either as [a,b] or as ["a","b"].
+func parseIncludeRelated(value string) []string {
+	value = strings.Trim(value, "[]")
+	if value == "" {
+		return nil
+	}
+	names := []string{}
+	for _, name := range strings.Split(value, ",") {
+		names = append(names, strings.Trim(strings.TrimSpace(name), `"`))
+	}
+	return names
+}
@@ -2,6 +2,7 @@ package dbt_cloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,8 +24,9 @@ type GlobalConnectionAdapter struct {
 	} `json:"data"`
 }
 
-func (c *Client) GetGlobalConnectionAdapter(connectionID int64) (*GlobalConnectionAdapter, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetGlobalConnectionAdapter(ctx context.Context, connectionID int64) (*GlobalConnectionAdapter, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/connections/%d/",
@@ -82,16 +84,17 @@ func NewGlobalConnectionClient[T GlobalConnectionConfig](c *Client) GlobalConnec
 	}
 }
 
-func (c *GlobalConnectionClient[T]) Get(connectionID int64) (*GlobalConnectionCommon, *T, error) {
-	data, err := c.get(connectionID)
+func (c *GlobalConnectionClient[T]) Get(ctx context.Context, connectionID int64) (*GlobalConnectionCommon, *T, error) {
+	data, err := c.get(ctx, connectionID)
 	if err != nil {
 		return nil, nil, err
 	}
 	return &data.GlobalConnectionCommon, &data.Config, nil
 }
 
-func (c *GlobalConnectionClient[T]) get(connectionID int64) (*globalConnectionPayload[T], error) {
-	req, err := http.NewRequest(
+func (c *GlobalConnectionClient[T]) get(ctx context.Context, connectionID int64) (*globalConnectionPayload[T], error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/connections/%d/",
@@ -122,9 +125,10 @@ func (c *GlobalConnectionClient[T]) get(connectionID int64) (*globalConnectionPa
 }
 
 func (c *GlobalConnectionClient[T]) GetWithAdapterVersion(
+	ctx context.Context,
 	connectionID int64,
 ) (*GlobalConnectionCommon, *T, string, error) {
-	data, err := c.get(connectionID)
+	data, err := c.get(ctx, connectionID)
 	if err != nil {
 		return nil, nil, "", err
 	}
@@ -135,11 +139,12 @@ func (c *GlobalConnectionClient[T]) GetWithAdapterVersion(
 }
 
 func (c *GlobalConnectionClient[T]) Create(
+	ctx context.Context,
 	common GlobalConnectionCommon,
 	config T,
 ) (*GlobalConnectionCommon, *T, error) {
 	av := config.AdapterVersion()
-	data, err := c.createGlobalConnection(common, config, av)
+	data, err := c.createGlobalConnection(ctx, common, config, av)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -147,14 +152,16 @@ func (c *GlobalConnectionClient[T]) Create(
 }
 
 func (c *GlobalConnectionClient[T]) CreateWithLatestAdapter(
+	ctx context.Context,
 	common GlobalConnectionCommon,
 	config T,
 	av string,
 ) (*globalConnectionPayload[T], error) {
-	return c.createGlobalConnection(common, config, av)
+	return c.createGlobalConnection(ctx, common, config, av)
 }
 
 func (c *GlobalConnectionClient[T]) createGlobalConnection(
+	ctx context.Context,
 	common GlobalConnectionCommon,
 	config T,
 	av string,
@@ -175,7 +182,8 @@ func (c *GlobalConnectionClient[T]) createGlobalConnection(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/connections/",
@@ -203,6 +211,7 @@ func (c *GlobalConnectionClient[T]) createGlobalConnection(
 }
 
 func (c *GlobalConnectionClient[T]) Update(
+	ctx context.Context,
 	connectionID int64,
 	common GlobalConnectionCommon,
 	config T,
@@ -217,16 +226,17 @@ func (c *GlobalConnectionClient[T]) Update(
 		Config:                 config,
 	}
 
-	return updateGlobalConnection(enc, payload, c, connectionID, buffer)
+	return updateGlobalConnection(ctx, enc, payload, c, connectionID, buffer)
 }
 
-func updateGlobalConnection[T GlobalConnectionConfig](enc *json.Encoder, payload globalConnectionPayload[T], c *GlobalConnectionClient[T], connectionID int64, buffer *bytes.Buffer) (*GlobalConnectionCommon, *T, error) {
+func updateGlobalConnection[T GlobalConnectionConfig](ctx context.Context, enc *json.Encoder, payload globalConnectionPayload[T], c *GlobalConnectionClient[T], connectionID int64, buffer *bytes.Buffer) (*GlobalConnectionCommon, *T, error) {
 	err := enc.Encode(payload)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/connections/%d/",
@@ -254,8 +264,9 @@ func updateGlobalConnection[T GlobalConnectionConfig](enc *json.Encoder, payload
 	return &resp.Data.GlobalConnectionCommon, &resp.Data.Config, nil
 }
 
-func (c *Client) DeleteGlobalConnection(connectionID int64) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteGlobalConnection(ctx context.Context, connectionID int64) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/connections/%d/",
@@ -300,10 +311,14 @@ type GlobalConnectionEncryptionPayload struct {
 	State        int64  `json:"state,omitempty"`
 }
 
-func (c *GlobalConnectionClient[T]) GetEncryptionsForConnection(
+// GetEncryptionsForConnection returns the active SSH tunnel of the connection,
+// as a list that is empty when the connection doesn't have any
+func (c *Client) GetEncryptionsForConnection(
+	ctx context.Context,
 	connectionID int64,
 ) (*[]GlobalConnectionEncryptionPayload, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v2/accounts/%d/encryptions/?connection_id=%d&state=1",
@@ -331,13 +346,26 @@ func (c *GlobalConnectionClient[T]) GetEncryptionsForConnection(
 	}
 
 	if len(resp.Data) > 1 {
-		return nil, fmt.Errorf("more than one SSH tunnel config found for the connection")
+		encryptionIDs := []int64{}
+		for _, encryption := range resp.Data {
+			if encryption.ID != nil {
+				encryptionIDs = append(encryptionIDs, *encryption.ID)
+			}
+		}
+		return nil, fmt.Errorf(
+			"more than one SSH tunnel config found for the connection %d (IDs %v)",
+			connectionID,
+			encryptionIDs,
+		)
 	}
 
 	return &resp.Data, nil
 }
 
-func (c *GlobalConnectionClient[T]) CreateUpdateEncryption(
+// CreateUpdateEncryption creates the SSH tunnel when its ID is not set, or
+// updates it, setting State to STATE_DELETED deletes it
+func (c *Client) CreateUpdateEncryption(
+	ctx context.Context,
 	encryptionPayload GlobalConnectionEncryptionPayload,
 ) (*GlobalConnectionEncryptionPayload, error) {
 
@@ -369,7 +397,7 @@ func (c *GlobalConnectionClient[T]) CreateUpdateEncryption(
 		)
 	}
 
-	req, err := http.NewRequest("POST", postURL, buffer)
+	req, err := http.NewRequestWithContext(ctx, "POST", postURL, buffer)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -18,7 +19,7 @@ type GlobalConnectionSummary struct {
 	EnvironmentCount      int64   `json:"environment__count"`
 }
 
-func (c *Client) GetAllConnections() ([]GlobalConnectionSummary, error) {
+func (c *Client) GetAllConnections(ctx context.Context) ([]GlobalConnectionSummary, error) {
 
 	url := fmt.Sprintf(
 		`%s/v3/accounts/%d/connections/`,
@@ -26,7 +27,10 @@ func (c *Client) GetAllConnections() ([]GlobalConnectionSummary, error) {
 		c.AccountID,
 	)
 
-	allConnectionsRaw := c.GetData(url)
+	allConnectionsRaw, err := c.GetData(ctx, url)
+	if err != nil {
+		return nil, err
+	}
 
 	allConnections := []GlobalConnectionSummary{}
 	for _, connection := range allConnectionsRaw {
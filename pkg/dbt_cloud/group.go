@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -46,8 +47,9 @@ type GroupListResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetGroup(groupID int) (*Group, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetGroup(ctx context.Context, groupID int) (*Group, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/groups/%s/",
@@ -76,6 +78,7 @@ func (c *Client) GetGroup(groupID int) (*Group, error) {
 }
 
 func (c *Client) CreateGroup(
+	ctx context.Context,
 	name string,
 	assignByDefault bool,
 	ssoMappingGroups []string,
@@ -92,7 +95,8 @@ func (c *Client) CreateGroup(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/v3/accounts/%d/groups/", c.HostURL, c.AccountID),
 		strings.NewReader(string(newGroupData)),
@@ -115,13 +119,14 @@ func (c *Client) CreateGroup(
 	return &groupResponse.Data, nil
 }
 
-func (c *Client) UpdateGroup(groupID int, group Group) (*Group, error) {
+func (c *Client) UpdateGroup(ctx context.Context, groupID int, group Group) (*Group, error) {
 	groupData, err := json.Marshal(group)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/v3/accounts/%s/groups/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), groupID),
 		strings.NewReader(string(groupData)),
@@ -145,6 +150,7 @@ func (c *Client) UpdateGroup(groupID int, group Group) (*Group, error) {
 }
 
 func (c *Client) UpdateGroupPermissions(
+	ctx context.Context,
 	groupID int,
 	groupPermissions []GroupPermission,
 ) (*[]GroupPermission, error) {
@@ -153,7 +159,8 @@ func (c *Client) UpdateGroupPermissions(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/group-permissions/%d/",
@@ -181,7 +188,7 @@ func (c *Client) UpdateGroupPermissions(
 	return &groupPermissionResponse.Data, nil
 }
 
-func (c *Client) GetAllGroups(name, nameContains, state string) ([]Group, error) {
+func (c *Client) GetAllGroups(ctx context.Context, name, nameContains, state string) ([]Group, error) {
 	url := fmt.Sprintf(
 		"%s/v3/accounts/%s/groups/",
 		c.HostURL,
@@ -207,7 +214,7 @@ func (c *Client) GetAllGroups(name, nameContains, state string) ([]Group, error)
 		url += "?" + strings.Join(params, "&")
 	}
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
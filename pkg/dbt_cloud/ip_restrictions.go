@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -49,8 +50,9 @@ type IPRestrictionsRuleResponse struct {
 	Status ResponseStatus     `json:"status"`
 }
 
-func (c *Client) GetIPRestrictions() (*IPRestrictions, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetIPRestrictions(ctx context.Context) (*IPRestrictions, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/ip-restrictions/",
@@ -77,8 +79,8 @@ func (c *Client) GetIPRestrictions() (*IPRestrictions, error) {
 	return &ipRestrictionsResponse.Data, nil
 }
 
-func (c *Client) GetIPRestrictionsRule(ruleID int64) (*IPRestrictionsRule, error) {
-	allIPRestrictions, err := c.GetIPRestrictions()
+func (c *Client) GetIPRestrictionsRule(ctx context.Context, ruleID int64) (*IPRestrictionsRule, error) {
+	allIPRestrictions, err := c.GetIPRestrictions(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +100,7 @@ func (c *Client) GetIPRestrictionsRule(ruleID int64) (*IPRestrictionsRule, error
 }
 
 func (c *Client) CreateIPRestrictionsRule(
+	ctx context.Context,
 	ipRestrictionsRule IPRestrictionsRule,
 ) (*IPRestrictionsRule, error) {
 
@@ -106,7 +109,8 @@ func (c *Client) CreateIPRestrictionsRule(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/v3/accounts/%s/ip-restrictions/", c.HostURL, strconv.FormatInt(c.AccountID, 10)),
 		strings.NewReader(string(newIPRestrictionsData)),
@@ -130,6 +134,7 @@ func (c *Client) CreateIPRestrictionsRule(
 }
 
 func (c *Client) UpdateIPRestrictionsRule(
+	ctx context.Context,
 	ipRestrictionsId string,
 	ipRestrictions IPRestrictionsRule,
 ) (*IPRestrictionsRule, error) {
@@ -138,7 +143,8 @@ func (c *Client) UpdateIPRestrictionsRule(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/ip-restrictions/%s",
@@ -166,9 +172,9 @@ func (c *Client) UpdateIPRestrictionsRule(
 	return &ipRestrictionsRuleResponse.Data, nil
 }
 
-func (c *Client) DeleteIPRestrictions(ipRestrictions IPRestrictions) error {
+func (c *Client) DeleteIPRestrictions(ctx context.Context, ipRestrictions IPRestrictions) error {
 	for _, ipRestrictionsRule := range ipRestrictions {
-		err := c.DeleteIPRestrictionsRule(ipRestrictionsRule.ID)
+		err := c.DeleteIPRestrictionsRule(ctx, ipRestrictionsRule.ID)
 		if err != nil {
 			return err
 		}
@@ -176,8 +182,9 @@ func (c *Client) DeleteIPRestrictions(ipRestrictions IPRestrictions) error {
 	return nil
 }
 
-func (c *Client) DeleteIPRestrictionsRule(ipRestrictionsRuleID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteIPRestrictionsRule(ctx context.Context, ipRestrictionsRuleID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/ip-restrictions/%d",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -102,8 +103,9 @@ type JobWithEnvironment struct {
 	Environment Environment `json:"environment"`
 }
 
-func (c *Client) GetJob(jobID string) (*Job, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf("%s/v2/accounts/%s/jobs/%s/", c.HostURL, strconv.FormatInt(c.AccountID, 10), jobID),
 		nil,
@@ -127,6 +129,7 @@ func (c *Client) GetJob(jobID string) (*Job, error) {
 }
 
 func (c *Client) CreateJob(
+	ctx context.Context,
 	projectId int,
 	environmentId int,
 	name string,
@@ -200,41 +203,7 @@ func (c *Client) CreateJob(
 		TargetName: targetName,
 	}
 
-	time := scheduleTime{
-		Type:     "every_hour",
-		Interval: 1,
-	}
-	if scheduleInterval > 0 {
-		time.Interval = scheduleInterval
-	}
-	if len(scheduleHours) > 0 {
-		time.Type = "at_exact_hours"
-		time.Hours = &scheduleHours
-		time.Interval = 0
-	}
-
-	date := scheduleDate{
-		Type: scheduleType,
-	}
-	if scheduleType == "days_of_week" {
-		date.Days = &scheduleDays
-		date.Cron = nil
-	} else if scheduleType == "interval_cron" {
-		// cron expression: "4 */[interval] * * [days]" , 4 value matches the way dbt Cloud UI creates the cron that is sent to the API
-		daysStr := make([]string, len(scheduleDays))
-		for i, d := range scheduleDays {
-			daysStr[i] = strconv.Itoa(d)
-		}
-		cronExpr := fmt.Sprintf("4 */%d * * %s", scheduleInterval, strings.Join(daysStr, ","))
-		date.Cron = &cronExpr
-	} else if scheduleCron != "" { // custom_cron
-		date.Cron = &scheduleCron
-	}
-
-	jobSchedule := JobSchedule{
-		Date: date,
-		Time: time,
-	}
+	jobSchedule := NewJobSchedule(scheduleType, scheduleInterval, scheduleHours, scheduleDays, scheduleCron)
 	jobExecution := JobExecution{
 		TimeoutSeconds: timeoutSeconds,
 	}
@@ -294,7 +263,8 @@ func (c *Client) CreateJob(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/v2/accounts/%s/jobs/", c.HostURL, strconv.FormatInt(c.AccountID, 10)),
 		strings.NewReader(string(newJobData)),
@@ -320,20 +290,21 @@ func (c *Client) CreateJob(
 		selfID := *jobResponse.Data.ID
 		updatedJob.DeferringJobId = &deferringJobID
 		updatedJob.ID = &selfID
-		return c.UpdateJob(strconv.Itoa(*jobResponse.Data.ID), updatedJob)
+		return c.UpdateJob(ctx, strconv.Itoa(*jobResponse.Data.ID), updatedJob)
 	}
 
 	return &jobResponse.Data, nil
 }
 
-func (c *Client) UpdateJob(jobId string, job Job) (*Job, error) {
+func (c *Client) UpdateJob(ctx context.Context, jobId string, job Job) (*Job, error) {
 
 	jobData, err := json.Marshal(job)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/v2/accounts/%s/jobs/%s/", c.HostURL, strconv.FormatInt(c.AccountID, 10), jobId),
 		strings.NewReader(string(jobData)),
@@ -0,0 +1,201 @@
+package dbt_cloud
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// JobGraphEdge is a job completion trigger: DownstreamJobID runs when
+// UpstreamJobID completes with one of the Statuses
+type JobGraphEdge struct {
+	UpstreamJobID     int
+	UpstreamProjectID int
+	DownstreamJobID   int
+	Statuses          []int
+}
+
+// JobGraph is the graph of the jobs of an account, linked by their job
+// completion triggers. A job has at most one completion trigger, so each job
+// has at most one upstream job and the cycles of the graph are disjoint.
+type JobGraph struct {
+	jobs     map[int]Job
+	upstream map[int]JobGraphEdge
+}
+
+// NewJobGraph builds the graph of the jobs, the jobs without ID are ignored
+func NewJobGraph(jobs []Job) JobGraph {
+	graph := JobGraph{
+		jobs:     map[int]Job{},
+		upstream: map[int]JobGraphEdge{},
+	}
+	for _, job := range jobs {
+		if job.ID == nil {
+			continue
+		}
+		graph.jobs[*job.ID] = job
+		if job.JobCompletionTrigger != nil {
+			condition := job.JobCompletionTrigger.Condition
+			graph.upstream[*job.ID] = JobGraphEdge{
+				UpstreamJobID:     condition.JobID,
+				UpstreamProjectID: condition.ProjectID,
+				DownstreamJobID:   *job.ID,
+				Statuses:          condition.Statuses,
+			}
+		}
+	}
+	return graph
+}
+
+// WithTrigger returns a copy of the graph where the completion trigger of the
+// job is replaced by one on upstreamJobID, or removed when upstreamJobID is 0
+func (g JobGraph) WithTrigger(jobID, upstreamJobID int) JobGraph {
+	graph := JobGraph{
+		jobs:     g.jobs,
+		upstream: maps.Clone(g.upstream),
+	}
+	if upstreamJobID == 0 {
+		delete(graph.upstream, jobID)
+		return graph
+	}
+	edge := graph.upstream[jobID]
+	edge.UpstreamJobID = upstreamJobID
+	edge.DownstreamJobID = jobID
+	if upstream, ok := g.jobs[upstreamJobID]; ok {
+		edge.UpstreamProjectID = upstream.ProjectId
+	}
+	graph.upstream[jobID] = edge
+	return graph
+}
+
+// JobIDs returns the IDs of the jobs of the graph, sorted
+func (g JobGraph) JobIDs() []int {
+	return slices.Sorted(maps.Keys(g.jobs))
+}
+
+// Edges returns the completion triggers of the graph, sorted by downstream job
+func (g JobGraph) Edges() []JobGraphEdge {
+	edges := make([]JobGraphEdge, 0, len(g.upstream))
+	for _, jobID := range slices.Sorted(maps.Keys(g.upstream)) {
+		edges = append(edges, g.upstream[jobID])
+	}
+	return edges
+}
+
+// CycleThrough returns the jobs of the cycle going through the job, in the
+// order they trigger each other and starting with the job, or nil when the
+// job is not part of a cycle
+func (g JobGraph) CycleThrough(jobID int) []int {
+	upstreamJobs := []int{jobID}
+	visited := map[int]bool{jobID: true}
+	current := jobID
+	for {
+		edge, ok := g.upstream[current]
+		if !ok {
+			return nil
+		}
+		current = edge.UpstreamJobID
+		if current == jobID {
+			break
+		}
+		if visited[current] {
+			// a cycle upstream of the job, which is not part of it
+			return nil
+		}
+		visited[current] = true
+		upstreamJobs = append(upstreamJobs, current)
+	}
+
+	// upstreamJobs goes against the triggers, the cycle follows them
+	cycle := []int{jobID}
+	for i := len(upstreamJobs) - 1; i > 0; i-- {
+		cycle = append(cycle, upstreamJobs[i])
+	}
+	return cycle
+}
+
+// Cycles returns the cycles of the graph, each starting with its job with the
+// lowest ID, sorted by that ID
+func (g JobGraph) Cycles() [][]int {
+	cycles := [][]int{}
+	inCycle := map[int]bool{}
+	for _, jobID := range slices.Sorted(maps.Keys(g.upstream)) {
+		if inCycle[jobID] {
+			continue
+		}
+		cycle := g.CycleThrough(jobID)
+		for _, cycleJobID := range cycle {
+			inCycle[cycleJobID] = true
+		}
+		if cycle != nil {
+			cycles = append(cycles, cycle)
+		}
+	}
+	return cycles
+}
+
+// TopologicalOrder returns the jobs so that each job comes after the job
+// triggering it, ties being broken by job ID. The jobs part of a cycle or
+// triggered by a job part of a cycle can't be ordered and are left out.
+// Triggers on jobs missing from the graph are ignored.
+func (g JobGraph) TopologicalOrder() []int {
+	downstream := map[int][]int{}
+	roots := []int{}
+	for _, jobID := range g.JobIDs() {
+		edge, ok := g.upstream[jobID]
+		if _, upstreamExists := g.jobs[edge.UpstreamJobID]; ok && upstreamExists {
+			downstream[edge.UpstreamJobID] = append(downstream[edge.UpstreamJobID], jobID)
+		} else {
+			roots = append(roots, jobID)
+		}
+	}
+
+	// each job has at most one upstream job, so a job is ready as soon as the
+	// job triggering it is ordered
+	order := []int{}
+	ready := roots
+	for len(ready) > 0 {
+		slices.Sort(ready)
+		jobID := ready[0]
+		ready = ready[1:]
+		order = append(order, jobID)
+		ready = append(ready, downstream[jobID]...)
+	}
+	return order
+}
+
+// DescribeCycle renders a cycle as `Daily (12) → Hourly (15) → Daily (12)`
+func (g JobGraph) DescribeCycle(cycle []int) string {
+	names := make([]string, 0, len(cycle)+1)
+	for _, jobID := range append(slices.Clone(cycle), cycle[0]) {
+		if job, ok := g.jobs[jobID]; ok {
+			names = append(names, fmt.Sprintf("%s (%d)", job.Name, jobID))
+		} else {
+			names = append(names, fmt.Sprintf("job %d", jobID))
+		}
+	}
+	return strings.Join(names, " → ")
+}
+
+// JobCompletionTriggerCycle describes the cycle that a completion trigger of
+// the job on upstreamJobID would create, or returns "" when it doesn't create
+// one. All the jobs of the account are read to build the graph.
+func (c *Client) JobCompletionTriggerCycle(ctx context.Context, jobID, upstreamJobID int) (string, error) {
+	apiJobs, err := c.GetAllAccountJobs(ctx)
+	if err != nil {
+		return "", err
+	}
+	jobs := make([]Job, len(apiJobs))
+	for i, job := range apiJobs {
+		jobs[i] = job.Job
+	}
+
+	graph := NewJobGraph(jobs).WithTrigger(jobID, upstreamJobID)
+	cycle := graph.CycleThrough(jobID)
+	if cycle == nil {
+		return "", nil
+	}
+	return graph.DescribeCycle(cycle), nil
+}
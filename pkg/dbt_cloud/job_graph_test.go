@@ -0,0 +1,109 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func graphJob(id int, name string, upstreamJobID int) Job {
+	job := Job{ID: &id, Name: name, ProjectId: 1}
+	if upstreamJobID != 0 {
+		job.JobCompletionTrigger = &JobCompletionTrigger{
+			Condition: JobCompletionTriggerCondition{
+				JobID:     upstreamJobID,
+				ProjectID: 1,
+				Statuses:  []int{10},
+			},
+		}
+	}
+	return job
+}
+
+func TestJobGraph(t *testing.T) {
+	graph := NewJobGraph([]Job{
+		graphJob(5, "Marts", 2),
+		graphJob(2, "Staging", 1),
+		graphJob(1, "Sources", 0),
+		graphJob(3, "Exports", 2),
+		graphJob(4, "Orphan", 99),
+		graphJob(7, "Loop A", 8),
+		graphJob(8, "Loop B", 7),
+		graphJob(9, "After loop", 8),
+		graphJob(10, "Self", 10),
+	})
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 7, 8, 9, 10}, graph.JobIDs())
+
+	edges := graph.Edges()
+	assert.Len(t, edges, 8)
+	assert.Equal(t, JobGraphEdge{
+		UpstreamJobID:     1,
+		UpstreamProjectID: 1,
+		DownstreamJobID:   2,
+		Statuses:          []int{10},
+	}, edges[0])
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, graph.TopologicalOrder())
+	assert.Equal(t, [][]int{{7, 8}, {10}}, graph.Cycles())
+	assert.Nil(t, graph.CycleThrough(9))
+	assert.Equal(t, []int{8, 7}, graph.CycleThrough(8))
+	assert.Equal(t, "Loop A (7) → Loop B (8) → Loop A (7)", graph.DescribeCycle([]int{7, 8}))
+	assert.Equal(t, "Self (10) → job 99 → Self (10)", graph.DescribeCycle([]int{10, 99}))
+}
+
+func TestJobGraphWithTrigger(t *testing.T) {
+	graph := NewJobGraph([]Job{
+		graphJob(1, "Sources", 0),
+		graphJob(2, "Staging", 1),
+		graphJob(3, "Marts", 2),
+	})
+
+	looping := graph.WithTrigger(1, 3)
+	assert.Equal(t, []int{1, 2, 3}, looping.CycleThrough(1))
+	assert.Nil(t, graph.CycleThrough(1), "the original graph is not modified")
+
+	assert.Nil(t, graph.WithTrigger(3, 1).CycleThrough(3))
+	assert.Equal(t, []int{2}, graph.WithTrigger(2, 2).CycleThrough(2))
+	assert.Empty(t, looping.WithTrigger(1, 0).Cycles())
+}
+
+func TestJobCompletionTriggerCycle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data []Job
+		switch r.URL.Path {
+		case "/v3/accounts/123/projects/":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"data":  []map[string]any{{"id": 1, "name": "Analytics"}},
+				"extra": map[string]any{"pagination": map[string]any{"count": 1, "total_count": 1}},
+			})
+			return
+		case "/v2/accounts/123/jobs":
+			data = []Job{graphJob(1, "Sources", 0), graphJob(2, "Staging", 1), graphJob(3, "Marts", 2)}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"data":  data,
+			"extra": map[string]any{"pagination": map[string]any{"count": len(data), "total_count": len(data)}},
+		})
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 0, nil)
+
+	cycle, err := c.JobCompletionTriggerCycle(context.Background(), 1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "Sources (1) → Staging (2) → Marts (3) → Sources (1)", cycle)
+
+	cycle, err = c.JobCompletionTriggerCycle(context.Background(), 3, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, cycle)
+}
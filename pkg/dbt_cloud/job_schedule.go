@@ -0,0 +1,466 @@
+package dbt_cloud
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ScheduleTypeEveryDay     = "every_day"
+	ScheduleTypeDaysOfWeek   = "days_of_week"
+	ScheduleTypeCustomCron   = "custom_cron"
+	ScheduleTypeIntervalCron = "interval_cron"
+
+	scheduleTimeEveryHour    = "every_hour"
+	scheduleTimeAtExactHours = "at_exact_hours"
+)
+
+// NewJobSchedule builds the schedule sent to the API from the flat schedule_*
+// attributes of a job.
+func NewJobSchedule(
+	scheduleType string,
+	scheduleInterval int,
+	scheduleHours []int,
+	scheduleDays []int,
+	scheduleCron string,
+) JobSchedule {
+	time := scheduleTime{
+		Type:     scheduleTimeEveryHour,
+		Interval: 1,
+	}
+	if scheduleInterval > 0 {
+		time.Interval = scheduleInterval
+	}
+	if len(scheduleHours) > 0 {
+		time.Type = scheduleTimeAtExactHours
+		time.Hours = &scheduleHours
+		time.Interval = 0
+	}
+
+	date := scheduleDate{
+		Type: scheduleType,
+	}
+	if scheduleType == ScheduleTypeDaysOfWeek {
+		date.Days = &scheduleDays
+		date.Cron = nil
+	} else if scheduleType == ScheduleTypeIntervalCron {
+		// cron expression: "4 */[interval] * * [days]" , 4 value matches the way dbt Cloud UI creates the cron that is sent to the API
+		daysStr := make([]string, len(scheduleDays))
+		for i, d := range scheduleDays {
+			daysStr[i] = strconv.Itoa(d)
+		}
+		cronExpr := fmt.Sprintf("4 */%d * * %s", scheduleInterval, strings.Join(daysStr, ","))
+		date.Cron = &cronExpr
+	} else if scheduleCron != "" { // custom_cron
+		date.Cron = &scheduleCron
+	}
+
+	return JobSchedule{
+		Date: date,
+		Time: time,
+	}
+}
+
+// CronExpression renders the schedule as a normalized 5 field cron expression:
+// fields are separated by a single space, lists of hours and days are sorted
+// and deduplicated and an interval of 1 hour is rendered as `*`.
+func (s JobSchedule) CronExpression() (string, error) {
+	switch s.Date.Type {
+	case ScheduleTypeCustomCron, ScheduleTypeIntervalCron:
+		if s.Date.Cron == nil {
+			return "", fmt.Errorf("a cron expression is required for the schedule type %s", s.Date.Type)
+		}
+		fields := strings.Fields(*s.Date.Cron)
+		// interval_cron without days is generated with an empty day of week field
+		if s.Date.Type == ScheduleTypeIntervalCron && len(fields) == 4 {
+			fields = append(fields, "*")
+		}
+		if len(fields) != 5 {
+			return "", fmt.Errorf("expected a cron expression with 5 fields, got %d in %q", len(fields), *s.Date.Cron)
+		}
+		if s.Date.Type == ScheduleTypeIntervalCron {
+			fields[1] = normalizeHourInterval(fields[1])
+		}
+		return strings.Join(fields, " "), nil
+
+	case ScheduleTypeEveryDay, ScheduleTypeDaysOfWeek:
+		hours, err := s.cronHours()
+		if err != nil {
+			return "", err
+		}
+		days := "*"
+		if s.Date.Type == ScheduleTypeDaysOfWeek {
+			if s.Date.Days == nil || len(*s.Date.Days) == 0 {
+				return "", fmt.Errorf("at least one day is required for the schedule type %s", ScheduleTypeDaysOfWeek)
+			}
+			days, err = cronList(normalizeDaysOfWeek(*s.Date.Days), 0, 6, "day")
+			if err != nil {
+				return "", err
+			}
+		}
+		return fmt.Sprintf("0 %s * * %s", hours, days), nil
+
+	default:
+		return "", fmt.Errorf("unsupported schedule type %q", s.Date.Type)
+	}
+}
+
+func (s JobSchedule) cronHours() (string, error) {
+	if s.Time.Type == scheduleTimeAtExactHours {
+		if s.Time.Hours == nil || len(*s.Time.Hours) == 0 {
+			return "", fmt.Errorf("at least one hour is required when running at exact hours")
+		}
+		return cronList(*s.Time.Hours, 0, 23, "hour")
+	}
+
+	// an interval of 0, e.g. when it is not set, runs every hour like 1
+	if s.Time.Interval < 0 || s.Time.Interval > 23 {
+		return "", fmt.Errorf("the hour interval must be between 0 and 23, got %d", s.Time.Interval)
+	}
+	return normalizeHourInterval(fmt.Sprintf("*/%d", s.Time.Interval)), nil
+}
+
+// ParseJobScheduleCron converts a cron expression into the closest schedule
+// dbt Cloud can represent natively. Expressions that can't be expressed with
+// intervals, exact hours or days of the week are kept as a custom_cron.
+func ParseJobScheduleCron(cron string) (JobSchedule, error) {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return JobSchedule{}, fmt.Errorf("expected a cron expression with 5 fields, got %d in %q", len(fields), cron)
+	}
+	normalizedCron := strings.Join(fields, " ")
+	minute, hour, dayOfMonth, month, dayOfWeek := fields[0], fields[1], fields[2], fields[3], fields[4]
+
+	customCron := NewJobSchedule(ScheduleTypeCustomCron, 0, nil, nil, normalizedCron)
+	if dayOfMonth != "*" || month != "*" {
+		return customCron, nil
+	}
+
+	var days []int
+	if dayOfWeek != "*" {
+		var ok bool
+		days, ok = cronFieldValues(dayOfWeek, cronFieldRanges[4])
+		if !ok {
+			return customCron, nil
+		}
+		days = slices.Compact(slices.Sorted(slices.Values(normalizeDaysOfWeek(days))))
+	}
+
+	interval, isInterval := parseHourInterval(hour)
+
+	switch minute {
+	case "0":
+		scheduleType := ScheduleTypeEveryDay
+		if days != nil {
+			scheduleType = ScheduleTypeDaysOfWeek
+		}
+		if isInterval {
+			return NewJobSchedule(scheduleType, interval, nil, days, ""), nil
+		}
+		if hours, ok := cronFieldValues(hour, cronFieldRanges[1]); ok {
+			return NewJobSchedule(scheduleType, 0, hours, days, ""), nil
+		}
+	case "4":
+		// interval_cron only makes sense with a list of days, otherwise it is
+		// just an every_day schedule
+		if isInterval && days != nil {
+			return NewJobSchedule(ScheduleTypeIntervalCron, interval, nil, days, ""), nil
+		}
+	}
+
+	return customCron, nil
+}
+
+// normalizeDaysOfWeek replaces 7, the cron alias of Sunday, with 0 as dbt
+// Cloud numbers the days of week from 0 (Sunday) to 6
+func normalizeDaysOfWeek(days []int) []int {
+	normalized := make([]int, len(days))
+	for i, day := range days {
+		if day == 7 {
+			day = 0
+		}
+		normalized[i] = day
+	}
+	return normalized
+}
+
+// normalizeHourInterval renders an interval of one hour as `*`.
+func normalizeHourInterval(hour string) string {
+	if hour == "*/1" || hour == "*/0" {
+		return "*"
+	}
+	return hour
+}
+
+func parseHourInterval(hour string) (int, bool) {
+	if hour == "*" {
+		return 1, true
+	}
+	intervalStr, found := strings.CutPrefix(hour, "*/")
+	if !found {
+		return 0, false
+	}
+	interval, err := strconv.Atoi(intervalStr)
+	if err != nil || interval < 1 || interval > 23 {
+		return 0, false
+	}
+	return interval, true
+}
+
+// cronFieldValues returns the sorted values of a field made of values and
+// ranges (e.g. `1-3,5`), returning false for `*`, steps or values outside of
+// fieldRange which the native schedules can't represent
+func cronFieldValues(field string, fieldRange cronFieldRange) ([]int, bool) {
+	if strings.ContainsAny(field, "*/") {
+		return nil, false
+	}
+	set, err := parseCronField(field, fieldRange)
+	if err != nil {
+		return nil, false
+	}
+	return set.values(), true
+}
+
+// cronSet holds the values allowed by a cron field as a bit set
+type cronSet uint64
+
+func (s cronSet) has(value int) bool {
+	return s&(1<<value) != 0
+}
+
+func (s cronSet) values() []int {
+	values := []int{}
+	for value := 0; value < 64; value++ {
+		if s.has(value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+type cronFieldRange struct {
+	name     string
+	min, max int
+}
+
+var cronFieldRanges = [5]cronFieldRange{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// cronSchedule is a parsed 5 field cron expression
+type cronSchedule struct {
+	fields [5]string
+	sets   [5]cronSet
+}
+
+func (c cronSchedule) minutes() cronSet     { return c.sets[0] }
+func (c cronSchedule) hours() cronSet       { return c.sets[1] }
+func (c cronSchedule) daysOfMonth() cronSet { return c.sets[2] }
+func (c cronSchedule) months() cronSet      { return c.sets[3] }
+func (c cronSchedule) daysOfWeek() cronSet  { return c.sets[4] }
+
+// restricted reports whether the field doesn't start with `*`, following the
+// cron convention where a restricted day of month and day of week are OR-ed
+func (c cronSchedule) restricted(field int) bool {
+	return !strings.HasPrefix(c.fields[field], "*")
+}
+
+func (c cronSchedule) matchesDay(day time.Time) bool {
+	if !c.months().has(int(day.Month())) {
+		return false
+	}
+	matchesDayOfMonth := c.daysOfMonth().has(day.Day())
+	matchesDayOfWeek := c.daysOfWeek().has(int(day.Weekday()))
+	if c.restricted(2) && c.restricted(4) {
+		return matchesDayOfMonth || matchesDayOfWeek
+	}
+	return matchesDayOfMonth && matchesDayOfWeek
+}
+
+func parseCronSchedule(cron string) (cronSchedule, error) {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return cronSchedule{}, fmt.Errorf("expected a cron expression with 5 fields, got %d in %q", len(fields), cron)
+	}
+
+	var schedule cronSchedule
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFieldRanges[i])
+		if err != nil {
+			return cronSchedule{}, fmt.Errorf("invalid cron expression %q: %w", cron, err)
+		}
+		schedule.fields[i] = field
+		schedule.sets[i] = set
+	}
+	// 7 is an alias of Sunday
+	if schedule.sets[4].has(7) {
+		schedule.sets[4] = schedule.sets[4]&^(1<<7) | 1
+	}
+	return schedule, nil
+}
+
+// parseCronField parses a comma separated list of `*`, values, ranges and
+// steps (e.g. `*/4` or `1-5/2`)
+func parseCronField(field string, fieldRange cronFieldRange) (cronSet, error) {
+	var set cronSet
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q in the %s field", stepStr, fieldRange.name)
+			}
+		}
+
+		start, end := fieldRange.min, fieldRange.max
+		if rangePart != "*" {
+			startStr, endStr, isRange := strings.Cut(rangePart, "-")
+			var errStart, errEnd error
+			start, errStart = strconv.Atoi(startStr)
+			end = start
+			if isRange {
+				end, errEnd = strconv.Atoi(endStr)
+			} else if hasStep {
+				// `5/15` means from 5 to the end of the range
+				end = fieldRange.max
+			}
+			if errStart != nil || errEnd != nil {
+				return 0, fmt.Errorf("invalid value %q in the %s field", part, fieldRange.name)
+			}
+		}
+		if start < fieldRange.min || end > fieldRange.max || start > end {
+			return 0, fmt.Errorf(
+				"the %s %q is not between %d and %d",
+				fieldRange.name,
+				part,
+				fieldRange.min,
+				fieldRange.max,
+			)
+		}
+
+		for value := start; value <= end; value += step {
+			set |= 1 << value
+		}
+	}
+	return set, nil
+}
+
+// renderCronSet renders the values of a cron field as `*` when all values
+// are set, as `*/n` for a regular interval starting at the minimum and as a
+// sorted list otherwise
+func renderCronSet(set cronSet, fieldRange cronFieldRange) string {
+	values := set.values()
+	if len(values) == fieldRange.max-fieldRange.min+1 {
+		return "*"
+	}
+	if len(values) >= 2 && values[0] == fieldRange.min {
+		step := values[1] - values[0]
+		regular := true
+		for i := range values {
+			if values[i] != fieldRange.min+i*step {
+				regular = false
+				break
+			}
+		}
+		if regular && values[len(values)-1]+step > fieldRange.max {
+			return fmt.Sprintf("*/%d", step)
+		}
+	}
+
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = strconv.Itoa(value)
+	}
+	return strings.Join(parts, ",")
+}
+
+func cronList(values []int, min, max int, name string) (string, error) {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	parts := make([]string, len(sorted))
+	for i, value := range sorted {
+		if value < min || value > max {
+			return "", fmt.Errorf("the %s %d is not between %d and %d", name, value, min, max)
+		}
+		parts[i] = strconv.Itoa(value)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// MergeCronExpressions combines cron expressions into a single one firing at
+// the times of all of them, e.g. `0 8 * * 1-5` and `0 18 * * 1-5` into
+// `0 8,18 * * 1-5`. dbt Cloud holds a single cron expression per job, so
+// expressions can only be combined when they differ by at most one field, an
+// error is returned otherwise.
+func MergeCronExpressions(crons []string) (string, error) {
+	if len(crons) == 0 {
+		return "", fmt.Errorf("at least one cron expression is required")
+	}
+
+	merged, err := parseCronSchedule(crons[0])
+	if err != nil {
+		return "", err
+	}
+	for _, cron := range crons[1:] {
+		schedule, err := parseCronSchedule(cron)
+		if err != nil {
+			return "", err
+		}
+		notCombinable := fmt.Errorf(
+			"the cron expressions %q and %q can't be combined into a single cron expression, they need to only differ by one field",
+			strings.Join(merged.fields[:], " "),
+			strings.Join(schedule.fields[:], " "),
+		)
+
+		differing := -1
+		for i := range schedule.sets {
+			if merged.sets[i] == schedule.sets[i] && merged.restricted(i) == schedule.restricted(i) {
+				continue
+			}
+			if differing != -1 {
+				return "", notCombinable
+			}
+			differing = i
+		}
+		if differing == -1 {
+			continue
+		}
+
+		set := merged.sets[differing] | schedule.sets[differing]
+		field := renderCronSet(set, cronFieldRanges[differing])
+		otherDays := -1
+		switch differing {
+		case 2:
+			otherDays = 4
+		case 4:
+			otherDays = 2
+		}
+		if otherDays != -1 && merged.restricted(otherDays) {
+			// the days of month and of week are OR-ed when both are restricted, the
+			// merged field needs to keep the kind of both expressions
+			switch {
+			case merged.restricted(differing) != schedule.restricted(differing):
+				return "", notCombinable
+			case merged.restricted(differing):
+				fieldRange := cronFieldRanges[differing]
+				field, _ = cronList(set.values(), fieldRange.min, fieldRange.max, fieldRange.name)
+			case !strings.HasPrefix(field, "*"):
+				return "", notCombinable
+			}
+		}
+		merged.fields[differing] = field
+		merged.sets[differing] = set
+	}
+	return strings.Join(merged.fields[:], " "), nil
+}
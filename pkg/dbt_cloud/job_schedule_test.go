@@ -0,0 +1,441 @@
+package dbt_cloud_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobScheduleCronExpression(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule dbt_cloud.JobSchedule
+		expected string
+		err      bool
+	}{
+		{
+			name:     "every day, every hour",
+			schedule: dbt_cloud.NewJobSchedule("every_day", 1, nil, nil, ""),
+			expected: "0 * * * *",
+		},
+		{
+			name:     "every day, every 4 hours",
+			schedule: dbt_cloud.NewJobSchedule("every_day", 4, nil, nil, ""),
+			expected: "0 */4 * * *",
+		},
+		{
+			name:     "days of week at exact hours are sorted and deduplicated",
+			schedule: dbt_cloud.NewJobSchedule("days_of_week", 0, []int{18, 6, 6}, []int{5, 1, 3}, ""),
+			expected: "0 6,18 * * 1,3,5",
+		},
+		{
+			name:     "day 7 is Sunday",
+			schedule: dbt_cloud.NewJobSchedule("days_of_week", 1, nil, []int{7, 1, 0}, ""),
+			expected: "0 * * * 0,1",
+		},
+		{
+			name:     "every day, interval not set",
+			schedule: dbt_cloud.NewJobSchedule("every_day", 0, nil, nil, ""),
+			expected: "0 * * * *",
+		},
+		{
+			name:     "custom cron is normalized",
+			schedule: dbt_cloud.NewJobSchedule("custom_cron", 0, nil, nil, "30  2 *   * 1-5"),
+			expected: "30 2 * * 1-5",
+		},
+		{
+			name:     "interval cron",
+			schedule: dbt_cloud.NewJobSchedule("interval_cron", 2, nil, []int{1, 2}, ""),
+			expected: "4 */2 * * 1,2",
+		},
+		{
+			name:     "interval cron without days",
+			schedule: dbt_cloud.NewJobSchedule("interval_cron", 1, nil, nil, ""),
+			expected: "4 * * * *",
+		},
+		{
+			name:     "invalid custom cron",
+			schedule: dbt_cloud.NewJobSchedule("custom_cron", 0, nil, nil, "0 * * *"),
+			err:      true,
+		},
+		{
+			name:     "days of week without days",
+			schedule: dbt_cloud.NewJobSchedule("days_of_week", 1, nil, nil, ""),
+			err:      true,
+		},
+		{
+			name:     "day out of range",
+			schedule: dbt_cloud.NewJobSchedule("days_of_week", 1, nil, []int{8}, ""),
+			err:      true,
+		},
+		{
+			name:     "interval out of range",
+			schedule: dbt_cloud.NewJobSchedule("every_day", 24, nil, nil, ""),
+			err:      true,
+		},
+		{
+			name:     "hour out of range",
+			schedule: dbt_cloud.NewJobSchedule("every_day", 0, []int{24}, nil, ""),
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := tt.schedule.CronExpression()
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cron)
+		})
+	}
+}
+
+func TestParseJobScheduleCron(t *testing.T) {
+	tests := []struct {
+		name         string
+		cron         string
+		expectedType string
+		expectedCron string
+	}{
+		{
+			name:         "every hour",
+			cron:         "0 * * * *",
+			expectedType: "every_day",
+			expectedCron: "0 * * * *",
+		},
+		{
+			name:         "every 6 hours on weekdays",
+			cron:         "0 */6 * * 5,1,2,3,4",
+			expectedType: "days_of_week",
+			expectedCron: "0 */6 * * 1,2,3,4,5",
+		},
+		{
+			name:         "exact hours",
+			cron:         "0 12,0 * * *",
+			expectedType: "every_day",
+			expectedCron: "0 0,12 * * *",
+		},
+		{
+			name:         "interval cron",
+			cron:         "4 */3 * * 0,6",
+			expectedType: "interval_cron",
+			expectedCron: "4 */3 * * 0,6",
+		},
+		{
+			name:         "minute not supported natively",
+			cron:         "15 3 * * *",
+			expectedType: "custom_cron",
+			expectedCron: "15 3 * * *",
+		},
+		{
+			name:         "day of month",
+			cron:         "0 3  1 * *",
+			expectedType: "custom_cron",
+			expectedCron: "0 3 1 * *",
+		},
+		{
+			name:         "ranges of days",
+			cron:         "0 * * * 1-3,5",
+			expectedType: "days_of_week",
+			expectedCron: "0 * * * 1,2,3,5",
+		},
+		{
+			name:         "day 7 is Sunday",
+			cron:         "0 8 * * 6,7",
+			expectedType: "days_of_week",
+			expectedCron: "0 8 * * 0,6",
+		},
+		{
+			name:         "steps",
+			cron:         "0 * * * */2",
+			expectedType: "custom_cron",
+			expectedCron: "0 * * * */2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := dbt_cloud.ParseJobScheduleCron(tt.cron)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedType, schedule.Date.Type)
+
+			cron, err := schedule.CronExpression()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCron, cron)
+		})
+	}
+
+	_, err := dbt_cloud.ParseJobScheduleCron("0 * * *")
+	assert.Error(t, err)
+}
+
+func TestConvertCronToUTC(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	assert.NoError(t, err)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+
+	winter := time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2026, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		cron     string
+		location *time.Location
+		at       time.Time
+		expected string
+		err      bool
+	}{
+		{
+			name:     "UTC is kept as is",
+			cron:     "0 6 * * 1-5",
+			location: time.UTC,
+			at:       winter,
+			expected: "0 6 * * 1-5",
+		},
+		{
+			name:     "standard time",
+			cron:     "0 6,18 * * *",
+			location: newYork,
+			at:       winter,
+			expected: "0 11,23 * * *",
+		},
+		{
+			name:     "daylight saving time",
+			cron:     "0 6,18 * * *",
+			location: newYork,
+			at:       summer,
+			expected: "0 10,22 * * *",
+		},
+		{
+			name:     "half hour offset",
+			cron:     "0 9 * * *",
+			location: kolkata,
+			at:       winter,
+			expected: "30 3 * * *",
+		},
+		{
+			name:     "intervals are kept",
+			cron:     "0 */4 * * *",
+			location: newYork,
+			at:       summer,
+			expected: "0 */4 * * *",
+		},
+		{
+			name:     "days of week moving to the previous day",
+			cron:     "0 7 * * 1,3,5",
+			location: tokyo,
+			at:       winter,
+			expected: "0 22 * * 0,2,4",
+		},
+		{
+			name:     "days of week moving to the next day",
+			cron:     "30 21 * * 0,6",
+			location: newYork,
+			at:       winter,
+			expected: "30 2 * * 0,1",
+		},
+		{
+			name:     "days of week on the same day",
+			cron:     "0 12 * * 1-5",
+			location: newYork,
+			at:       summer,
+			expected: "0 16 * * 1-5",
+		},
+		{
+			name:     "days of week spanning two UTC days",
+			cron:     "0 6,22 * * 1-5",
+			location: newYork,
+			at:       summer,
+			err:      true,
+		},
+		{
+			name:     "day of month moving to another day",
+			cron:     "0 7 1 * *",
+			location: tokyo,
+			at:       winter,
+			err:      true,
+		},
+		{
+			name:     "minutes differing between hours",
+			cron:     "0,30 9 * * *",
+			location: time.FixedZone("UTC+00:30", 30*60),
+			at:       winter,
+			err:      true,
+		},
+		{
+			name:     "invalid cron",
+			cron:     "0 25 * * *",
+			location: newYork,
+			at:       winter,
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := dbt_cloud.ConvertCronToUTC(tt.cron, tt.location, tt.at)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cron)
+		})
+	}
+}
+
+func TestNextCronTimes(t *testing.T) {
+	from := time.Date(2026, time.March, 6, 10, 30, 0, 0, time.UTC) // a Friday
+
+	tests := []struct {
+		name     string
+		cron     string
+		count    int
+		expected []string
+		err      bool
+	}{
+		{
+			name:  "every 4 hours",
+			cron:  "0 */4 * * *",
+			count: 3,
+			expected: []string{
+				"2026-03-06T12:00:00Z",
+				"2026-03-06T16:00:00Z",
+				"2026-03-06T20:00:00Z",
+			},
+		},
+		{
+			name:  "week days",
+			cron:  "0 6 * * 1-5",
+			count: 2,
+			expected: []string{
+				"2026-03-09T06:00:00Z",
+				"2026-03-10T06:00:00Z",
+			},
+		},
+		{
+			name:  "day of month or day of week",
+			cron:  "15 8 10 * 0",
+			count: 3,
+			expected: []string{
+				"2026-03-08T08:15:00Z",
+				"2026-03-10T08:15:00Z",
+				"2026-03-15T08:15:00Z",
+			},
+		},
+		{
+			name:     "Sunday as 7",
+			cron:     "0 0 * * 7",
+			count:    1,
+			expected: []string{"2026-03-08T00:00:00Z"},
+		},
+		{
+			name:  "cron never firing",
+			cron:  "0 0 30 2 *",
+			count: 1,
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times, err := dbt_cloud.NextCronTimes(tt.cron, from, tt.count)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			formatted := make([]string, len(times))
+			for i, fireTime := range times {
+				formatted[i] = fireTime.Format(time.RFC3339)
+			}
+			assert.Equal(t, tt.expected, formatted)
+		})
+	}
+}
+
+func TestMergeCronExpressions(t *testing.T) {
+	tests := []struct {
+		name     string
+		crons    []string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "single cron",
+			crons:    []string{"0 6 * * 1-5"},
+			expected: "0 6 * * 1-5",
+		},
+		{
+			name:     "hours",
+			crons:    []string{"0 6 * * 1-5", "0 18 * * 1-5", "0 12 * * 1-5"},
+			expected: "0 6,12,18 * * 1-5",
+		},
+		{
+			name:     "minutes",
+			crons:    []string{"0 8 * * *", "15 8 * * *"},
+			expected: "0,15 8 * * *",
+		},
+		{
+			name:     "identical crons",
+			crons:    []string{"0 8 * * *", "0 8 * * *"},
+			expected: "0 8 * * *",
+		},
+		{
+			name:     "days of week",
+			crons:    []string{"0 8 * * 1-5", "0 8 * * 6"},
+			expected: "0 8 * * 1,2,3,4,5,6",
+		},
+		{
+			name:     "days of week with a restricted day of month",
+			crons:    []string{"0 8 1 * 1", "0 8 1 * 0-6"},
+			expected: "0 8 1 * 0,1,2,3,4,5,6",
+		},
+		{
+			name:     "all hours",
+			crons:    []string{"0 */2 * * *", "0 1-23/2 * * *"},
+			expected: "0 * * * *",
+		},
+		{
+			name:  "two fields",
+			crons: []string{"0 6 * * 1-5", "30 10 * * 1-5"},
+			err:   true,
+		},
+		{
+			name:  "restricted and unrestricted days",
+			crons: []string{"0 8 1 * 1", "0 8 1 * */2"},
+			err:   true,
+		},
+		{
+			name:  "invalid cron",
+			crons: []string{"0 6 * * *", "0 24 * * *"},
+			err:   true,
+		},
+		{
+			name:  "no cron",
+			crons: []string{},
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := dbt_cloud.MergeCronExpressions(tt.crons)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cron)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package dbt_cloud
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxCronSearchDays bounds the search for the next fire times of a cron
+// expression, expressions like `0 0 30 2 *` never fire
+const maxCronSearchDays = 5 * 366
+
+// ConvertCronToUTC converts a cron expression written in the local time of
+// location into the equivalent UTC cron expression, using the UTC offset of
+// location at the time at. As the offset changes on daylight saving time
+// boundaries, the result needs to be re-rendered when they are crossed.
+//
+// An error is returned when the local schedule can't be expressed as a single
+// UTC cron expression, for example when some of its hours move to the previous
+// day while the schedule is restricted to some days of the week.
+func ConvertCronToUTC(cron string, location *time.Location, at time.Time) (string, error) {
+	schedule, err := parseCronSchedule(cron)
+	if err != nil {
+		return "", err
+	}
+
+	_, offsetSeconds := at.In(location).Zone()
+	if offsetSeconds%60 != 0 {
+		return "", fmt.Errorf("the UTC offset of %s is not a whole number of minutes", location)
+	}
+	offset := offsetSeconds / 60
+	if offset == 0 {
+		return strings.Join(schedule.fields[:], " "), nil
+	}
+
+	// shift every local fire time of a day and record on which UTC day it lands
+	var minutes, hours cronSet
+	pairs := map[[2]int]bool{}
+	dayShifts := map[int]bool{}
+	for _, hour := range schedule.hours().values() {
+		for _, minute := range schedule.minutes().values() {
+			utcMinutes := hour*60 + minute - offset
+			dayShift := 0
+			switch {
+			case utcMinutes < 0:
+				dayShift = -1
+				utcMinutes += 24 * 60
+			case utcMinutes >= 24*60:
+				dayShift = 1
+				utcMinutes -= 24 * 60
+			}
+			dayShifts[dayShift] = true
+			minutes |= 1 << (utcMinutes % 60)
+			hours |= 1 << (utcMinutes / 60)
+			pairs[[2]int{utcMinutes / 60, utcMinutes % 60}] = true
+		}
+	}
+
+	if len(pairs) != bits.OnesCount64(uint64(minutes))*bits.OnesCount64(uint64(hours)) {
+		return "", fmt.Errorf(
+			"the schedule %q in %s can't be expressed as a single UTC cron expression, its minutes differ between hours once converted to UTC",
+			cron,
+			location,
+		)
+	}
+
+	utcFields := schedule.fields
+	utcFields[0] = renderCronSet(minutes, cronFieldRanges[0])
+	utcFields[1] = renderCronSet(hours, cronFieldRanges[1])
+
+	daysRestricted := utcFields[2] != "*" || utcFields[3] != "*" || utcFields[4] != "*"
+	if !daysRestricted || (len(dayShifts) == 1 && dayShifts[0]) {
+		return strings.Join(utcFields[:], " "), nil
+	}
+
+	if len(dayShifts) > 1 {
+		return "", fmt.Errorf(
+			"the schedule %q in %s runs on two different UTC days and can't be expressed as a single UTC cron expression, restrict its hours or run it every day",
+			cron,
+			location,
+		)
+	}
+	if utcFields[2] != "*" || utcFields[3] != "*" {
+		return "", fmt.Errorf(
+			"the schedule %q in %s runs on a different UTC day and restricts the days of the month or the months, it can't be expressed as a UTC cron expression",
+			cron,
+			location,
+		)
+	}
+
+	var dayShift int
+	for shift := range dayShifts {
+		dayShift = shift
+	}
+	var daysOfWeek cronSet
+	for _, day := range schedule.daysOfWeek().values() {
+		daysOfWeek |= 1 << ((day + dayShift + 7) % 7)
+	}
+	utcFields[4] = renderCronSet(daysOfWeek, cronFieldRange{name: "day of week", min: 0, max: 6})
+	return strings.Join(utcFields[:], " "), nil
+}
+
+// NextCronTimes returns the next count times strictly after from when the cron
+// expression fires, evaluated in the location of from
+func NextCronTimes(cron string, from time.Time, count int) ([]time.Time, error) {
+	schedule, err := parseCronSchedule(cron)
+	if err != nil {
+		return nil, err
+	}
+
+	location := from.Location()
+	times := make([]time.Time, 0, count)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location)
+	for range maxCronSearchDays {
+		if len(times) == count {
+			break
+		}
+		if schedule.matchesDay(day) {
+			for _, hour := range schedule.hours().values() {
+				for _, minute := range schedule.minutes().values() {
+					fireTime := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, location)
+					// skip the local times that don't exist or are normalized to another
+					// hour on daylight saving time boundaries
+					if fireTime.Hour() != hour || !fireTime.After(from) {
+						continue
+					}
+					if len(times) > 0 && !fireTime.After(times[len(times)-1]) {
+						continue
+					}
+					times = append(times, fireTime)
+					if len(times) == count {
+						break
+					}
+				}
+				if len(times) == count {
+					break
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	if len(times) == 0 && count > 0 {
+		return nil, fmt.Errorf("the cron expression %q never fires", cron)
+	}
+	return slices.Clip(times), nil
+}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -21,8 +22,8 @@ type LicenseMapResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetLicenseMap(licenseMapId int) (*LicenseMap, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%s/license-maps/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), licenseMapId), nil)
+func (c *Client) GetLicenseMap(ctx context.Context, licenseMapId int) (*LicenseMap, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v3/accounts/%s/license-maps/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), licenseMapId), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +42,7 @@ func (c *Client) GetLicenseMap(licenseMapId int) (*LicenseMap, error) {
 	return &licenseMapResponse.Data, nil
 }
 
-func (c *Client) CreateLicenseMap(licenseType string, ssoLicenseMappingGroups []string) (*LicenseMap, error) {
+func (c *Client) CreateLicenseMap(ctx context.Context, licenseType string, ssoLicenseMappingGroups []string) (*LicenseMap, error) {
 	newLicenseMap := LicenseMap{
 		AccountID:               c.AccountID,
 		LicenseType:             licenseType,
@@ -53,7 +54,7 @@ func (c *Client) CreateLicenseMap(licenseType string, ssoLicenseMappingGroups []
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%d/license-maps/", c.HostURL, c.AccountID), strings.NewReader(string(newLicenseMapData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%d/license-maps/", c.HostURL, c.AccountID), strings.NewReader(string(newLicenseMapData)))
 	if err != nil {
 		return nil, err
 	}
@@ -72,13 +73,13 @@ func (c *Client) CreateLicenseMap(licenseType string, ssoLicenseMappingGroups []
 	return &licenseMapResponse.Data, nil
 }
 
-func (c *Client) UpdateLicenseMap(licenseMapID int, licenseMap LicenseMap) (*LicenseMap, error) {
+func (c *Client) UpdateLicenseMap(ctx context.Context, licenseMapID int, licenseMap LicenseMap) (*LicenseMap, error) {
 	licenseMapData, err := json.Marshal(licenseMap)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/license-maps/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), licenseMapID), strings.NewReader(string(licenseMapData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%s/license-maps/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), licenseMapID), strings.NewReader(string(licenseMapData)))
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +98,8 @@ func (c *Client) UpdateLicenseMap(licenseMapID int, licenseMap LicenseMap) (*Lic
 	return &licenseMapResponse.Data, nil
 }
 
-func (c *Client) DestroyLicenseMap(licenseMapID int) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%s/license-maps/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), licenseMapID), nil)
+func (c *Client) DestroyLicenseMap(ctx context.Context, licenseMapID int) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v3/accounts/%s/license-maps/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), licenseMapID), nil)
 	if err != nil {
 		return err
 	}
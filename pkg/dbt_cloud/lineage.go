@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,10 +29,12 @@ type LineageIntegrationResponse struct {
 }
 
 func (c *Client) GetLineageIntegration(
+	ctx context.Context,
 	projectID int64,
 	lineageIntegrationID int64,
 ) (*LineageIntegration, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/integrations/lineage/%d/",
@@ -61,6 +64,7 @@ func (c *Client) GetLineageIntegration(
 }
 
 func (c *Client) CreateLineageIntegration(
+	ctx context.Context,
 	projectID int64,
 	name string,
 	host string,
@@ -84,7 +88,8 @@ func (c *Client) CreateLineageIntegration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/integrations/lineage/",
@@ -113,6 +118,7 @@ func (c *Client) CreateLineageIntegration(
 }
 
 func (c *Client) UpdateLineageIntegration(
+	ctx context.Context,
 	projectID int64,
 	lineageIntegrationID int64,
 	lineageIntegration LineageIntegration,
@@ -122,7 +128,8 @@ func (c *Client) UpdateLineageIntegration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/integrations/lineage/%d/",
@@ -151,8 +158,9 @@ func (c *Client) UpdateLineageIntegration(
 	return &lineageIntegrationResponse.Data, nil
 }
 
-func (c *Client) DeleteLineageIntegration(projectID int64, lineageIntegrationID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteLineageIntegration(ctx context.Context, projectID int64, lineageIntegrationID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/integrations/lineage/%d/",
@@ -0,0 +1,50 @@
+package dbt_cloud
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedQueryParameters lists the query parameters whose values are
+// replaced in the logged URLs, matched as substrings of the lowercase name.
+var redactedQueryParameters = []string{"token", "secret", "password", "key"}
+
+// newLogContext returns the context used for the logs of a request, with the
+// API token masked from all the messages and fields so that TF_LOG output can
+// be shared safely.
+func newLogContext(ctx context.Context, token string) context.Context {
+	if token != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+		ctx = tflog.MaskMessageStrings(ctx, token)
+	}
+	return tflog.MaskFieldValuesWithFieldKeys(ctx, "authorization")
+}
+
+// redactURL returns the URL without credentials and with the values of the
+// sensitive query parameters replaced.
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	redacted := *u
+	query := redacted.Query()
+	changed := false
+	for name := range query {
+		lowerName := strings.ToLower(name)
+		for _, sensitive := range redactedQueryParameters {
+			if strings.Contains(lowerName, sensitive) {
+				query.Set(name, "REDACTED")
+				changed = true
+				break
+			}
+		}
+	}
+	if changed {
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.Redacted()
+}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,8 +27,9 @@ type ModelNotifications struct {
 	OnSkipped     bool   `json:"on_skipped"`
 }
 
-func (c *Client) GetModelNotifications(environmentID string) (*ModelNotifications, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetModelNotifications(ctx context.Context, environmentID string) (*ModelNotifications, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/environments/%s/model-notifications/",
@@ -61,6 +63,7 @@ func (c *Client) GetModelNotifications(environmentID string) (*ModelNotification
 }
 
 func (c *Client) CreateModelNotifications(
+	ctx context.Context,
 	environmentID string,
 	enabled bool,
 	onSuccess bool,
@@ -82,10 +85,11 @@ func (c *Client) CreateModelNotifications(
 		OnSkipped:     onSkipped,
 	}
 
-	return c.UpdateModelNotifications(environmentID, modelNotifications)
+	return c.UpdateModelNotifications(ctx, environmentID, modelNotifications)
 }
 
 func (c *Client) UpdateModelNotifications(
+	ctx context.Context,
 	environmentID string,
 	modelNotifications ModelNotifications,
 ) (*ModelNotifications, error) {
@@ -109,7 +113,8 @@ func (c *Client) UpdateModelNotifications(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/environments/%s/model-notifications/",
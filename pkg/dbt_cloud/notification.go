@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,8 +29,9 @@ type Notification struct {
 	SlackChannelName *string `json:"slack_channel_name"`
 }
 
-func (c *Client) GetNotification(notificationID string) (*Notification, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetNotification(ctx context.Context, notificationID string) (*Notification, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v2/accounts/%s/notifications/%s/",
@@ -63,6 +65,7 @@ func (c *Client) GetNotification(notificationID string) (*Notification, error) {
 }
 
 func (c *Client) CreateNotification(
+	ctx context.Context,
 	userId int,
 	onCancel []int,
 	onFailure []int,
@@ -93,7 +96,8 @@ func (c *Client) CreateNotification(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/v2/accounts/%s/notifications/", c.HostURL, strconv.FormatInt(c.AccountID, 10)),
 		strings.NewReader(string(newNotificationData)),
@@ -122,6 +126,7 @@ func (c *Client) CreateNotification(
 }
 
 func (c *Client) UpdateNotification(
+	ctx context.Context,
 	notificationId string,
 	notification Notification,
 ) (*Notification, error) {
@@ -130,7 +135,8 @@ func (c *Client) UpdateNotification(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v2/accounts/%s/notifications/%s/",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -42,10 +43,10 @@ type notificationSettingResponse struct {
 	Data   NotificationSetting `json:"data"`
 }
 
-func (c *Client) GetNotificationSetting(id int64) (*NotificationSetting, error) {
+func (c *Client) GetNotificationSetting(ctx context.Context, id int64) (*NotificationSetting, error) {
 	url := c.BuildAPIURL(APIVersionPrivate, fmt.Sprintf("accounts/%d/notification-settings/%d", c.AccountID, id))
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +64,7 @@ func (c *Client) GetNotificationSetting(id int64) (*NotificationSetting, error)
 	return &resp.Data, nil
 }
 
-func (c *Client) CreateNotificationSetting(setting NotificationSetting) (*NotificationSetting, error) {
+func (c *Client) CreateNotificationSetting(ctx context.Context, setting NotificationSetting) (*NotificationSetting, error) {
 	// account_id comes from the URL path, not the body.
 	setting.AccountID = 0
 
@@ -74,7 +75,7 @@ func (c *Client) CreateNotificationSetting(setting NotificationSetting) (*Notifi
 
 	url := c.BuildAPIURL(APIVersionPrivate, fmt.Sprintf("accounts/%d/notification-settings", c.AccountID))
 
-	req, err := http.NewRequest("POST", url, strings.NewReader(string(payload)))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(payload)))
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +93,7 @@ func (c *Client) CreateNotificationSetting(setting NotificationSetting) (*Notifi
 	return &resp.Data, nil
 }
 
-func (c *Client) UpdateNotificationSetting(id int64, setting NotificationSetting) (*NotificationSetting, error) {
+func (c *Client) UpdateNotificationSetting(ctx context.Context, id int64, setting NotificationSetting) (*NotificationSetting, error) {
 	setting.AccountID = 0
 
 	payload, err := json.Marshal(setting)
@@ -102,7 +103,7 @@ func (c *Client) UpdateNotificationSetting(id int64, setting NotificationSetting
 
 	url := c.BuildAPIURL(APIVersionPrivate, fmt.Sprintf("accounts/%d/notification-settings/%d", c.AccountID, id))
 
-	req, err := http.NewRequest("PATCH", url, strings.NewReader(string(payload)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, strings.NewReader(string(payload)))
 	if err != nil {
 		return nil, err
 	}
@@ -120,10 +121,10 @@ func (c *Client) UpdateNotificationSetting(id int64, setting NotificationSetting
 	return &resp.Data, nil
 }
 
-func (c *Client) DeleteNotificationSetting(id int64) error {
+func (c *Client) DeleteNotificationSetting(ctx context.Context, id int64) error {
 	url := c.BuildAPIURL(APIVersionPrivate, fmt.Sprintf("accounts/%d/notification-settings/%d", c.AccountID, id))
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
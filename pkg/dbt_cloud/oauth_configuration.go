@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -36,8 +37,9 @@ type OAuthConfigurationResponse struct {
 	Status ResponseStatus     `json:"status"`
 }
 
-func (c *Client) GetOAuthConfiguration(oAuthConfigurationID int64) (*OAuthConfiguration, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetOAuthConfiguration(ctx context.Context, oAuthConfigurationID int64) (*OAuthConfiguration, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/oauth-configurations/%d/",
@@ -66,6 +68,7 @@ func (c *Client) GetOAuthConfiguration(oAuthConfigurationID int64) (*OAuthConfig
 }
 
 func (c *Client) CreateOAuthConfiguration(
+	ctx context.Context,
 	oAuthType string,
 	name string,
 	clientId string,
@@ -98,7 +101,8 @@ func (c *Client) CreateOAuthConfiguration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/oauth-configurations/",
@@ -126,6 +130,7 @@ func (c *Client) CreateOAuthConfiguration(
 }
 
 func (c *Client) UpdateOAuthConfiguration(
+	ctx context.Context,
 	oAuthConfigurationID int64,
 	oAuthConfiguration OAuthConfiguration,
 ) (*OAuthConfiguration, error) {
@@ -134,7 +139,8 @@ func (c *Client) UpdateOAuthConfiguration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/oauth-configurations/%d/",
@@ -163,9 +169,11 @@ func (c *Client) UpdateOAuthConfiguration(
 }
 
 func (c *Client) DeleteOAuthConfiguration(
+	ctx context.Context,
 	oAuthConfigurationID int64,
 ) error {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/oauth-configurations/%d/",
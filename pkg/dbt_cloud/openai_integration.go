@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,8 +31,9 @@ type OpenAIIntegrationListResponse struct {
 	Status ResponseStatus      `json:"status"`
 }
 
-func (c *Client) GetOpenAIIntegration(integrationID int64) (*OpenAIIntegration, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetOpenAIIntegration(ctx context.Context, integrationID int64) (*OpenAIIntegration, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/integrations/open-ai/%d/",
@@ -58,7 +60,7 @@ func (c *Client) GetOpenAIIntegration(integrationID int64) (*OpenAIIntegration,
 	return &resp.Data, nil
 }
 
-func (c *Client) CreateOpenAIIntegration(integration OpenAIIntegration) (*OpenAIIntegration, error) {
+func (c *Client) CreateOpenAIIntegration(ctx context.Context, integration OpenAIIntegration) (*OpenAIIntegration, error) {
 	// account_id is passed via the URL path — the API rejects it in the body.
 	integration.AccountID = 0
 
@@ -67,7 +69,8 @@ func (c *Client) CreateOpenAIIntegration(integration OpenAIIntegration) (*OpenAI
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/integrations/open-ai/",
@@ -93,7 +96,7 @@ func (c *Client) CreateOpenAIIntegration(integration OpenAIIntegration) (*OpenAI
 	return &resp.Data, nil
 }
 
-func (c *Client) UpdateOpenAIIntegration(integrationID int64, integration OpenAIIntegration) (*OpenAIIntegration, error) {
+func (c *Client) UpdateOpenAIIntegration(ctx context.Context, integrationID int64, integration OpenAIIntegration) (*OpenAIIntegration, error) {
 	// account_id is passed via the URL path; zero it out to avoid API rejection.
 	integration.AccountID = 0
 
@@ -102,7 +105,8 @@ func (c *Client) UpdateOpenAIIntegration(integrationID int64, integration OpenAI
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/integrations/open-ai/%d/",
@@ -129,8 +133,9 @@ func (c *Client) UpdateOpenAIIntegration(integrationID int64, integration OpenAI
 	return &resp.Data, nil
 }
 
-func (c *Client) DeleteOpenAIIntegration(integrationID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteOpenAIIntegration(ctx context.Context, integrationID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/integrations/open-ai/%d/",
@@ -1,13 +1,13 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/samber/lo"
-	"github.com/sirupsen/logrus"
 )
 
 type Response struct {
@@ -29,12 +29,10 @@ type Pagination struct {
 	TotalCount int `json:"total_count"`
 }
 
-var log = logrus.New()
-
-func (c *Client) GetEndpoint(url string) ([]byte, error) {
-	req, err := http.NewRequest("GET", url, nil)
+func (c *Client) GetEndpoint(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		log.Fatalf("Error creating a new request: %v", err)
+		return nil, err
 	}
 
 	resp, err := c.doRequestWithRetry(req)
@@ -45,10 +43,10 @@ func (c *Client) GetEndpoint(url string) ([]byte, error) {
 	return resp, err
 }
 
-func (c *Client) GetRawData(url string) ([]json.RawMessage, error) {
+func (c *Client) GetRawData(ctx context.Context, url string) ([]json.RawMessage, error) {
 
 	// get the first page
-	jsonPayload, err := c.GetEndpoint(url)
+	jsonPayload, err := c.GetEndpoint(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +72,7 @@ func (c *Client) GetRawData(url string) ([]json.RawMessage, error) {
 			newURL = fmt.Sprintf("%s?offset=%d", url, count)
 		}
 
-		jsonPayload, err := c.GetEndpoint(newURL)
+		jsonPayload, err := c.GetEndpoint(ctx, newURL)
 		if err != nil {
 			return nil, err
 		}
@@ -98,43 +96,46 @@ func (c *Client) GetRawData(url string) ([]json.RawMessage, error) {
 	return allResponses, nil
 }
 
-func (c *Client) GetData(url string) []any {
-	rawData, err := c.GetRawData(url)
+func (c *Client) GetData(ctx context.Context, url string) ([]any, error) {
+	rawData, err := c.GetRawData(ctx, url)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	allData := make([]any, len(rawData))
 	for i, data := range rawData {
 		err := json.Unmarshal(data, &allData[i])
 		if err != nil {
-			log.Fatal(err)
+			return nil, err
 		}
 	}
-	return allData
+	return allData, nil
 }
 
-func (c *Client) GetAllGroupIDsByName(groupName string) []int {
+func (c *Client) GetAllGroupIDsByName(ctx context.Context, groupName string) ([]int, error) {
 	url := c.BuildAccountV3URL(ResourceGroups)
 
-	allGroupsRaw := c.GetData(url)
+	allGroupsRaw, err := c.GetData(ctx, url)
+	if err != nil {
+		return nil, err
+	}
 
 	return lo.FilterMap(allGroupsRaw, func(group any, _ int) (int, bool) {
 		if group.(map[string]any)["name"].(string) == groupName {
 			return int(group.(map[string]any)["id"].(float64)), true
 		}
 		return 0, false
-	})
+	}), nil
 }
 
-func (c *Client) GetAllEnvironments(projectID int) ([]Environment, error) {
+func (c *Client) GetAllEnvironments(ctx context.Context, projectID int) ([]Environment, error) {
 	url := fmt.Sprintf("%s/v3/accounts/%d/environments/", c.HostURL, c.AccountID)
 
 	if projectID != 0 {
 		url = fmt.Sprintf("%s?project_id=%d", url, projectID)
 	}
 
-	allEnvironmentsRaw, err := c.GetRawData(url)
+	allEnvironmentsRaw, err := c.GetRawData(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -153,10 +154,13 @@ func (c *Client) GetAllEnvironments(projectID int) ([]Environment, error) {
 	return allEnvs, nil
 }
 
-func (c *Client) GetAllNotifications() ([]Notification, error) {
+func (c *Client) GetAllNotifications(ctx context.Context) ([]Notification, error) {
 	url := fmt.Sprintf("%s/v2/accounts/%d/notifications/", c.HostURL, c.AccountID)
 
-	allNotificationsRaw := c.GetData(url)
+	allNotificationsRaw, err := c.GetData(ctx, url)
+	if err != nil {
+		return nil, err
+	}
 
 	allNotifications := []Notification{}
 	for _, notification := range allNotificationsRaw {
@@ -172,10 +176,13 @@ func (c *Client) GetAllNotifications() ([]Notification, error) {
 	return allNotifications, nil
 }
 
-func (c *Client) GetAllServiceTokens() ([]ServiceToken, error) {
+func (c *Client) GetAllServiceTokens(ctx context.Context) ([]ServiceToken, error) {
 	url := fmt.Sprintf("%s/v3/accounts/%d/service-tokens/?state=1", c.HostURL, c.AccountID)
 
-	allServiceTokensRaw := c.GetData(url)
+	allServiceTokensRaw, err := c.GetData(ctx, url)
+	if err != nil {
+		return nil, err
+	}
 
 	allServiceTokens := []ServiceToken{}
 	for _, notification := range allServiceTokensRaw {
@@ -191,10 +198,13 @@ func (c *Client) GetAllServiceTokens() ([]ServiceToken, error) {
 	return allServiceTokens, nil
 }
 
-func (c *Client) GetAllLicenseMaps() ([]LicenseMap, error) {
+func (c *Client) GetAllLicenseMaps(ctx context.Context) ([]LicenseMap, error) {
 	url := fmt.Sprintf("%s/v3/accounts/%d/license-maps/", c.HostURL, c.AccountID)
 
-	allLicenseMapsRaw := c.GetData(url)
+	allLicenseMapsRaw, err := c.GetData(ctx, url)
+	if err != nil {
+		return nil, err
+	}
 
 	allLicenseMaps := []LicenseMap{}
 	for _, notification := range allLicenseMapsRaw {
@@ -210,7 +220,7 @@ func (c *Client) GetAllLicenseMaps() ([]LicenseMap, error) {
 	return allLicenseMaps, nil
 }
 
-func (c *Client) GetAllJobs(projectID int, environmentID int) ([]JobWithEnvironment, error) {
+func (c *Client) GetAllJobs(ctx context.Context, projectID int, environmentID int) ([]JobWithEnvironment, error) {
 	var url string
 
 	if projectID != 0 && environmentID != 0 {
@@ -239,7 +249,10 @@ func (c *Client) GetAllJobs(projectID int, environmentID int) ([]JobWithEnvironm
 		)
 	}
 
-	allJobsRaw := c.GetData(url)
+	allJobsRaw, err := c.GetData(ctx, url)
+	if err != nil {
+		return nil, err
+	}
 
 	allJobs := []JobWithEnvironment{}
 	for _, job := range allJobsRaw {
@@ -254,3 +267,22 @@ func (c *Client) GetAllJobs(projectID int, environmentID int) ([]JobWithEnvironm
 	}
 	return allJobs, nil
 }
+
+// GetAllAccountJobs returns the jobs of all the projects of the account, as the
+// jobs listing needs to be filtered by project or environment
+func (c *Client) GetAllAccountJobs(ctx context.Context) ([]JobWithEnvironment, error) {
+	projects, err := c.GetAllProjects(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	allJobs := []JobWithEnvironment{}
+	for _, project := range projects {
+		jobs, err := c.GetAllJobs(ctx, int(project.ID), 0)
+		if err != nil {
+			return nil, err
+		}
+		allJobs = append(allJobs, jobs...)
+	}
+	return allJobs, nil
+}
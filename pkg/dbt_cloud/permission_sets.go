@@ -0,0 +1,65 @@
+package dbt_cloud
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+// permissionSetsData is the catalog of the permission sets, kept as data so
+// that supporting a new permission set doesn't require changing the code
+//
+//go:embed permission_sets.json
+var permissionSetsData []byte
+
+// PermissionSet describes a permission set that can be granted to groups and
+// service tokens
+type PermissionSet struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// ProjectScoped is true when the permission set can be restricted to a
+	// project, the other ones apply to the whole account
+	ProjectScoped bool `json:"project_scoped"`
+	// WritableEnvironmentCategories is true when Write access can be
+	// restricted to some environment categories
+	WritableEnvironmentCategories bool `json:"writable_environment_categories"`
+	// Enterprise is true when the permission set is only available on
+	// Enterprise plans
+	Enterprise bool `json:"enterprise"`
+}
+
+type permissionSetCatalog struct {
+	Version        int             `json:"version"`
+	PermissionSets []PermissionSet `json:"permission_sets"`
+}
+
+// PermissionSetCatalog lists all the permission sets, in the order of the
+// catalog
+var PermissionSetCatalog = mustLoadPermissionSets(permissionSetsData)
+
+func mustLoadPermissionSets(data []byte) []PermissionSet {
+	var catalog permissionSetCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		panic(fmt.Sprintf("invalid permission set catalog: %s", err))
+	}
+	return catalog.PermissionSets
+}
+
+// LookupPermissionSet returns the permission set with the given name, and
+// false when it is not in the catalog
+func LookupPermissionSet(name string) (PermissionSet, bool) {
+	for _, permissionSet := range PermissionSetCatalog {
+		if permissionSet.Name == name {
+			return permissionSet, true
+		}
+	}
+	return PermissionSet{}, false
+}
+
+func permissionSetNames(permissionSets []PermissionSet) []string {
+	names := make([]string, len(permissionSets))
+	for i, permissionSet := range permissionSets {
+		names[i] = permissionSet.Name
+	}
+	return names
+}
@@ -0,0 +1,42 @@
+package dbt_cloud
+
+import (
+	"testing"
+)
+
+func TestPermissionSetCatalog(t *testing.T) {
+	if len(PermissionSetCatalog) == 0 {
+		t.Fatal("expected the catalog to list permission sets")
+	}
+	names := map[string]bool{}
+	for _, permissionSet := range PermissionSetCatalog {
+		if permissionSet.Name == "" || permissionSet.DisplayName == "" {
+			t.Errorf("expected a name and a display name, got %+v", permissionSet)
+		}
+		if names[permissionSet.Name] {
+			t.Errorf("duplicate permission set %s", permissionSet.Name)
+		}
+		names[permissionSet.Name] = true
+		// environment categories only exist in projects
+		if permissionSet.WritableEnvironmentCategories && !permissionSet.ProjectScoped {
+			t.Errorf("expected %s to be project scoped to support environment categories", permissionSet.Name)
+		}
+	}
+	if len(PermissionSets) != len(PermissionSetCatalog) {
+		t.Errorf("expected PermissionSets to list the catalog, got %v", PermissionSets)
+	}
+}
+
+func TestLookupPermissionSet(t *testing.T) {
+	developer, ok := LookupPermissionSet("developer")
+	if !ok || !developer.ProjectScoped || !developer.WritableEnvironmentCategories {
+		t.Errorf("expected developer to be project scoped with environment categories, got %+v", developer)
+	}
+	member, ok := LookupPermissionSet("member")
+	if !ok || member.ProjectScoped || member.Enterprise {
+		t.Errorf("expected member to be an account level set available on all plans, got %+v", member)
+	}
+	if _, ok := LookupPermissionSet("superuser"); ok {
+		t.Error("expected superuser not to be in the catalog")
+	}
+}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -51,8 +52,9 @@ type PlatformMetadataCredentialConfig struct {
 }
 
 // GetPlatformMetadataCredential retrieves a single platform metadata credential by ID
-func (c *Client) GetPlatformMetadataCredential(credentialID int64) (*PlatformMetadataCredential, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetPlatformMetadataCredential(ctx context.Context, credentialID int64) (*PlatformMetadataCredential, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/platform-metadata-credentials/%d/",
@@ -81,8 +83,9 @@ func (c *Client) GetPlatformMetadataCredential(credentialID int64) (*PlatformMet
 }
 
 // ListPlatformMetadataCredentials retrieves all platform metadata credentials for the account
-func (c *Client) ListPlatformMetadataCredentials() ([]PlatformMetadataCredential, error) {
-	req, err := http.NewRequest(
+func (c *Client) ListPlatformMetadataCredentials(ctx context.Context) ([]PlatformMetadataCredential, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/platform-metadata-credentials/",
@@ -111,6 +114,7 @@ func (c *Client) ListPlatformMetadataCredentials() ([]PlatformMetadataCredential
 
 // CreatePlatformMetadataCredential creates a new platform metadata credential
 func (c *Client) CreatePlatformMetadataCredential(
+	ctx context.Context,
 	credential PlatformMetadataCredential,
 ) (*PlatformMetadataCredential, error) {
 	credential.AccountID = int64(c.AccountID)
@@ -120,7 +124,8 @@ func (c *Client) CreatePlatformMetadataCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/platform-metadata-credentials/",
@@ -149,6 +154,7 @@ func (c *Client) CreatePlatformMetadataCredential(
 
 // UpdatePlatformMetadataCredential updates an existing platform metadata credential
 func (c *Client) UpdatePlatformMetadataCredential(
+	ctx context.Context,
 	credentialID int64,
 	credential PlatformMetadataCredential,
 ) (*PlatformMetadataCredential, error) {
@@ -159,7 +165,8 @@ func (c *Client) UpdatePlatformMetadataCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/platform-metadata-credentials/%d/",
@@ -188,8 +195,9 @@ func (c *Client) UpdatePlatformMetadataCredential(
 }
 
 // DeletePlatformMetadataCredential soft-deletes a platform metadata credential
-func (c *Client) DeletePlatformMetadataCredential(credentialID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeletePlatformMetadataCredential(ctx context.Context, credentialID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/platform-metadata-credentials/%d/",
@@ -208,8 +216,9 @@ func (c *Client) DeletePlatformMetadataCredential(credentialID int64) error {
 }
 
 // TriggerPlatformMetadataIngestion triggers catalog ingestion for a credential
-func (c *Client) TriggerPlatformMetadataIngestion(credentialID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) TriggerPlatformMetadataIngestion(ctx context.Context, credentialID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/platform-metadata-credentials/%d/trigger-ingestion/",
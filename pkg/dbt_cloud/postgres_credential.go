@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,10 +28,12 @@ type PostgresCredentialResponse struct {
 
 // GetPostgresCredential retrieves a specific Postgres credential by its ID
 func (c *Client) GetPostgresCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*PostgresCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -61,6 +64,7 @@ func (c *Client) GetPostgresCredential(
 
 // CreatePostgresCredential creates a new Postgres credential
 func (c *Client) CreatePostgresCredential(
+	ctx context.Context,
 	projectId int,
 	isActive bool,
 	type_ string,
@@ -86,7 +90,8 @@ func (c *Client) CreatePostgresCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -116,6 +121,7 @@ func (c *Client) CreatePostgresCredential(
 
 // UpdatePostgresCredential updates an existing Postgres credential
 func (c *Client) UpdatePostgresCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	postgresCredential PostgresCredential,
@@ -125,7 +131,8 @@ func (c *Client) UpdatePostgresCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -155,8 +162,9 @@ func (c *Client) UpdatePostgresCredential(
 }
 
 // DeletePostgresCredential deletes a Postgres credential by its ID
-func (c *Client) DeletePostgresCredential(credentialId, projectId string) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeletePostgresCredential(ctx context.Context, credentialId, projectId string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%s/credentials/%s/",
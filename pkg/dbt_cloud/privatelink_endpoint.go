@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -25,7 +26,7 @@ type PrivatelinkEndpointResponse struct {
 	Status ResponseStatus      `json:"status"`
 }
 
-func (c *Client) GetPrivatelinkEndpoint(endpointName string, privatelinkEndpointURL string) (*PrivatelinkEndpoint, error) {
+func (c *Client) GetPrivatelinkEndpoint(ctx context.Context, endpointName string, privatelinkEndpointURL string) (*PrivatelinkEndpoint, error) {
 
 	if endpointName == "" && privatelinkEndpointURL == "" {
 		return nil, fmt.Errorf("the endpoint name or url needs to be provided")
@@ -33,7 +34,7 @@ func (c *Client) GetPrivatelinkEndpoint(endpointName string, privatelinkEndpoint
 
 	url := c.BuildAccountV3URL(ResourcePrivatelinkEndpoints)
 
-	allPrivatelinkEndpointsRaw, err := c.GetRawData(url)
+	allPrivatelinkEndpointsRaw, err := c.GetRawData(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get raw data for PrivateLink endpoints: %w", err)
 	}
@@ -55,10 +56,10 @@ func (c *Client) GetPrivatelinkEndpoint(endpointName string, privatelinkEndpoint
 	return nil, fmt.Errorf("did not find PrivateLink endpoint with name = '%s' and/or endpoint = '%s'", endpointName, privatelinkEndpointURL)
 }
 
-func (c *Client) GetAllPrivatelinkEndpoints() ([]PrivatelinkEndpoint, error) {
+func (c *Client) GetAllPrivatelinkEndpoints(ctx context.Context) ([]PrivatelinkEndpoint, error) {
 	url := c.BuildAccountV3URL(ResourcePrivatelinkEndpoints)
 
-	allPrivatelinkEndpointsRaw, err := c.GetRawData(url)
+	allPrivatelinkEndpointsRaw, err := c.GetRawData(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get raw data for all PrivateLink endpoints: %w", err)
 	}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,8 +28,9 @@ type Profile struct {
 	ExtendedAttributesID *int   `json:"extended_attributes_id"`
 }
 
-func (c *Client) GetProfile(projectID int, profileID int) (*Profile, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetProfile(ctx context.Context, projectID int, profileID int) (*Profile, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/profiles/%d/",
@@ -57,8 +59,9 @@ func (c *Client) GetProfile(projectID int, profileID int) (*Profile, error) {
 	return &profileResponse.Data, nil
 }
 
-func (c *Client) GetAllProfiles(projectID int) ([]Profile, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetAllProfiles(ctx context.Context, projectID int) ([]Profile, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/profiles/",
@@ -87,6 +90,7 @@ func (c *Client) GetAllProfiles(projectID int) ([]Profile, error) {
 }
 
 func (c *Client) CreateProfile(
+	ctx context.Context,
 	projectID int,
 	key string,
 	connectionID int,
@@ -107,7 +111,8 @@ func (c *Client) CreateProfile(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/profiles/",
@@ -136,6 +141,7 @@ func (c *Client) CreateProfile(
 }
 
 func (c *Client) UpdateProfile(
+	ctx context.Context,
 	projectID int,
 	profileID int,
 	profile Profile,
@@ -145,7 +151,8 @@ func (c *Client) UpdateProfile(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/profiles/%d/",
@@ -174,8 +181,9 @@ func (c *Client) UpdateProfile(
 	return &profileResponse.Data, nil
 }
 
-func (c *Client) DeleteProfile(projectID int, profileID int) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteProfile(ctx context.Context, projectID int, profileID int) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/profiles/%d/",
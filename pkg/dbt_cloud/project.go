@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -36,8 +37,9 @@ type ProjectResponse struct {
 
 const InvalidFileCharacters = `#%&{}<>*?$!'":@`
 
-func (c *Client) GetProjectByName(projectName string) (*Project, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetProjectByName(ctx context.Context, projectName string) (*Project, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/?include_related=[freshness_job_id,docs_job_id]",
@@ -68,7 +70,8 @@ func (c *Client) GetProjectByName(projectName string) (*Project, error) {
 		numProjects := projectListResponse.Extra.Pagination.Count
 		for numProjects < projectListResponse.Extra.Pagination.TotalCount {
 
-			req, err := http.NewRequest(
+			req, err := http.NewRequestWithContext(
+				ctx,
 				"GET",
 				fmt.Sprintf(
 					"%s/v3/accounts/%s/projects/?include_related=[freshness_job_id,docs_job_id]&offset=%d",
@@ -123,8 +126,9 @@ func (c *Client) GetProjectByName(projectName string) (*Project, error) {
 	return &matchingProjects[0], nil
 }
 
-func (c *Client) GetProject(projectID string) (*Project, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetProject(ctx context.Context, projectID string) (*Project, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/?include_related=[freshness_job_id,docs_job_id]",
@@ -158,6 +162,7 @@ func (c *Client) GetProject(projectID string) (*Project, error) {
 }
 
 func (c *Client) CreateProject(
+	ctx context.Context,
 	name string,
 	description string,
 	dbtProjectSubdirectory string,
@@ -184,7 +189,8 @@ func (c *Client) CreateProject(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/v3/accounts/%s/projects/", c.HostURL, strconv.FormatInt(c.AccountID, 10)),
 		strings.NewReader(string(newProjectData)),
@@ -212,7 +218,7 @@ func (c *Client) CreateProject(
 	return &projectResponse.Data, nil
 }
 
-func (c *Client) UpdateProject(projectID string, project Project) (*Project, error) {
+func (c *Client) UpdateProject(ctx context.Context, projectID string, project Project) (*Project, error) {
 	if project.DbtProjectSubdirectory != nil {
 		*project.DbtProjectSubdirectory = strings.TrimSpace(*project.DbtProjectSubdirectory)
 		if err := IsValidSubdirectory(*project.DbtProjectSubdirectory); err != nil {
@@ -225,7 +231,8 @@ func (c *Client) UpdateProject(projectID string, project Project) (*Project, err
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/",
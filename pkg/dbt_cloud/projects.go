@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -29,7 +30,7 @@ type ProjectConnectionRepository struct {
 	FreshnessJob           any                                   `json:"freshness_job,omitempty"`
 }
 
-func (c *Client) GetAllProjects(nameContains string) ([]ProjectConnectionRepository, error) {
+func (c *Client) GetAllProjects(ctx context.Context, nameContains string) ([]ProjectConnectionRepository, error) {
 	var url string
 
 	if nameContains == "" {
@@ -47,7 +48,10 @@ func (c *Client) GetAllProjects(nameContains string) ([]ProjectConnectionReposit
 		)
 	}
 
-	allProjectsRaw := c.GetData(url)
+	allProjectsRaw, err := c.GetData(ctx, url)
+	if err != nil {
+		return nil, err
+	}
 
 	allProjects := []ProjectConnectionRepository{}
 	for _, job := range allProjectsRaw {
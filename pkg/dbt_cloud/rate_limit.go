@@ -0,0 +1,130 @@
+package dbt_cloud
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// epochThreshold separates X-RateLimit-Reset values sent as a number of
+// seconds to wait from the ones sent as a Unix timestamp.
+const epochThreshold = 1_000_000_000
+
+// rateLimiter throttles the requests sent by a Client. It is shared by all the
+// resources using the client, so the limit applies to the whole provider
+// regardless of Terraform's parallelism.
+type rateLimiter struct {
+	// limiter is nil when no client-side limit is configured
+	limiter *rate.Limiter
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	l := &rateLimiter{}
+	if requestsPerSecond > 0 {
+		burst := int(math.Max(1, math.Ceil(requestsPerSecond)))
+		l.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return l
+}
+
+// wait blocks until a request can be sent, either because the API asked us to
+// pause via its rate limit headers or because of the client-side limit.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	pause := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+
+	if err := sleepWithContext(ctx, pause); err != nil {
+		return err
+	}
+
+	if l.limiter == nil {
+		return nil
+	}
+	return l.limiter.Wait(ctx)
+}
+
+// pauseUntil holds all the requests until t, used when the API reports that
+// the rate limit has been reached.
+func (l *rateLimiter) pauseUntil(t time.Time) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if t.After(l.pausedUntil) {
+		l.pausedUntil = t
+	}
+}
+
+// SetMaxRequestsPerSecond limits the number of requests per second sent by the
+// client, 0 removes the limit.
+func (c *Client) SetMaxRequestsPerSecond(requestsPerSecond float64) {
+	c.rateLimiter = newRateLimiter(requestsPerSecond)
+}
+
+// rateLimitWait returns how long the API asked us to wait before sending the
+// next request, from the Retry-After header or from the X-RateLimit-Remaining
+// and X-RateLimit-Reset headers. It returns 0 when there is no such hint.
+func rateLimitWait(header http.Header, now time.Time) time.Duration {
+	if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(max(seconds, 0)) * time.Second
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return max(date.Sub(now), 0)
+		}
+	}
+
+	if header.Get("X-RateLimit-Remaining") != "0" {
+		return 0
+	}
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil || reset <= 0 {
+		return 0
+	}
+	if reset >= epochThreshold {
+		return max(time.Unix(reset, 0).Sub(now), 0)
+	}
+	return time.Duration(reset) * time.Second
+}
+
+// backoffWithJitter returns the exponential backoff for the given attempt,
+// starting at interval, with up to 50% of random jitter added so that the
+// parallel requests failing at the same time don't retry all at once.
+func backoffWithJitter(interval time.Duration, attempt int) time.Duration {
+	backoff := interval * (1 << attempt)
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff + rand.N(backoff/2+1)
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+package dbt_cloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitWait(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		header   map[string]string
+		expected time.Duration
+	}{
+		{name: "no header", expected: 0},
+		{name: "retry after seconds", header: map[string]string{"Retry-After": "7"}, expected: 7 * time.Second},
+		{name: "retry after date", header: map[string]string{"Retry-After": now.Add(3 * time.Second).Format(http.TimeFormat)}, expected: 3 * time.Second},
+		{name: "retry after date in the past", header: map[string]string{"Retry-After": now.Add(-time.Minute).Format(http.TimeFormat)}, expected: 0},
+		{name: "retry after invalid", header: map[string]string{"Retry-After": "soon"}, expected: 0},
+		{name: "remaining requests", header: map[string]string{"X-RateLimit-Remaining": "4", "X-RateLimit-Reset": "30"}, expected: 0},
+		{name: "reset in seconds", header: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "30"}, expected: 30 * time.Second},
+		{name: "reset as timestamp", header: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(now.Add(time.Minute).Unix(), 10)}, expected: time.Minute},
+		{name: "retry after takes precedence", header: map[string]string{"Retry-After": "2", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "30"}, expected: 2 * time.Second},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{}
+			for key, value := range tc.header {
+				header.Set(key, value)
+			}
+			if got := rateLimitWait(header, now); got != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestBackoffWithJitter(t *testing.T) {
+	for attempt := 0; attempt < 4; attempt++ {
+		base := time.Second * (1 << attempt)
+		for i := 0; i < 20; i++ {
+			got := backoffWithJitter(time.Second, attempt)
+			if got < base || got > base+base/2 {
+				t.Fatalf("attempt %d: expected a backoff between %v and %v, got %v", attempt, base, base+base/2, got)
+			}
+		}
+	}
+
+	if got := backoffWithJitter(0, 2); got != 0 {
+		t.Fatalf("expected no backoff without interval, got %v", got)
+	}
+}
+
+// TestDoRequestWithRetry_HonorsRetryAfter verifies that the wait requested by
+// the API is used instead of the configured retry interval, which is zeroed
+// out in the test client.
+func TestDoRequestWithRetry_HonorsRetryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "slow down", http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 3, nil)
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/test/", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	start := time.Now()
+	if _, err := c.doRequestWithRetry(req); err != nil {
+		t.Fatalf("expected success after retry, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected to wait for the Retry-After duration, waited %v", elapsed)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+// TestDoRequestWithRetry_PausesWhenLimitReached verifies that a successful
+// response reporting that no request is remaining delays the next request
+// until the limit is reset.
+func TestDoRequestWithRetry_PausesWhenLimitReached(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 1, nil)
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/test/", nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		if _, err := c.doRequestWithRetry(req); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected the second request to wait for the reset, waited %v", elapsed)
+	}
+}
+
+func TestSetMaxRequestsPerSecond(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 1, nil)
+	c.SetMaxRequestsPerSecond(20)
+
+	// the first 20 requests use the burst, the next 10 are spread over 500ms
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/test/", nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		if _, err := c.doRequestWithRetry(req); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected the requests to be throttled, 30 requests took %v", elapsed)
+	}
+	if got := requests.Load(); got != 30 {
+		t.Fatalf("expected 30 requests, got %d", got)
+	}
+}
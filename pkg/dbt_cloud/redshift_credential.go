@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -25,10 +26,12 @@ type RedshiftCredential struct {
 }
 
 func (c *Client) GetRedshiftCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*RedshiftCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -58,6 +61,7 @@ func (c *Client) GetRedshiftCredential(
 }
 
 func (c *Client) CreateRedshiftCredential(
+	ctx context.Context,
 	projectId int,
 	type_ string,
 	isActive bool,
@@ -82,7 +86,8 @@ func (c *Client) CreateRedshiftCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -111,6 +116,7 @@ func (c *Client) CreateRedshiftCredential(
 }
 
 func (c *Client) UpdateRedshiftCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	RedshiftCredential RedshiftCredential,
@@ -120,7 +126,8 @@ func (c *Client) UpdateRedshiftCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -47,6 +48,7 @@ type RepositoryResponse struct {
 }
 
 func (c *Client) GetRepository(
+	ctx context.Context,
 	repositoryID, projectID string,
 ) (*Repository, error) {
 
@@ -58,7 +60,7 @@ func (c *Client) GetRepository(
 		repositoryID,
 	)
 
-	req, err := http.NewRequest("GET", repositoryUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", repositoryUrl, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +80,7 @@ func (c *Client) GetRepository(
 }
 
 func (c *Client) CreateRepository(
+	ctx context.Context,
 	projectID int,
 	remoteUrl string,
 	isActive bool,
@@ -122,7 +125,8 @@ func (c *Client) CreateRepository(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/repositories/",
@@ -169,6 +173,7 @@ func (c *Client) CreateRepository(
 		}
 
 		updatedRepo, err := c.UpdateRepository(
+			ctx,
 			strconv.Itoa(*repositoryResponse.Data.ID),
 			strconv.Itoa(projectID),
 			newRepository,
@@ -183,6 +188,7 @@ func (c *Client) CreateRepository(
 }
 
 func (c *Client) UpdateRepository(
+	ctx context.Context,
 	repositoryID, projectID string,
 	repository Repository,
 ) (*Repository, error) {
@@ -203,7 +209,8 @@ func (c *Client) UpdateRepository(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/repositories/%s/",
@@ -232,8 +239,9 @@ func (c *Client) UpdateRepository(
 	return &repositoryResponse.Data, nil
 }
 
-func (c *Client) DeleteRepository(repositoryID, projectID string) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteRepository(ctx context.Context, repositoryID, projectID string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/repositories/%s/",
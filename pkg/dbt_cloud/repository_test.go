@@ -1,6 +1,7 @@
 package dbt_cloud_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
@@ -44,6 +45,7 @@ func TestCreateRepository_PreservesRemoteBackendAndFullName(t *testing.T) {
 	client := testutil.CreateTestClient(server.URL(), accountID)
 
 	_, err := client.CreateRepository(
+		context.Background(),
 		projectID,
 		"git@github.com:test/repo.git",
 		true,
@@ -120,6 +122,7 @@ func TestCreateRepository_ADO_StripsAzureFieldsOnUpdate(t *testing.T) {
 
 	// A pull_request_url_template forces the follow-up UpdateRepository call.
 	_, err := client.CreateRepository(
+		context.Background(),
 		projectID,
 		"https://abc@dev.azure.com/abc/def/_git/my_repo",
 		true,
@@ -14,8 +14,8 @@ func init() {
 	validate = validator.New(validator.WithRequiredStructEnabled())
 }
 
-// ValidationError wraps validator errors with the API response for debugging
-type ValidationError struct {
+// ResponseValidationError wraps validator errors with the API response for debugging
+type ResponseValidationError struct {
 	Message     string
 	FieldErrors []FieldError
 	Response    interface{}
@@ -28,7 +28,7 @@ type FieldError struct {
 	Message string
 }
 
-func (e *ValidationError) Error() string {
+func (e *ResponseValidationError) Error() string {
 	responseJSON, _ := json.MarshalIndent(e.Response, "", "  ")
 
 	msg := fmt.Sprintf("API response validation failed:\n%s\n\nFields with errors:\n", e.Message)
@@ -64,7 +64,7 @@ func ValidateResponse(response interface{}, resourceType string) error {
 		})
 	}
 
-	return &ValidationError{
+	return &ResponseValidationError{
 		Message:     fmt.Sprintf("Validation failed for %s", resourceType),
 		FieldErrors: fieldErrors,
 		Response:    response,
@@ -35,9 +35,9 @@ func TestValidateResponse_NilID(t *testing.T) {
 		t.Error("ValidateResponse() should return error for nil ID")
 	}
 
-	validationErr, ok := err.(*ValidationError)
+	validationErr, ok := err.(*ResponseValidationError)
 	if !ok {
-		t.Errorf("Expected ValidationError type, got %T", err)
+		t.Errorf("Expected ResponseValidationError type, got %T", err)
 	}
 
 	if validationErr != nil {
@@ -68,9 +68,9 @@ func TestValidateResponse_ZeroID(t *testing.T) {
 		t.Error("ValidateResponse() should return error for zero ID")
 	}
 
-	validationErr, ok := err.(*ValidationError)
+	validationErr, ok := err.(*ResponseValidationError)
 	if !ok {
-		t.Errorf("Expected ValidationError type, got %T", err)
+		t.Errorf("Expected ResponseValidationError type, got %T", err)
 	}
 
 	if validationErr != nil {
@@ -143,7 +143,7 @@ func TestValidateResponse_ModelNotificationsNilID(t *testing.T) {
 }
 
 func TestValidationError_ErrorMessage(t *testing.T) {
-	// Test that ValidationError produces a helpful error message
+	// Test that ResponseValidationError produces a helpful error message
 	zeroID := 0
 	notification := Notification{
 		Id:        &zeroID,
@@ -1,22 +1,98 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
+)
+
+// Run statuses returned by the API
+const (
+	RunStatusQueued    = 1
+	RunStatusStarting  = 2
+	RunStatusRunning   = 3
+	RunStatusSuccess   = 10
+	RunStatusError     = 20
+	RunStatusCancelled = 30
 )
 
 type Run struct {
-	ID                  int64  `json:"id,omitempty"`
-	AccountID           int64  `json:"account_id"`
-	JobID               int    `json:"job_id"`
-	GitSHA              string `json:"git_sha,omitempty"`
-	GitBranch           string `json:"git_branch,omitempty"`
-	GitHubPullRequestID string `json:"github_pull_request_id,omitempty"`
-	SchemaOverride      string `json:"schema_override,omitempty"`
-	Cause               string `json:"cause,omitempty"`
+	ID                  int64       `json:"id,omitempty"`
+	AccountID           int64       `json:"account_id"`
+	JobID               int         `json:"job_id"`
+	JobDefinitionID     int64       `json:"job_definition_id,omitempty"`
+	EnvironmentID       int64       `json:"environment_id,omitempty"`
+	ProjectID           int64       `json:"project_id,omitempty"`
+	TriggerID           int64       `json:"trigger_id,omitempty"`
+	GitSHA              string      `json:"git_sha,omitempty"`
+	GitBranch           string      `json:"git_branch,omitempty"`
+	GitHubPullRequestID string      `json:"github_pull_request_id,omitempty"`
+	SchemaOverride      string      `json:"schema_override,omitempty"`
+	Cause               string      `json:"cause,omitempty"`
+	DbtVersion          string      `json:"dbt_version,omitempty"`
+	Status              int         `json:"status,omitempty"`
+	StatusHumanized     string      `json:"status_humanized,omitempty"`
+	StatusMessage       *string     `json:"status_message,omitempty"`
+	InProgress          bool        `json:"in_progress,omitempty"`
+	IsComplete          bool        `json:"is_complete,omitempty"`
+	IsSuccess           bool        `json:"is_success,omitempty"`
+	IsError             bool        `json:"is_error,omitempty"`
+	IsCancelled         bool        `json:"is_cancelled,omitempty"`
+	CreatedAt           *string     `json:"created_at,omitempty"`
+	UpdatedAt           *string     `json:"updated_at,omitempty"`
+	DequeuedAt          *string     `json:"dequeued_at,omitempty"`
+	StartedAt           *string     `json:"started_at,omitempty"`
+	FinishedAt          *string     `json:"finished_at,omitempty"`
+	Duration            *string     `json:"duration,omitempty"`
+	QueuedDuration      *string     `json:"queued_duration,omitempty"`
+	RunDuration         *string     `json:"run_duration,omitempty"`
+	DurationHumanized   string      `json:"duration_humanized,omitempty"`
+	ArtifactsSaved      bool        `json:"artifacts_saved,omitempty"`
+	ArtifactS3Path      *string     `json:"artifact_s3_path,omitempty"`
+	HasDocsGenerated    bool        `json:"has_docs_generated,omitempty"`
+	HasSourcesGenerated bool        `json:"has_sources_generated,omitempty"`
+	Href                string      `json:"href,omitempty"`
+	Trigger             *RunTrigger `json:"trigger,omitempty"`
+	RunSteps            []RunStep   `json:"run_steps,omitempty"`
+}
+
+// RunTrigger describes what started a run, it is only returned when the
+// trigger is requested in include_related.
+type RunTrigger struct {
+	ID                  int64   `json:"id"`
+	Cause               string  `json:"cause"`
+	CauseCategory       *string `json:"cause_category"`
+	JobDefinitionID     int64   `json:"job_definition_id"`
+	GitBranch           *string `json:"git_branch"`
+	GitSHA              *string `json:"git_sha"`
+	GitHubPullRequestID *int64  `json:"github_pull_request_id"`
+	SchemaOverride      *string `json:"schema_override"`
+	CreatedAt           *string `json:"created_at"`
+}
+
+// RunStep is a single command executed by a run, run steps are only returned
+// when they are requested in include_related.
+type RunStep struct {
+	ID                int64   `json:"id"`
+	RunID             int64   `json:"run_id"`
+	Index             int64   `json:"index"`
+	Name              string  `json:"name"`
+	Status            int     `json:"status"`
+	StatusHumanized   string  `json:"status_humanized"`
+	CreatedAt         *string `json:"created_at"`
+	FinishedAt        *string `json:"finished_at"`
+	Duration          *string `json:"duration"`
+	DurationHumanized string  `json:"duration_humanized"`
+}
+
+// IsTerminal returns true when the run has finished, whether it succeeded,
+// failed or got cancelled.
+func (r *Run) IsTerminal() bool {
+	return r.Status == RunStatusSuccess || r.Status == RunStatusError || r.Status == RunStatusCancelled
 }
 
 type RunResponse struct {
@@ -39,10 +115,15 @@ type RunFilter struct {
 	PullRequestID   int    `json:"pull_request_id"`
 	Status          int    `json:"status"`
 	StatusIn        string `json:"status_in"`
+	OrderBy         string `json:"order_by"`
+	// IncludeTrigger and IncludeRunSteps add the related objects to the runs
+	IncludeTrigger  bool `json:"-"`
+	IncludeRunSteps bool `json:"-"`
 }
 
-func (c *Client) GetRun(runID int64) (*Run, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetRun(ctx context.Context, runID int64) (*Run, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v2/accounts/%s/runs/%s/",
@@ -70,8 +151,25 @@ func (c *Client) GetRun(runID int64) (*Run, error) {
 	return &runResponse.Data, nil
 }
 
-func (c *Client) GetRuns(filter *RunFilter) (*[]Run, error) {
-	req, err := http.NewRequest(
+// includeRelated returns the value of the include_related query parameter,
+// e.g. ["trigger","run_steps"]
+func (f *RunFilter) includeRelated() string {
+	related := []string{}
+	if f.IncludeTrigger {
+		related = append(related, `"trigger"`)
+	}
+	if f.IncludeRunSteps {
+		related = append(related, `"run_steps"`)
+	}
+	if len(related) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(related, ",") + "]"
+}
+
+func (c *Client) GetRuns(ctx context.Context, filter *RunFilter) (*[]Run, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v2/accounts/%s/runs/",
@@ -109,7 +207,13 @@ func (c *Client) GetRuns(filter *RunFilter) (*[]Run, error) {
 			query.Add("pull_request_id", strconv.Itoa(filter.PullRequestID))
 		}
 		if filter.StatusIn != "" {
-			query.Add("status_in", filter.StatusIn)
+			query.Add("status__in", filter.StatusIn)
+		}
+		if filter.OrderBy != "" {
+			query.Add("order_by", filter.OrderBy)
+		}
+		if includeRelated := filter.includeRelated(); includeRelated != "" {
+			query.Add("include_related", includeRelated)
 		}
 	}
 	req.URL.RawQuery = query.Encode()
@@ -130,11 +234,17 @@ func (c *Client) GetRuns(filter *RunFilter) (*[]Run, error) {
 }
 
 func (c *Client) TriggerRun(
+	ctx context.Context,
 	jobID int,
 	gitSHA string,
 	gitBranch string,
 	githubPullRequestID string,
-	schemaOverride string) (*Run, error) {
+	schemaOverride string,
+	cause string) (*Run, error) {
+
+	if cause == "" {
+		cause = "API"
+	}
 
 	newRun := Run{
 		AccountID:           int64(c.AccountID),
@@ -143,7 +253,7 @@ func (c *Client) TriggerRun(
 		GitBranch:           gitBranch,
 		GitHubPullRequestID: githubPullRequestID,
 		SchemaOverride:      schemaOverride,
-		Cause:               "API",
+		Cause:               cause,
 	}
 
 	newRunData, err := json.Marshal(newRun)
@@ -151,13 +261,14 @@ func (c *Client) TriggerRun(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v2/accounts/%s/jobs/%s/run/",
 			c.HostURL,
-			strconv.Itoa(int(jobID)),
 			strconv.FormatInt(c.AccountID, 10),
+			strconv.Itoa(jobID),
 		),
 		strings.NewReader(string(newRunData)),
 	)
@@ -179,9 +290,138 @@ func (c *Client) TriggerRun(
 	return &runResponse.Data, nil
 }
 
-func (c *Client) CancelRun(runID int64) (*Run, error) {
+// GetRunArtifact downloads an artifact of a run, e.g. manifest.json. When step
+// is set, the artifact generated by this step of the run is returned.
+func (c *Client) GetRunArtifact(ctx context.Context, runID int64, path string, step int) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf(
+			"%s/v2/accounts/%s/runs/%s/artifacts/%s",
+			c.HostURL,
+			strconv.FormatInt(c.AccountID, 10),
+			strconv.FormatInt(runID, 10),
+			path,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if step > 0 {
+		query := req.URL.Query()
+		query.Add("step", strconv.Itoa(step))
+		req.URL.RawQuery = query.Encode()
+	}
+
+	return c.doRequestWithRetry(req)
+}
+
+// GetJobLatestArtifact downloads an artifact of the latest successful run of a
+// job, e.g. manifest.json.
+func (c *Client) GetJobLatestArtifact(ctx context.Context, jobID int64, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf(
+			"%s/v2/accounts/%s/jobs/%s/artifacts/%s",
+			c.HostURL,
+			strconv.FormatInt(c.AccountID, 10),
+			strconv.FormatInt(jobID, 10),
+			path,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRequestWithRetry(req)
+}
+
+// GetEnvironmentLatestArtifact downloads an artifact of the latest successful
+// run of an environment that saved it, whatever the job of the run, and
+// returns the ID of that run alongside the content.
+func (c *Client) GetEnvironmentLatestArtifact(
+	ctx context.Context,
+	environmentID int,
+	path string,
+) (int64, []byte, error) {
+	runs, err := c.GetRuns(ctx, &RunFilter{
+		EnvironmentID: environmentID,
+		Status:        RunStatusSuccess,
+		OrderBy:       "-finished_at",
+		Limit:         10,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, run := range *runs {
+		if !run.ArtifactsSaved {
+			continue
+		}
+		content, err := c.GetRunArtifact(ctx, run.ID, path, 0)
+		if IsNotFound(err) {
+			// runs like `dbt debug` don't generate all the artifacts
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		return run.ID, content, nil
+	}
+
+	return 0, nil, newNotFoundError(
+		"none of the latest successful runs of the environment %d saved the artifact %s",
+		environmentID,
+		path,
+	)
+}
+
+// WaitForRun polls the run every pollInterval until it reaches a terminal
+// status, returning the last version of the run. It gives up when ctx is
+// done, e.g. when its deadline is exceeded.
+func (c *Client) WaitForRun(ctx context.Context, runID int64, pollInterval time.Duration) (*Run, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var lastRun *Run
+	stopped := func() (*Run, error) {
+		status := ""
+		if lastRun != nil {
+			status = lastRun.StatusHumanized
+		}
+		return lastRun, fmt.Errorf("stopped waiting for run %d with status %q: %w", runID, status, ctx.Err())
+	}
+
+	for {
+		run, err := c.GetRun(ctx, runID)
+		if err != nil {
+			// the poll itself is interrupted when ctx is done
+			if ctx.Err() != nil {
+				return stopped()
+			}
+			return nil, err
+		}
+		lastRun = run
+		if run.IsTerminal() {
+			return run, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return stopped()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) CancelRun(ctx context.Context, runID int64) (*Run, error) {
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v2/accounts/%s/runs/%s/cancel",
@@ -209,9 +449,10 @@ func (c *Client) CancelRun(runID int64) (*Run, error) {
 	return &runResponse.Data, nil
 }
 
-func (c *Client) RetryRun(runID int64) (*Run, error) {
+func (c *Client) RetryRun(ctx context.Context, runID int64) (*Run, error) {
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v2/accounts/%s/runs/%s/retry",
@@ -0,0 +1,209 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func writeRun(t *testing.T, w http.ResponseWriter, run Run) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(RunResponse{Data: run}); err != nil {
+		t.Errorf("encoding run: %v", err)
+	}
+}
+
+func TestTriggerRun(t *testing.T) {
+	ctx := context.Background()
+	var received Run
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/accounts/123/jobs/456/run/" {
+			t.Errorf("unexpected call %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decoding body: %v", err)
+		}
+		writeRun(t, w, Run{ID: 789, JobID: 456, Status: RunStatusQueued})
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 0, nil)
+	run, err := c.TriggerRun(ctx, 456, "abc123", "", "", "dbt_seed", "Environment bootstrap")
+	if err != nil {
+		t.Fatalf("TriggerRun: %v", err)
+	}
+	if run.ID != 789 {
+		t.Fatalf("expected run 789, got %d", run.ID)
+	}
+	if received.Cause != "Environment bootstrap" || received.GitSHA != "abc123" || received.SchemaOverride != "dbt_seed" {
+		t.Fatalf("unexpected payload: %+v", received)
+	}
+	if received.GitBranch != "" {
+		t.Fatalf("expected no git branch, got %q", received.GitBranch)
+	}
+}
+
+func TestWaitForRun(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := RunStatusRunning
+		if polls.Add(1) >= 3 {
+			status = RunStatusSuccess
+		}
+		writeRun(t, w, Run{ID: 789, Status: status})
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 0, nil)
+	run, err := c.WaitForRun(context.Background(), 789, time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForRun: %v", err)
+	}
+	if run.Status != RunStatusSuccess {
+		t.Fatalf("expected a successful run, got status %d", run.Status)
+	}
+	if got := polls.Load(); got != 3 {
+		t.Fatalf("expected 3 polls, got %d", got)
+	}
+}
+
+func TestWaitForRun_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeRun(t, w, Run{ID: 789, Status: RunStatusQueued, StatusHumanized: "Queued"})
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 0, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	run, err := c.WaitForRun(ctx, 789, time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error, got %v", err)
+	}
+	if run == nil || run.Status != RunStatusQueued {
+		t.Fatalf("expected the last polled run to be returned, got %+v", run)
+	}
+}
+
+func TestGetRuns_QueryParameters(t *testing.T) {
+	ctx := context.Background()
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": 1, "status": 10, "run_steps": [{"id": 2, "name": "dbt build"}]}]}`))
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 0, nil)
+	runs, err := c.GetRuns(ctx, &RunFilter{
+		JobDefinitionID: 7,
+		Limit:           1,
+		StatusIn:        "[10,20]",
+		OrderBy:         "-finished_at",
+		IncludeTrigger:  true,
+		IncludeRunSteps: true,
+	})
+	if err != nil {
+		t.Fatalf("GetRuns: %v", err)
+	}
+
+	expected := map[string]string{
+		"job_definition_id": "7",
+		"limit":             "1",
+		"status__in":        "[10,20]",
+		"order_by":          "-finished_at",
+		"include_related":   `["trigger","run_steps"]`,
+	}
+	for key, value := range expected {
+		if got := query.Get(key); got != value {
+			t.Errorf("expected %s=%s, got %q", key, value, got)
+		}
+	}
+	if len(*runs) != 1 || len((*runs)[0].RunSteps) != 1 {
+		t.Fatalf("expected one run with one step, got %+v", *runs)
+	}
+}
+
+func TestGetRunArtifact(t *testing.T) {
+	ctx := context.Background()
+	var requested *url.URL
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"metadata": {"dbt_version": "1.9.0"}}`))
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 0, nil)
+
+	content, err := c.GetRunArtifact(ctx, 789, "manifest.json", 2)
+	if err != nil {
+		t.Fatalf("GetRunArtifact: %v", err)
+	}
+	if requested.Path != "/v2/accounts/123/runs/789/artifacts/manifest.json" || requested.Query().Get("step") != "2" {
+		t.Fatalf("unexpected request %s", requested)
+	}
+	if string(content) != `{"metadata": {"dbt_version": "1.9.0"}}` {
+		t.Fatalf("unexpected content %s", content)
+	}
+
+	if _, err := c.GetJobLatestArtifact(ctx, 456, "run_results.json"); err != nil {
+		t.Fatalf("GetJobLatestArtifact: %v", err)
+	}
+	if requested.Path != "/v2/accounts/123/jobs/456/artifacts/run_results.json" || requested.RawQuery != "" {
+		t.Fatalf("unexpected request %s", requested)
+	}
+}
+
+func TestGetEnvironmentLatestArtifact(t *testing.T) {
+	ctx := context.Background()
+	var runsQuery url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/accounts/123/runs/":
+			runsQuery = r.URL.Query()
+			_ = json.NewEncoder(w).Encode(RunsResponse{Data: []Run{
+				{ID: 3, ArtifactsSaved: false},
+				{ID: 2, ArtifactsSaved: true},
+				{ID: 1, ArtifactsSaved: true},
+			}})
+		case "/v2/accounts/123/runs/1/artifacts/manifest.json":
+			_, _ = w.Write([]byte(`{"nodes": {}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status": {"code": 404, "is_success": false}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := newRetryTestClient(t, srv.URL, 0, nil)
+
+	runID, content, err := c.GetEnvironmentLatestArtifact(ctx, 456, "manifest.json")
+	if err != nil {
+		t.Fatalf("GetEnvironmentLatestArtifact: %v", err)
+	}
+	if runID != 1 || string(content) != `{"nodes": {}}` {
+		t.Fatalf("unexpected artifact of run %d: %s", runID, content)
+	}
+	if runsQuery.Get("environment_id") != "456" ||
+		runsQuery.Get("status") != "10" ||
+		runsQuery.Get("order_by") != "-finished_at" {
+		t.Fatalf("unexpected runs query %s", runsQuery.Encode())
+	}
+
+	if _, _, err := c.GetEnvironmentLatestArtifact(ctx, 456, "catalog.json"); !IsNotFound(err) {
+		t.Fatalf("expected a not found error when no run saved the artifact, got %v", err)
+	}
+}
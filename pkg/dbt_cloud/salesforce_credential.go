@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -55,10 +56,12 @@ type SalesforceCredentialGlobConnPatch struct {
 }
 
 func (c *Client) GetSalesforceCredential(
+	ctx context.Context,
 	projectID int,
 	credentialID int,
 ) (*SalesforceCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -89,6 +92,7 @@ func (c *Client) GetSalesforceCredential(
 }
 
 func (c *Client) CreateSalesforceCredential(
+	ctx context.Context,
 	projectID int,
 	username string,
 	clientID string,
@@ -123,7 +127,8 @@ func (c *Client) CreateSalesforceCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -152,6 +157,7 @@ func (c *Client) CreateSalesforceCredential(
 }
 
 func (c *Client) UpdateSalesforceCredential(
+	ctx context.Context,
 	projectID int,
 	credentialID int,
 	salesforceCredential SalesforceCredentialGlobConnPatch,
@@ -161,7 +167,8 @@ func (c *Client) UpdateSalesforceCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
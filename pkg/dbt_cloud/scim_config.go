@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,8 +20,9 @@ type SCIMConfigResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetSCIMConfig() (*SCIMConfig, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetSCIMConfig(ctx context.Context) (*SCIMConfig, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/scim-config/",
@@ -46,13 +48,14 @@ func (c *Client) GetSCIMConfig() (*SCIMConfig, error) {
 	return &resp.Data, nil
 }
 
-func (c *Client) UpdateSCIMConfig(cfg SCIMConfig) (*SCIMConfig, error) {
+func (c *Client) UpdateSCIMConfig(ctx context.Context, cfg SCIMConfig) (*SCIMConfig, error) {
 	payload, err := json.Marshal(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/scim-config/",
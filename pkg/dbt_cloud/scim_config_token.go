@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,8 +27,9 @@ type SCIMConfigTokenListResponse struct {
 	Status ResponseStatus    `json:"status"`
 }
 
-func (c *Client) GetSCIMConfigToken(tokenID int64) (*SCIMConfigToken, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetSCIMConfigToken(ctx context.Context, tokenID int64) (*SCIMConfigToken, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/scim-config/tokens/%d/",
@@ -54,13 +56,14 @@ func (c *Client) GetSCIMConfigToken(tokenID int64) (*SCIMConfigToken, error) {
 	return &resp.Data, nil
 }
 
-func (c *Client) CreateSCIMConfigToken(name string) (*SCIMConfigToken, error) {
+func (c *Client) CreateSCIMConfigToken(ctx context.Context, name string) (*SCIMConfigToken, error) {
 	payload, err := json.Marshal(SCIMConfigToken{Name: name})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/scim-config/tokens/",
@@ -86,8 +89,9 @@ func (c *Client) CreateSCIMConfigToken(name string) (*SCIMConfigToken, error) {
 	return &resp.Data, nil
 }
 
-func (c *Client) DeleteSCIMConfigToken(tokenID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteSCIMConfigToken(ctx context.Context, tokenID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/scim-config/tokens/%d/",
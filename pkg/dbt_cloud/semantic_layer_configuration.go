@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,8 +24,9 @@ type SemanticLayerConfigurationResponse struct {
 	Status ResponseStatus             `json:"status"`
 }
 
-func (c *Client) GetSemanticLayerConfiguration(projectId int64, semanticLayerConfigId int64) (*SemanticLayerConfiguration, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetSemanticLayerConfiguration(ctx context.Context, projectId int64, semanticLayerConfigId int64) (*SemanticLayerConfiguration, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/semantic-layer-configurations/%s/",
@@ -54,6 +56,7 @@ func (c *Client) GetSemanticLayerConfiguration(projectId int64, semanticLayerCon
 }
 
 func (c *Client) CreateSemanticLayerConfiguration(
+	ctx context.Context,
 	projectId int64,
 	environmentId int64,
 ) (*SemanticLayerConfiguration, error) {
@@ -70,7 +73,8 @@ func (c *Client) CreateSemanticLayerConfiguration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/semantic-layer-configurations/",
@@ -99,6 +103,7 @@ func (c *Client) CreateSemanticLayerConfiguration(
 }
 
 func (c *Client) UpdateSemanticLayerConfiguration(
+	ctx context.Context,
 	projectId int64,
 	semanticLayerConfigId int64,
 	semanticLayerConfig SemanticLayerConfiguration) (*SemanticLayerConfiguration, error) {
@@ -108,7 +113,8 @@ func (c *Client) UpdateSemanticLayerConfiguration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/semantic-layer-configurations/%s/",
@@ -138,10 +144,12 @@ func (c *Client) UpdateSemanticLayerConfiguration(
 }
 
 func (c *Client) DeleteSemanticLayerConfiguration(
+	ctx context.Context,
 	projectId int64,
 	semanticLayerConfigurationID int64,
 ) error {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/projects/%s/semantic-layer-configurations/%s/",
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -33,8 +34,9 @@ type SemanticLayerCredentialResponse struct {
 	Data   SemanticLayerCredentials `json:"data"`
 }
 
-func (c *Client) GetSemanticLayerCredential(id int64) (*SemanticLayerCredentials, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetSemanticLayerCredential(ctx context.Context, id int64) (*SemanticLayerCredentials, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/semantic-layer-credentials/%d",
@@ -63,6 +65,7 @@ func (c *Client) GetSemanticLayerCredential(id int64) (*SemanticLayerCredentials
 }
 
 func (c *Client) CreateSemanticLayerCredential(
+	ctx context.Context,
 	projectId int64,
 	values map[string]interface{},
 	name string,
@@ -84,7 +87,8 @@ func (c *Client) CreateSemanticLayerCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/semantic-layer-credentials/",
@@ -112,6 +116,7 @@ func (c *Client) CreateSemanticLayerCredential(
 }
 
 func (c *Client) UpdateSemanticLayerCredential(
+	ctx context.Context,
 	credentialId int64,
 	credential SemanticLayerCredentials) (*SemanticLayerCredentials, error) {
 
@@ -120,7 +125,8 @@ func (c *Client) UpdateSemanticLayerCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/semantic-layer-credentials/%d/",
@@ -149,10 +155,12 @@ func (c *Client) UpdateSemanticLayerCredential(
 }
 
 func (c *Client) DeleteSemanticLayerCredential(
+	ctx context.Context,
 	projectId int64,
 	credentialId int64,
 ) error {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/semantic-layer-credentials/%d/",
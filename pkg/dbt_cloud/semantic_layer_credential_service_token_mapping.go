@@ -2,6 +2,7 @@ package dbt_cloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,6 +30,7 @@ type SemanticLayerCredentialServiceTokenMappingArrayResponse struct {
 }
 
 func (c *Client) CreateSemanticLayerCredentialServiceTokenMapping(
+	ctx context.Context,
 	projectId int,
 	semanticLayerCredentialId int,
 	serviceTokenId int,
@@ -46,7 +48,8 @@ func (c *Client) CreateSemanticLayerCredentialServiceTokenMapping(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/semantic-layer-credential-to-service-token-mapping/",
@@ -74,10 +77,12 @@ func (c *Client) CreateSemanticLayerCredentialServiceTokenMapping(
 }
 
 func (c *Client) GetSemanticLayerCredentialServiceTokenMapping(
+	ctx context.Context,
 	sm SemanticLayerCredentialServiceTokenMapping,
 ) (*SemanticLayerCredentialServiceTokenMapping, error) {
 	query := fmt.Sprintf("project_id=%d", sm.ProjectID)
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf("%s/v3/accounts/%d/semantic-layer-credential-to-service-token-mapping/?%s", c.HostURL, c.AccountID, query),
 		nil,
@@ -107,14 +112,15 @@ func (c *Client) GetSemanticLayerCredentialServiceTokenMapping(
 	}
 
 	if SemanticCredentialTokenMapping.SemanticLayerCredentialID == 0 {
-		return nil, fmt.Errorf("resource-not-found: semantic layer credential service token mapping not found for ID %d", *sm.ID)
+		return nil, newNotFoundError("resource-not-found: semantic layer credential service token mapping not found for ID %d", *sm.ID)
 	}
 
 	return &SemanticCredentialTokenMapping, nil
 }
 
-func (c *Client) DeleteSemanticLayerCredentialServiceTokenMapping(id int) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteSemanticLayerCredentialServiceTokenMapping(ctx context.Context, id int) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf("%s/v3/accounts/%d/semantic-layer-credential-to-service-token-mapping/%d/", c.HostURL, c.AccountID, id),
 		nil,
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -44,9 +45,9 @@ type ServiceTokenPermissionListResponse struct {
 	Status ResponseStatus           `json:"status"`
 }
 
-func (c *Client) GetServiceTokenPermissions(serviceTokenID int) (*[]ServiceTokenPermission, error) {
+func (c *Client) GetServiceTokenPermissions(ctx context.Context, serviceTokenID int) (*[]ServiceTokenPermission, error) {
 
-	allServiceTokenPermissionsRaw, err := c.GetRawData(fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%s/permissions/", c.HostURL, strconv.FormatInt(c.AccountID, 10), strconv.Itoa(serviceTokenID)))
+	allServiceTokenPermissionsRaw, err := c.GetRawData(ctx, fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%s/permissions/", c.HostURL, strconv.FormatInt(c.AccountID, 10), strconv.Itoa(serviceTokenID)))
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +64,8 @@ func (c *Client) GetServiceTokenPermissions(serviceTokenID int) (*[]ServiceToken
 	return &allPermissions, nil
 }
 
-func (c *Client) GetServiceToken(serviceTokenID int) (*ServiceToken, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%s/", c.HostURL, strconv.FormatInt(c.AccountID, 10), strconv.Itoa(serviceTokenID)), nil)
+func (c *Client) GetServiceToken(ctx context.Context, serviceTokenID int) (*ServiceToken, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%s/", c.HostURL, strconv.FormatInt(c.AccountID, 10), strconv.Itoa(serviceTokenID)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,10 +83,10 @@ func (c *Client) GetServiceToken(serviceTokenID int) (*ServiceToken, error) {
 
 	// the endpoint returns service tokens when their state is inactive, so we need to check for the state
 	if serviceTokenResponse.Data.State != STATE_ACTIVE {
-		return nil, fmt.Errorf("resource-not-found: service token %d is not active", serviceTokenID)
+		return nil, newNotFoundError("resource-not-found: service token %d is not active", serviceTokenID)
 	}
 
-	permissions, err := c.GetServiceTokenPermissions(serviceTokenID)
+	permissions, err := c.GetServiceTokenPermissions(ctx, serviceTokenID)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +96,7 @@ func (c *Client) GetServiceToken(serviceTokenID int) (*ServiceToken, error) {
 }
 
 func (c *Client) CreateServiceToken(
+	ctx context.Context,
 	name string,
 	state int,
 ) (*ServiceToken, error) {
@@ -108,7 +110,7 @@ func (c *Client) CreateServiceToken(
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/", c.HostURL, c.AccountID), strings.NewReader(string(newServiceTokenData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/", c.HostURL, c.AccountID), strings.NewReader(string(newServiceTokenData)))
 	if err != nil {
 		return nil, err
 	}
@@ -127,13 +129,13 @@ func (c *Client) CreateServiceToken(
 	return &serviceTokenResponse.Data, nil
 }
 
-func (c *Client) UpdateServiceToken(serviceTokenID int, serviceToken ServiceToken) (*ServiceToken, error) {
+func (c *Client) UpdateServiceToken(ctx context.Context, serviceTokenID int, serviceToken ServiceToken) (*ServiceToken, error) {
 	serviceTokenData, err := json.Marshal(serviceToken)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), serviceTokenID), strings.NewReader(string(serviceTokenData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), serviceTokenID), strings.NewReader(string(serviceTokenData)))
 	if err != nil {
 		return nil, err
 	}
@@ -152,14 +154,14 @@ func (c *Client) UpdateServiceToken(serviceTokenID int, serviceToken ServiceToke
 	return &serviceTokenResponse.Data, nil
 }
 
-func (c *Client) UpdateServiceTokenPermissions(serviceTokenID int, serviceTokenPermissions []ServiceTokenPermission) (*[]ServiceTokenPermission, error) {
+func (c *Client) UpdateServiceTokenPermissions(ctx context.Context, serviceTokenID int, serviceTokenPermissions []ServiceTokenPermission) (*[]ServiceTokenPermission, error) {
 	serviceTokenPermissionData, err := json.Marshal(serviceTokenPermissions)
 
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%d/permissions/", c.HostURL, strconv.FormatInt(c.AccountID, 10), serviceTokenID), strings.NewReader(string(serviceTokenPermissionData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%d/permissions/", c.HostURL, strconv.FormatInt(c.AccountID, 10), serviceTokenID), strings.NewReader(string(serviceTokenPermissionData)))
 	if err != nil {
 		return nil, err
 	}
@@ -169,11 +171,11 @@ func (c *Client) UpdateServiceTokenPermissions(serviceTokenID int, serviceTokenP
 		return nil, err
 	}
 
-	return c.GetServiceTokenPermissions(serviceTokenID)
+	return c.GetServiceTokenPermissions(ctx, serviceTokenID)
 }
 
-func (c *Client) DeleteServiceToken(serviceTokenID int) (string, error) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/%d/", c.HostURL, c.AccountID, serviceTokenID), nil)
+func (c *Client) DeleteServiceToken(ctx context.Context, serviceTokenID int) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/%d/", c.HostURL, c.AccountID, serviceTokenID), nil)
 	if err != nil {
 		return "", err
 	}
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -31,10 +32,12 @@ type SnowflakeCredential struct {
 }
 
 func (c *Client) GetSnowflakeCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*SnowflakeCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -64,6 +67,7 @@ func (c *Client) GetSnowflakeCredential(
 }
 
 func (c *Client) CreateSnowflakeCredential(
+	ctx context.Context,
 	projectId int,
 	type_ string,
 	isActive bool,
@@ -103,7 +107,8 @@ func (c *Client) CreateSnowflakeCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -139,6 +144,7 @@ func (c *Client) CreateSnowflakeCredential(
 }
 
 func (c *Client) UpdateSnowflakeCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	snowflakeCredential SnowflakeCredential,
@@ -148,7 +154,8 @@ func (c *Client) UpdateSnowflakeCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
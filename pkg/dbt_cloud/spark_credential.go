@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -54,10 +55,12 @@ type SparkCredentialGlobConnPatch struct {
 }
 
 func (c *Client) GetSparkCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*SparkCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -88,6 +91,7 @@ func (c *Client) GetSparkCredential(
 }
 
 func (c *Client) CreateSparkCredential(
+	ctx context.Context,
 	projectId int,
 	token string,
 	schema string,
@@ -118,7 +122,8 @@ func (c *Client) CreateSparkCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -147,6 +152,7 @@ func (c *Client) CreateSparkCredential(
 }
 
 func (c *Client) UpdateSparkCredentialGlobConn(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	sparkCredential SparkCredentialGlobConnPatch,
@@ -156,7 +162,8 @@ func (c *Client) UpdateSparkCredentialGlobConn(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
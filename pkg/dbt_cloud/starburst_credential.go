@@ -50,10 +50,12 @@ type StarburstCredentialRequest struct {
 }
 
 func (c *Client) GetStarburstCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*StarburstCredentialData, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -118,7 +120,8 @@ func (c *Client) CreateStarburstCredential(
 	}
 	tflog.Debug(ctx, fmt.Sprintf("CreateStarburstCredential: %s", string(rb)))
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -149,6 +152,7 @@ func (c *Client) CreateStarburstCredential(
 }
 
 func (c *Client) UpdateStarburstCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	starburstCredential StarburstCredentialRequest,
@@ -158,7 +162,8 @@ func (c *Client) UpdateStarburstCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
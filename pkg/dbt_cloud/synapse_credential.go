@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -39,10 +40,12 @@ type SynapseCredential struct {
 }
 
 func (c *Client) GetSynapseCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*SynapseCredential, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -72,6 +75,7 @@ func (c *Client) GetSynapseCredential(
 }
 
 func (c *Client) CreateSynapseCredential(
+	ctx context.Context,
 	projectId int,
 	authentication string,
 	user string,
@@ -112,7 +116,8 @@ func (c *Client) CreateSynapseCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -362,6 +367,7 @@ func GenerateSynapseCredentialDetails(
 }
 
 func (c *Client) UpdateSynapseCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	synapseCredential SynapseCredential,
@@ -371,7 +377,8 @@ func (c *Client) UpdateSynapseCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
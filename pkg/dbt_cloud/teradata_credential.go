@@ -46,10 +46,12 @@ type TeradataCredentialRequest struct {
 }
 
 func (c *Client) GetTeradataCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*TeradataCredentialData, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
@@ -114,7 +116,8 @@ func (c *Client) CreateTeradataCredential(
 	}
 	tflog.Debug(ctx, fmt.Sprintf("CreateTeradataCredential: %s", string(rb)))
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/",
@@ -145,6 +148,7 @@ func (c *Client) CreateTeradataCredential(
 }
 
 func (c *Client) UpdateTeradataCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 	teradataCredential TeradataCredentialRequest,
@@ -154,7 +158,8 @@ func (c *Client) UpdateTeradataCredential(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
@@ -1,22 +1,121 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type User struct {
-	ID    int    `json:"id"`
-	Email string `json:"email"`
+	ID           int     `json:"id"`
+	Email        string  `json:"email"`
+	FirstName    string  `json:"first_name"`
+	LastName     string  `json:"last_name"`
+	IsActive     bool    `json:"is_active"`
+	IsSSOManaged bool    `json:"is_sso_managed"`
+	LastLogin    *string `json:"last_login"`
 	// only the first permission is filled id, it is a list with  1 element
-	Permissions []struct {
-		Groups []struct {
-			ID int `json:"id"`
-		} `json:"groups"`
-	} `json:"permissions"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// UserAccess is the access a user gets from the permissions of their groups
+type UserAccess struct {
+	// PermissionSets are the permission sets of the groups, sorted
+	PermissionSets []string
+	// AllProjects is true when one of the permissions applies to all projects
+	AllProjects bool
+	// ProjectIDs are the projects with a permission, sorted
+	ProjectIDs []int
+}
+
+// AccountPermission returns the membership of the user in the account, or nil
+// when they are not an active member of it. The permissions returned without account
+// are considered to be the ones of the account listed.
+func (u User) AccountPermission(accountID int64) *Permission {
+	for i, permission := range u.Permissions {
+		if permission.State == STATE_DELETED {
+			continue
+		}
+		if permission.AccountID == accountID || permission.AccountID == 0 {
+			return &u.Permissions[i]
+		}
+	}
+	return nil
+}
+
+// LastLoginTime parses LastLogin, it returns false when the user never logged in
+func (u User) LastLoginTime() (time.Time, bool) {
+	if u.LastLogin == nil || *u.LastLogin == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999-07:00"} {
+		if lastLogin, err := time.Parse(layout, *u.LastLogin); err == nil {
+			return lastLogin, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// groupPermissions returns the permissions granted by the groups of the
+// account permission, with the ID of the group granting each of them. groups
+// are all the groups of the account with their permissions, the deleted
+// permissions are skipped.
+func (p Permission) groupPermissions(groups []Group) iter.Seq2[int, GroupPermission] {
+	groupsByID := map[int]Group{}
+	for _, group := range groups {
+		if group.ID != nil {
+			groupsByID[*group.ID] = group
+		}
+	}
+	return func(yield func(int, GroupPermission) bool) {
+		for _, userGroup := range p.Groups {
+			if userGroup.ID == nil {
+				continue
+			}
+			for _, groupPermission := range groupsByID[*userGroup.ID].Permissions {
+				if groupPermission.State == STATE_DELETED {
+					continue
+				}
+				if !yield(*userGroup.ID, groupPermission) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// EffectiveAccess returns the access the user gets in the account from the
+// permissions of their groups, groups being all the groups of the account
+// with their permissions
+func (u User) EffectiveAccess(accountID int64, groups []Group) UserAccess {
+	access := UserAccess{
+		PermissionSets: []string{},
+		ProjectIDs:     []int{},
+	}
+	permission := u.AccountPermission(accountID)
+	if permission == nil {
+		return access
+	}
+
+	for _, groupPermission := range permission.groupPermissions(groups) {
+		if groupPermission.Set != "" && !slices.Contains(access.PermissionSets, groupPermission.Set) {
+			access.PermissionSets = append(access.PermissionSets, groupPermission.Set)
+		}
+		if groupPermission.AllProjects {
+			access.AllProjects = true
+		} else if groupPermission.ProjectID != 0 && !slices.Contains(access.ProjectIDs, groupPermission.ProjectID) {
+			access.ProjectIDs = append(access.ProjectIDs, groupPermission.ProjectID)
+		}
+	}
+	slices.Sort(access.PermissionSets)
+	slices.Sort(access.ProjectIDs)
+	return access
 }
 
 type UserListResponse struct {
@@ -34,8 +133,9 @@ type CurrentUserResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetUsers() ([]User, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf("%s/v3/accounts/%s/users/", c.HostURL, strconv.FormatInt(c.AccountID, 10)),
 		nil,
@@ -62,7 +162,8 @@ func (c *Client) GetUsers() ([]User, error) {
 		numUsers := userListResponse.Extra.Pagination.Count
 		for numUsers < userListResponse.Extra.Pagination.TotalCount {
 
-			req, err := http.NewRequest(
+			req, err := http.NewRequestWithContext(
+				ctx,
 				"GET",
 				fmt.Sprintf(
 					"%s/v3/accounts/%s/users/?offset=%d",
@@ -103,9 +204,9 @@ func (c *Client) GetUsers() ([]User, error) {
 	return listAllUsers, nil
 }
 
-func (c *Client) GetUser(email string) (*User, error) {
+func (c *Client) GetUser(ctx context.Context, email string) (*User, error) {
 
-	listAllUsers, err := c.GetUsers()
+	listAllUsers, err := c.GetUsers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +220,8 @@ func (c *Client) GetUser(email string) (*User, error) {
 	return nil, fmt.Errorf("did not find user with email %s", email)
 }
 
-func (c *Client) GetConnectedUser() (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/whoami/", c.HostURL), nil)
+func (c *Client) GetConnectedUser(ctx context.Context) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v2/whoami/", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
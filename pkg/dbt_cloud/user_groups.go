@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -18,8 +19,12 @@ type UserGroups struct {
 }
 
 type Permission struct {
-	AccountID int64   `json:"account_id"`
-	Groups    []Group `json:"groups"`
+	ID          int     `json:"id"`
+	AccountID   int64   `json:"account_id"`
+	UserID      int     `json:"user_id"`
+	LicenseType string  `json:"license_type"`
+	State       int     `json:"state"`
+	Groups      []Group `json:"groups"`
 }
 
 type UserGroupsCurrentAccount struct {
@@ -38,8 +43,8 @@ type AssignUserGroupsResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetUserGroups(userId int) (*UserGroupsCurrentAccount, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%s/users/%s/", c.HostURL, strconv.FormatInt(c.AccountID, 10), strconv.Itoa(userId)), nil)
+func (c *Client) GetUserGroups(ctx context.Context, userId int) (*UserGroupsCurrentAccount, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v2/accounts/%s/users/%s/", c.HostURL, strconv.FormatInt(c.AccountID, 10), strconv.Itoa(userId)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +72,7 @@ func (c *Client) GetUserGroups(userId int) (*UserGroupsCurrentAccount, error) {
 	return &userGroupsCurrentAccount, nil
 }
 
-func (c *Client) AssignUserGroups(userId int, groupIDs []int) (*AssignUserGroupsResponse, error) {
+func (c *Client) AssignUserGroups(ctx context.Context, userId int, groupIDs []int) (*AssignUserGroupsResponse, error) {
 
 	userGroupsBody := UserGroupsBody{
 		UserID:   userId,
@@ -79,7 +84,7 @@ func (c *Client) AssignUserGroups(userId int, groupIDs []int) (*AssignUserGroups
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/assign-groups/", c.HostURL, strconv.FormatInt(c.AccountID, 10)), strings.NewReader(string(userGroupsData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%s/assign-groups/", c.HostURL, strconv.FormatInt(c.AccountID, 10)), strings.NewReader(string(userGroupsData)))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,192 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// INVITE_STATUS_PENDING is the status of an invite not accepted yet
+	INVITE_STATUS_PENDING = "pending"
+	// INVITE_STATUS_ACCEPTED is the status of an invite accepted by the user,
+	// its UserID is then set
+	INVITE_STATUS_ACCEPTED = "accepted"
+)
+
+type UserInvite struct {
+	ID          *int   `json:"id,omitempty"`
+	AccountID   int64  `json:"account_id"`
+	Email       string `json:"email"`
+	LicenseType string `json:"license_type"`
+	GroupIDs    []int  `json:"group_ids"`
+	Status      string `json:"status,omitempty"`
+	UserID      *int   `json:"user_id,omitempty"`
+}
+
+type UserInviteResponse struct {
+	Data   UserInvite     `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+type UserPermissionResponse struct {
+	Data   Permission     `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+func (c *Client) CreateUserInvite(ctx context.Context, email string, licenseType string, groupIDs []int) (*UserInvite, error) {
+	newUserInvite := UserInvite{
+		AccountID:   c.AccountID,
+		Email:       email,
+		LicenseType: licenseType,
+		GroupIDs:    groupIDs,
+	}
+	newUserInviteData, err := json.Marshal(newUserInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%d/invites/", c.HostURL, c.AccountID), strings.NewReader(string(newUserInviteData)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doUserInviteRequest(req)
+}
+
+func (c *Client) GetUserInvite(ctx context.Context, inviteID int) (*UserInvite, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v3/accounts/%s/invites/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), inviteID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doUserInviteRequest(req)
+}
+
+// ResendUserInvite sends the invite email again, only pending invites can be
+// resent
+func (c *Client) ResendUserInvite(ctx context.Context, inviteID int) (*UserInvite, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v3/accounts/%s/invites/%d/resend/", c.HostURL, strconv.FormatInt(c.AccountID, 10), inviteID), strings.NewReader("{}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doUserInviteRequest(req)
+}
+
+// RevokeUserInvite deletes a pending invite, the link sent by email stops
+// working
+func (c *Client) RevokeUserInvite(ctx context.Context, inviteID int) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/v3/accounts/%s/invites/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), inviteID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequestWithRetry(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) doUserInviteRequest(req *http.Request) (*UserInvite, error) {
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	userInviteResponse := UserInviteResponse{}
+	err = json.Unmarshal(body, &userInviteResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userInviteResponse.Data, nil
+}
+
+// GetUserPermission returns the permission giving the user access to the
+// account, with its license type. A NotFoundError is returned when the user is
+// not an active member of the account.
+func (c *Client) GetUserPermission(ctx context.Context, userID int) (*Permission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v2/accounts/%s/users/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroupsResponse := UserGroupsResponse{}
+	err = json.Unmarshal(body, &userGroupsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API returns the permissions for all accounts
+	for i, permission := range userGroupsResponse.Data.Permissions {
+		if permission.AccountID == c.AccountID && permission.State != STATE_DELETED {
+			return &userGroupsResponse.Data.Permissions[i], nil
+		}
+	}
+
+	return nil, newNotFoundError("the user %d is not a member of the account %d", userID, c.AccountID)
+}
+
+// UpdateUserLicenseType changes the license type of a member of the account
+func (c *Client) UpdateUserLicenseType(ctx context.Context, userID int, licenseType string) error {
+	permission, err := c.GetUserPermission(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	permission.LicenseType = licenseType
+	return c.updateUserPermission(ctx, permission)
+}
+
+// DeactivateUser removes the license of the user in the account, so that they
+// can't log in to it anymore. Users who are already not members are ignored.
+func (c *Client) DeactivateUser(ctx context.Context, userID int) error {
+	permission, err := c.GetUserPermission(ctx, userID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	permission.State = STATE_DELETED
+	return c.updateUserPermission(ctx, permission)
+}
+
+func (c *Client) updateUserPermission(ctx context.Context, permission *Permission) error {
+	// the groups are managed with AssignUserGroups
+	permissionData, err := json.Marshal(map[string]any{
+		"id":           permission.ID,
+		"account_id":   permission.AccountID,
+		"user_id":      permission.UserID,
+		"license_type": permission.LicenseType,
+		"state":        permission.State,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/v2/accounts/%s/permissions/%d/", c.HostURL, strconv.FormatInt(c.AccountID, 10), permission.ID), strings.NewReader(string(permissionData)))
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequestWithRetry(req)
+	if err != nil {
+		return err
+	}
+
+	userPermissionResponse := UserPermissionResponse{}
+	return json.Unmarshal(body, &userPermissionResponse)
+}
@@ -0,0 +1,89 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserEffectiveAccess(t *testing.T) {
+	var user User
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": 1,
+		"email": "ada@example.com",
+		"permissions": [
+			{"account_id": 99, "license_type": "read_only", "state": 1, "groups": [{"id": 30}]},
+			{"account_id": 1, "license_type": "developer", "state": 1, "groups": [{"id": 10}, {"id": 20}]}
+		]
+	}`), &user))
+
+	groupID := func(id int) *int { return &id }
+	groups := []Group{
+		{ID: groupID(10), Permissions: []GroupPermission{
+			{Set: "developer", ProjectID: 200},
+			{Set: "analyst", ProjectID: 100},
+			{Set: "admin", ProjectID: 300, State: STATE_DELETED},
+		}},
+		{ID: groupID(20), Permissions: []GroupPermission{
+			{Set: "developer", ProjectID: 100},
+			{Set: "job_viewer", AllProjects: true},
+		}},
+		{ID: groupID(30), Permissions: []GroupPermission{
+			{Set: "account_admin", AllProjects: true},
+		}},
+	}
+
+	assert.Equal(t, "developer", user.AccountPermission(1).LicenseType)
+	assert.Equal(t, UserAccess{
+		PermissionSets: []string{"analyst", "developer", "job_viewer"},
+		AllProjects:    true,
+		ProjectIDs:     []int{100, 200},
+	}, user.EffectiveAccess(1, groups))
+
+	assert.Nil(t, user.AccountPermission(2))
+	assert.Equal(t, UserAccess{
+		PermissionSets: []string{},
+		ProjectIDs:     []int{},
+	}, user.EffectiveAccess(2, groups))
+
+	user.Permissions[1].State = STATE_DELETED
+	assert.Nil(t, user.AccountPermission(1), "deactivated users are not members")
+}
+
+func TestUserLastLoginTime(t *testing.T) {
+	tests := []struct {
+		name      string
+		lastLogin *string
+		expected  time.Time
+		ok        bool
+	}{
+		{name: "never logged in", lastLogin: nil},
+		{name: "empty", lastLogin: stringPtr("")},
+		{
+			name:      "RFC 3339",
+			lastLogin: stringPtr("2026-07-01T09:30:00.123456+00:00"),
+			expected:  time.Date(2026, 7, 1, 9, 30, 0, 123456000, time.UTC),
+			ok:        true,
+		},
+		{
+			name:      "space separated",
+			lastLogin: stringPtr("2026-07-01 11:30:00+02:00"),
+			expected:  time.Date(2026, 7, 1, 9, 30, 0, 0, time.UTC),
+			ok:        true,
+		},
+		{name: "invalid", lastLogin: stringPtr("yesterday")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lastLogin, ok := User{LastLogin: tt.lastLogin}.LastLoginTime()
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.True(t, tt.expected.Equal(lastLogin), "expected %s, got %s", tt.expected, lastLogin)
+			}
+		})
+	}
+}
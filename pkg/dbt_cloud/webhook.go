@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -56,8 +57,9 @@ type WebhookWrite struct {
 	Active      bool     `json:"active"`
 }
 
-func (c *Client) GetWebhook(webhookID string) (*WebhookRead, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*WebhookRead, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/webhooks/subscription/%s",
@@ -86,6 +88,7 @@ func (c *Client) GetWebhook(webhookID string) (*WebhookRead, error) {
 }
 
 func (c *Client) CreateWebhook(
+	ctx context.Context,
 	webhookId string,
 	name string,
 	description string,
@@ -110,7 +113,8 @@ func (c *Client) CreateWebhook(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/webhooks/subscriptions",
@@ -137,13 +141,14 @@ func (c *Client) CreateWebhook(
 	return &webhookResponse.Data, nil
 }
 
-func (c *Client) UpdateWebhook(webhookId string, webhook WebhookWrite) (*WebhookRead, error) {
+func (c *Client) UpdateWebhook(ctx context.Context, webhookId string, webhook WebhookWrite) (*WebhookRead, error) {
 	webhookData, err := json.Marshal(webhook)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/webhooks/subscription/%s",
@@ -171,8 +176,9 @@ func (c *Client) UpdateWebhook(webhookId string, webhook WebhookWrite) (*Webhook
 	return &webhookResponse.Data, nil
 }
 
-func (c *Client) DeleteWebhook(webhookId string) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteWebhook(ctx context.Context, webhookId string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"%s/v3/accounts/%s/webhooks/subscription/%s",
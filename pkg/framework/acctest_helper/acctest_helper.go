@@ -36,7 +36,10 @@ func SharedClient() (*dbt_cloud.Client, error) {
 		panic(fmt.Sprintf("failed to parse serverURL: %s, error: %v", hostURL, err))
 	}
 	client := dbt_cloud.Client{
-		HTTPClient:   &http.Client{Timeout: 30 * time.Second},
+		HTTPClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: dbt_cloud.NewCassetteTransport(http.DefaultTransport),
+		},
 		HostURL:      parsedURL,
 		Token:        token,
 		AccountID:    accountID,
@@ -53,12 +56,37 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 }
 
 func TestAccPreCheck(t *testing.T) {
+	if os.Getenv(dbt_cloud.CassetteModeEnvVar) == dbt_cloud.CassetteModeReplay {
+		// the requests are answered from the cassettes, the credentials only
+		// need to be set for the provider to be configured
+		setDefaultEnv("DBT_CLOUD_ACCOUNT_ID", "1")
+		setDefaultEnv("DBT_CLOUD_TOKEN", "replay-token")
+	}
 	if v := os.Getenv("DBT_CLOUD_ACCOUNT_ID"); v == "" {
 		t.Fatal("DBT_CLOUD_ACCOUNT_ID must be set for acceptance tests")
 	}
 	if v := os.Getenv("DBT_CLOUD_TOKEN"); v == "" {
 		t.Fatal("DBT_CLOUD_TOKEN must be set for acceptance tests")
 	}
+
+	// the cassette is recorded or replayed for the whole test, which needs to
+	// run alone in its package (-parallel 1)
+	if err := dbt_cloud.StartCassette(t.Name()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := dbt_cloud.StopCassette(); err != nil {
+			t.Errorf("the cassette of %s can't be saved: %v", t.Name(), err)
+		}
+	})
+}
+
+// setDefaultEnv sets the environment variable when it is empty, with os.Setenv
+// as t.Setenv can't be used by the parallel tests.
+func setDefaultEnv(key, value string) {
+	if os.Getenv(key) == "" {
+		os.Setenv(key, value)
+	}
 }
 
 func HelperTestResourceSchema[R resource.Resource](t *testing.T, r R) {
@@ -0,0 +1,122 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	defResp := function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, &defResp)
+
+	validateResp := function.DefinitionValidateResponse{}
+	defResp.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{}, &validateResp)
+	if validateResp.Diagnostics.HasError() {
+		t.Fatalf("invalid function definition: %v", validateResp.Diagnostics)
+	}
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(defResp.Definition.Return.GetType().ValueType(ctx)),
+	}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func int64List(values ...int64) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.Int64Value(v)
+	}
+	return types.ListValueMust(types.Int64Type, elements)
+}
+
+func TestSplitIDFunction(t *testing.T) {
+	result, err := runFunction(t, functions.SplitIDFunction(), types.StringValue("123:DBT_MY_VAR"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("123"),
+		types.StringValue("DBT_MY_VAR"),
+	}), result)
+
+	_, err = runFunction(t, functions.SplitIDFunction(), types.StringValue("123"))
+	assert.NotNil(t, err)
+}
+
+func TestSplitNumericIDFunction(t *testing.T) {
+	result, err := runFunction(t, functions.SplitNumericIDFunction(), types.StringValue("123:456"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64List(123, 456), result)
+
+	_, err = runFunction(t, functions.SplitNumericIDFunction(), types.StringValue("123:DBT_MY_VAR"))
+	assert.NotNil(t, err)
+}
+
+func TestJoinIDFunction(t *testing.T) {
+	result, err := runFunction(t, functions.JoinIDFunction(), types.StringValue("123"), types.StringValue("DBT_MY_VAR"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue("123:DBT_MY_VAR"), result)
+
+	_, err = runFunction(t, functions.JoinIDFunction(), types.StringValue("123:456"), types.StringValue("789"))
+	assert.NotNil(t, err)
+}
+
+func TestJobScheduleCronFunction(t *testing.T) {
+	result, err := runFunction(
+		t,
+		functions.JobScheduleCronFunction(),
+		types.StringValue("days_of_week"),
+		types.Int64Null(),
+		int64List(18, 6),
+		int64List(5, 1),
+		types.StringNull(),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue("0 6,18 * * 1,5"), result)
+
+	_, err = runFunction(
+		t,
+		functions.JobScheduleCronFunction(),
+		types.StringValue("custom_cron"),
+		types.Int64Null(),
+		types.ListNull(types.Int64Type),
+		types.ListNull(types.Int64Type),
+		types.StringNull(),
+	)
+	assert.NotNil(t, err)
+}
+
+func TestParseJobScheduleFunction(t *testing.T) {
+	result, err := runFunction(t, functions.ParseJobScheduleFunction(), types.StringValue("0 */2 * * 1,2,3"))
+	assert.Nil(t, err)
+
+	attributes := result.(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("days_of_week"), attributes["schedule_type"])
+	assert.Equal(t, types.Int64Value(2), attributes["schedule_interval"])
+	assert.Equal(t, int64List(1, 2, 3), attributes["schedule_days"])
+	assert.True(t, attributes["schedule_hours"].IsNull())
+	assert.True(t, attributes["schedule_cron"].IsNull())
+
+	result, err = runFunction(t, functions.ParseJobScheduleFunction(), types.StringValue("4 */3 * * 0,6"))
+	assert.Nil(t, err)
+
+	attributes = result.(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("interval_cron"), attributes["schedule_type"])
+	assert.Equal(t, types.Int64Value(3), attributes["schedule_interval"])
+	assert.Equal(t, int64List(0, 6), attributes["schedule_days"])
+
+	result, err = runFunction(t, functions.ParseJobScheduleFunction(), types.StringValue("15 3 * * *"))
+	assert.Nil(t, err)
+
+	attributes = result.(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("custom_cron"), attributes["schedule_type"])
+	assert.Equal(t, types.StringValue("15 3 * * *"), attributes["schedule_cron"])
+}
@@ -0,0 +1,116 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &jobScheduleCronFunction{}
+
+func JobScheduleCronFunction() function.Function {
+	return &jobScheduleCronFunction{}
+}
+
+type jobScheduleCronFunction struct{}
+
+// Metadata implements function.Function.
+func (f *jobScheduleCronFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "job_schedule_cron"
+}
+
+// Definition implements function.Function.
+func (f *jobScheduleCronFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render the schedule of a job as a cron expression",
+		MarkdownDescription: helper.DocString(
+			`Renders the ~~~schedule_*~~~ attributes of a ~~~dbtcloud_job~~~ as a normalized cron expression, the same way they are sent to dbt Cloud.
+			Lists of hours and days are sorted and deduplicated, an interval of 1 hour is rendered as ~~~*~~~ and the fields of custom cron expressions are separated by a single space, so that the result can be compared across jobs.
+			Arguments not used by the given ~~~schedule_type~~~ can be set to ~~~null~~~.`,
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "schedule_type",
+				Description: "Type of schedule, one of every_day/ days_of_week/ custom_cron/ interval_cron",
+			},
+			function.Int64Parameter{
+				Name:           "schedule_interval",
+				Description:    "Number of hours between job executions",
+				AllowNullValue: true,
+			},
+			function.ListParameter{
+				Name:           "schedule_hours",
+				Description:    "List of hours to execute the job at",
+				ElementType:    types.Int64Type,
+				AllowNullValue: true,
+			},
+			function.ListParameter{
+				Name:           "schedule_days",
+				Description:    "List of days of week as numbers (0 = Sunday, 7 is accepted as an alias of Sunday) to execute the job at",
+				ElementType:    types.Int64Type,
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "schedule_cron",
+				Description:    "Custom cron expression",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run implements function.Function.
+func (f *jobScheduleCronFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var scheduleType string
+	var scheduleInterval types.Int64
+	var scheduleHours, scheduleDays types.List
+	var scheduleCron types.String
+
+	resp.Error = function.ConcatFuncErrors(
+		resp.Error,
+		req.Arguments.Get(ctx, &scheduleType, &scheduleInterval, &scheduleHours, &scheduleDays, &scheduleCron),
+	)
+	if resp.Error != nil {
+		return
+	}
+
+	hours := int64ListToIntSlice(scheduleHours)
+	days := int64ListToIntSlice(scheduleDays)
+
+	if scheduleType == dbt_cloud.ScheduleTypeCustomCron && scheduleCron.ValueString() == "" {
+		resp.Error = function.NewArgumentFuncError(4, "schedule_cron is required when schedule_type is custom_cron")
+		return
+	}
+
+	schedule := dbt_cloud.NewJobSchedule(
+		scheduleType,
+		int(scheduleInterval.ValueInt64()),
+		hours,
+		days,
+		scheduleCron.ValueString(),
+	)
+
+	cron, err := schedule.CronExpression()
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, cron))
+}
+
+func int64ListToIntSlice(list types.List) []int {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	values := helper.TypesListInt64SliceToInt64Slice(list)
+	result := make([]int, len(values))
+	for i, v := range values {
+		result[i] = int(v)
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &joinIDFunction{}
+
+func JoinIDFunction() function.Function {
+	return &joinIDFunction{}
+}
+
+type joinIDFunction struct{}
+
+// Metadata implements function.Function.
+func (f *joinIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "join_id"
+}
+
+// Definition implements function.Function.
+func (f *joinIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a composite ID from its two parts",
+		MarkdownDescription: helper.DocString(
+			`Builds a composite ID from its two parts, using the same delimiter as the provider.
+			This is useful to generate the ~~~id~~~ of ~~~import~~~ blocks, for example ~~~provider::dbtcloud::join_id(dbtcloud_project.my_project.id, "DBT_MY_VAR")~~~ for a ~~~dbtcloud_environment_variable~~~.`,
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "first",
+				Description: "The first part of the ID, usually the ID of the parent object (e.g. the project ID)",
+			},
+			function.StringParameter{
+				Name:        "second",
+				Description: "The second part of the ID, usually the ID or the name of the object itself",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run implements function.Function.
+func (f *joinIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var first, second string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &first, &second))
+	if resp.Error != nil {
+		return
+	}
+
+	if first == "" || strings.Contains(first, dbt_cloud.ID_DELIMITER) {
+		resp.Error = function.NewArgumentFuncError(0, "the first part of the ID must not be empty or contain the ID delimiter "+dbt_cloud.ID_DELIMITER)
+		return
+	}
+	if second == "" || strings.Contains(second, dbt_cloud.ID_DELIMITER) {
+		resp.Error = function.NewArgumentFuncError(1, "the second part of the ID must not be empty or contain the ID delimiter "+dbt_cloud.ID_DELIMITER)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, first+dbt_cloud.ID_DELIMITER+second))
+}
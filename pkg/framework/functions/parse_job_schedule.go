@@ -0,0 +1,117 @@
+package functions
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseJobScheduleFunction{}
+
+var jobScheduleAttributeTypes = map[string]attr.Type{
+	"schedule_type":     types.StringType,
+	"schedule_interval": types.Int64Type,
+	"schedule_hours":    types.ListType{ElemType: types.Int64Type},
+	"schedule_days":     types.ListType{ElemType: types.Int64Type},
+	"schedule_cron":     types.StringType,
+}
+
+func ParseJobScheduleFunction() function.Function {
+	return &parseJobScheduleFunction{}
+}
+
+type parseJobScheduleFunction struct{}
+
+// Metadata implements function.Function.
+func (f *parseJobScheduleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_job_schedule"
+}
+
+// Definition implements function.Function.
+func (f *parseJobScheduleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a cron expression into the schedule attributes of a job",
+		MarkdownDescription: helper.DocString(
+			`Converts a cron expression into the ~~~schedule_type~~~, ~~~schedule_interval~~~, ~~~schedule_hours~~~, ~~~schedule_days~~~ and ~~~schedule_cron~~~ attributes of a ~~~dbtcloud_job~~~.
+			Crons running at minute 0 every N hours or at exact hours, every day or on some days of the week, are converted to the equivalent ~~~every_day~~~ or ~~~days_of_week~~~ schedule.
+			Any other cron expression is returned as a ~~~custom_cron~~~ schedule. Attributes not used by the returned schedule type are ~~~null~~~.`,
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cron",
+				Description: "A cron expression with 5 fields",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: jobScheduleAttributeTypes,
+		},
+	}
+}
+
+// Run implements function.Function.
+func (f *parseJobScheduleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cron string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cron))
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := dbt_cloud.ParseJobScheduleCron(cron)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	attributes := map[string]attr.Value{
+		"schedule_type":     types.StringValue(schedule.Date.Type),
+		"schedule_interval": types.Int64Null(),
+		"schedule_hours":    types.ListNull(types.Int64Type),
+		"schedule_days":     types.ListNull(types.Int64Type),
+		"schedule_cron":     types.StringNull(),
+	}
+
+	switch schedule.Date.Type {
+	case dbt_cloud.ScheduleTypeCustomCron:
+		attributes["schedule_cron"] = types.StringValue(*schedule.Date.Cron)
+	case dbt_cloud.ScheduleTypeIntervalCron:
+		// the interval and days are only stored in the generated cron, like when reading a job
+		cronParts := strings.Split(*schedule.Date.Cron, " ")
+		interval, _ := strconv.Atoi(strings.TrimPrefix(cronParts[1], "*/"))
+		attributes["schedule_interval"] = types.Int64Value(int64(interval))
+		days, _ := helper.SliceStringToTypesListInt64Value(strings.Split(cronParts[4], ","))
+		attributes["schedule_days"] = days
+	default:
+		if schedule.Time.Hours != nil {
+			attributes["schedule_hours"] = int64ListValue(*schedule.Time.Hours)
+		} else {
+			attributes["schedule_interval"] = types.Int64Value(int64(schedule.Time.Interval))
+		}
+	}
+
+	if schedule.Date.Days != nil {
+		attributes["schedule_days"] = int64ListValue(*schedule.Date.Days)
+	}
+
+	result, diags := types.ObjectValue(jobScheduleAttributeTypes, attributes)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func int64ListValue(values []int) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.Int64Value(int64(v))
+	}
+	return types.ListValueMust(types.Int64Type, elements)
+}
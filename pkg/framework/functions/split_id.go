@@ -0,0 +1,112 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &splitIDFunction{}
+	_ function.Function = &splitNumericIDFunction{}
+)
+
+func SplitIDFunction() function.Function {
+	return &splitIDFunction{}
+}
+
+type splitIDFunction struct{}
+
+// Metadata implements function.Function.
+func (f *splitIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "split_id"
+}
+
+// Definition implements function.Function.
+func (f *splitIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a composite ID into its two parts",
+		MarkdownDescription: helper.DocString(
+			`Splits a composite ID, like the ones of ~~~dbtcloud_environment_variable~~~ or ~~~dbtcloud_extended_attributes~~~, into a list of its two parts, as strings.
+			The delimiter used is the same one used by the provider when building the IDs.`,
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The composite ID to split",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run implements function.Function.
+func (f *splitIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	first, second, err := helper.SplitIDToStrings(id, "composite ID")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, []string{first, second}))
+}
+
+func SplitNumericIDFunction() function.Function {
+	return &splitNumericIDFunction{}
+}
+
+type splitNumericIDFunction struct{}
+
+// Metadata implements function.Function.
+func (f *splitNumericIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "split_numeric_id"
+}
+
+// Definition implements function.Function.
+func (f *splitNumericIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a composite ID made of two numeric IDs",
+		MarkdownDescription: helper.DocString(
+			`Splits a composite ID made of two numeric IDs, like the ones of ~~~dbtcloud_environment~~~ or ~~~dbtcloud_snowflake_credential~~~, into a list of two numbers.
+			An error is raised if any of the two parts is not a number.`,
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The composite ID to split",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.Int64Type,
+		},
+	}
+}
+
+// Run implements function.Function.
+func (f *splitNumericIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	first, second, err := helper.SplitIDToInts(id, "composite ID")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, []int64{int64(first), int64(second)}))
+}
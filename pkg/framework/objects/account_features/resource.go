@@ -22,8 +22,8 @@ func AccountFeaturesResource() resource.Resource {
 	return &accountFeaturesResource{}
 }
 
-func readFeatures(client *dbt_cloud.Client) (AccountFeaturesResourceModel, error) {
-	features, err := client.GetAccountFeatures()
+func readFeatures(ctx context.Context, client *dbt_cloud.Client) (AccountFeaturesResourceModel, error) {
+	features, err := client.GetAccountFeatures(ctx)
 	if err != nil {
 		return AccountFeaturesResourceModel{}, err
 	}
@@ -63,7 +63,7 @@ func (r *accountFeaturesResource) Create(
 
 	// Update features
 	if !plan.AdvancedCI.IsUnknown() {
-		err := r.client.UpdateAccountFeature("advanced-ci", plan.AdvancedCI.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "advanced-ci", plan.AdvancedCI.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating advanced-ci feature", err.Error())
 			return
@@ -71,7 +71,7 @@ func (r *accountFeaturesResource) Create(
 	}
 
 	if !plan.PartialParsing.IsUnknown() {
-		err := r.client.UpdateAccountFeature("partial-parsing", plan.PartialParsing.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "partial-parsing", plan.PartialParsing.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating partial-parsing feature", err.Error())
 			return
@@ -79,7 +79,7 @@ func (r *accountFeaturesResource) Create(
 	}
 
 	if !plan.RepoCaching.IsUnknown() {
-		err := r.client.UpdateAccountFeature("repo-caching", plan.RepoCaching.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "repo-caching", plan.RepoCaching.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating repo-caching feature", err.Error())
 			return
@@ -87,7 +87,7 @@ func (r *accountFeaturesResource) Create(
 	}
 
 	if !plan.AIFeatures.IsUnknown() {
-		err := r.client.UpdateAccountFeature("ai_features", plan.AIFeatures.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "ai_features", plan.AIFeatures.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating ai_features feature", err.Error())
 			return
@@ -95,7 +95,7 @@ func (r *accountFeaturesResource) Create(
 	}
 
 	if !plan.CatalogIngestion.IsUnknown() {
-		err := r.client.UpdateAccountFeature("catalog-ingestion", plan.CatalogIngestion.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "catalog-ingestion", plan.CatalogIngestion.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating catalog-ingestion feature", err.Error())
 			return
@@ -103,7 +103,7 @@ func (r *accountFeaturesResource) Create(
 	}
 
 	if !plan.ExplorerAccountUI.IsUnknown() {
-		err := r.client.UpdateAccountFeature("explorer-account-ui", plan.ExplorerAccountUI.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "explorer-account-ui", plan.ExplorerAccountUI.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating explorer-account-ui feature", err.Error())
 			return
@@ -111,14 +111,14 @@ func (r *accountFeaturesResource) Create(
 	}
 
 	if !plan.FusionMigrationPermissions.IsUnknown() {
-		err := r.client.UpdateAccountFeature("fusion-migration-permissions", plan.FusionMigrationPermissions.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "fusion-migration-permissions", plan.FusionMigrationPermissions.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating fusion-migration-permissions feature", err.Error())
 			return
 		}
 	}
 
-	features, err := readFeatures(r.client)
+	features, err := readFeatures(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
@@ -134,7 +134,7 @@ func (r *accountFeaturesResource) Read(
 	resp *resource.ReadResponse,
 ) {
 
-	features, err := readFeatures(r.client)
+	features, err := readFeatures(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
@@ -165,7 +165,7 @@ func (r *accountFeaturesResource) Update(
 
 	// Update changed values
 	if !plan.AdvancedCI.IsUnknown() && !plan.AdvancedCI.Equal(state.AdvancedCI) {
-		err := r.client.UpdateAccountFeature("advanced-ci", plan.AdvancedCI.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "advanced-ci", plan.AdvancedCI.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating advanced-ci feature", err.Error())
 			return
@@ -173,7 +173,7 @@ func (r *accountFeaturesResource) Update(
 	}
 
 	if !plan.PartialParsing.IsUnknown() && !plan.PartialParsing.Equal(state.PartialParsing) {
-		err := r.client.UpdateAccountFeature("partial-parsing", plan.PartialParsing.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "partial-parsing", plan.PartialParsing.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating partial-parsing feature", err.Error())
 			return
@@ -181,7 +181,7 @@ func (r *accountFeaturesResource) Update(
 	}
 
 	if !plan.RepoCaching.IsUnknown() && !plan.RepoCaching.Equal(state.RepoCaching) {
-		err := r.client.UpdateAccountFeature("repo-caching", plan.RepoCaching.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "repo-caching", plan.RepoCaching.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating repo-caching feature", err.Error())
 			return
@@ -189,7 +189,7 @@ func (r *accountFeaturesResource) Update(
 	}
 
 	if !plan.AIFeatures.IsUnknown() && !plan.AIFeatures.Equal(state.AIFeatures) {
-		err := r.client.UpdateAccountFeature("ai_features", plan.AIFeatures.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "ai_features", plan.AIFeatures.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating ai_features feature", err.Error())
 			return
@@ -197,7 +197,7 @@ func (r *accountFeaturesResource) Update(
 	}
 
 	if !plan.CatalogIngestion.IsUnknown() && !plan.CatalogIngestion.Equal(state.CatalogIngestion) {
-		err := r.client.UpdateAccountFeature("catalog-ingestion", plan.CatalogIngestion.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "catalog-ingestion", plan.CatalogIngestion.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating catalog-ingestion feature", err.Error())
 			return
@@ -205,7 +205,7 @@ func (r *accountFeaturesResource) Update(
 	}
 
 	if !plan.ExplorerAccountUI.IsUnknown() && !plan.ExplorerAccountUI.Equal(state.ExplorerAccountUI) {
-		err := r.client.UpdateAccountFeature("explorer-account-ui", plan.ExplorerAccountUI.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "explorer-account-ui", plan.ExplorerAccountUI.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating explorer-account-ui feature", err.Error())
 			return
@@ -213,14 +213,14 @@ func (r *accountFeaturesResource) Update(
 	}
 
 	if !plan.FusionMigrationPermissions.IsUnknown() && !plan.FusionMigrationPermissions.Equal(state.FusionMigrationPermissions) {
-		err := r.client.UpdateAccountFeature("fusion-migration-permissions", plan.FusionMigrationPermissions.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "fusion-migration-permissions", plan.FusionMigrationPermissions.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating fusion-migration-permissions feature", err.Error())
 			return
 		}
 	}
 
-	features, err := readFeatures(r.client)
+	features, err := readFeatures(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
@@ -84,7 +84,7 @@ func (d *athenaCredentialDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetAthenaCredential(projectID, credentialID)
+	credential, err := d.client.GetAthenaCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Athena credential",
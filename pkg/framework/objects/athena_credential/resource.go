@@ -102,6 +102,7 @@ func (r *athenaCredentialResource) Create(
 
 	// Create new credential
 	credential, err := r.client.CreateAthenaCredential(
+		ctx,
 		projectID,
 		awsAccessKeyID,
 		awsSecretAccessKey,
@@ -145,7 +146,7 @@ func (r *athenaCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetAthenaCredential(projectID, credentialID)
+	credential, err := r.client.GetAthenaCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "athena credential") {
 			return
@@ -233,6 +234,7 @@ func (r *athenaCredentialResource) Update(
 
 	// Update credential
 	_, err = r.client.UpdateAthenaCredential(
+		ctx,
 		projectID,
 		credentialID,
 		updateCredential,
@@ -272,6 +274,7 @@ func (r *athenaCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
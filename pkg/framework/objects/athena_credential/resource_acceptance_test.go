@@ -1,6 +1,7 @@
 package athena_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -155,7 +156,7 @@ func testAccCheckDbtCloudAthenaCredentialExists(resource string) resource.TestCh
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetAthenaCredential(projectID, credentialID)
+		_, err = apiClient.GetAthenaCredential(context.Background(), projectID, credentialID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -303,7 +304,7 @@ func testAccCheckDbtCloudAthenaCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetAthenaCredential(projectID, credentialID)
+		_, err = apiClient.GetAthenaCredential(context.Background(), projectID, credentialID)
 		if err == nil {
 			return fmt.Errorf("Athena credential still exists")
 		}
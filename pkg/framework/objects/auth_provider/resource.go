@@ -158,7 +158,7 @@ func (r *authProviderResource) Create(
 
 	ap := planToAuthProvider(plan, config)
 
-	created, err := r.client.CreateAuthProvider(ap)
+	created, err := r.client.CreateAuthProvider(ctx, ap)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create auth provider", "Error: "+err.Error())
 		return
@@ -181,7 +181,7 @@ func (r *authProviderResource) Read(
 		return
 	}
 
-	ap, err := r.client.GetAuthProvider(state.ID.ValueInt64())
+	ap, err := r.client.GetAuthProvider(ctx, state.ID.ValueInt64())
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "auth provider") {
 			return
@@ -223,7 +223,7 @@ func (r *authProviderResource) Update(
 	}
 
 	// Fetch current server state to build the update payload.
-	current, err := r.client.GetAuthProvider(state.ID.ValueInt64())
+	current, err := r.client.GetAuthProvider(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting auth provider for update", err.Error())
 		return
@@ -281,7 +281,7 @@ func (r *authProviderResource) Update(
 		}
 	}
 
-	updated, err := r.client.UpdateAuthProvider(state.ID.ValueInt64(), *current)
+	updated, err := r.client.UpdateAuthProvider(ctx, state.ID.ValueInt64(), *current)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update auth provider", "Error: "+err.Error())
 		return
@@ -304,7 +304,7 @@ func (r *authProviderResource) Delete(
 		return
 	}
 
-	if err := r.client.DeleteAuthProvider(state.ID.ValueInt64()); err != nil {
+	if err := r.client.DeleteAuthProvider(ctx, state.ID.ValueInt64()); err != nil {
 		resp.Diagnostics.AddError("Issue deleting auth provider", "Error: "+err.Error())
 	}
 }
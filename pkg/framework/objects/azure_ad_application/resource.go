@@ -2,7 +2,6 @@ package azure_ad_application
 
 import (
 	"context"
-	"strings"
 	"strconv"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
@@ -108,7 +107,7 @@ func (r *azureADApplicationResource) Create(
 
 	// One Azure AD application is allowed per account (unique constraint).
 	// If one already exists, adopt it and update rather than failing.
-	existing, err := r.client.GetAzureADApplicationForAccount()
+	existing, err := r.client.GetAzureADApplicationForAccount(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to check existing Azure AD application", err.Error())
 		return
@@ -117,15 +116,15 @@ func (r *azureADApplicationResource) Create(
 	var result *dbt_cloud.AzureADApplication
 	if existing != nil {
 		// Adopt the existing record and update it with the desired config.
-		result, err = r.client.UpdateAzureADApplication(*existing.ID, modelToApp(plan))
+		result, err = r.client.UpdateAzureADApplication(ctx, *existing.ID, modelToApp(plan))
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to update existing Azure AD application", err.Error())
 			return
 		}
 	} else {
-		result, err = r.client.CreateAzureADApplication(modelToApp(plan))
+		result, err = r.client.CreateAzureADApplication(ctx, modelToApp(plan))
 		if err != nil {
-			if strings.Contains(err.Error(), "duplicate key value") {
+			if dbt_cloud.IsConflict(err) {
 				resp.Diagnostics.AddError(
 					"Unable to create Azure AD application",
 					"An Azure AD application already exists for this dbt Cloud account but is "+
@@ -160,7 +159,7 @@ func (r *azureADApplicationResource) Read(
 		return
 	}
 
-	app, err := r.client.GetAzureADApplication(state.ID.ValueInt64())
+	app, err := r.client.GetAzureADApplication(ctx, state.ID.ValueInt64())
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "Azure AD application") {
 			return
@@ -189,7 +188,7 @@ func (r *azureADApplicationResource) Update(
 		return
 	}
 
-	updated, err := r.client.UpdateAzureADApplication(state.ID.ValueInt64(), modelToApp(plan))
+	updated, err := r.client.UpdateAzureADApplication(ctx, state.ID.ValueInt64(), modelToApp(plan))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update Azure AD application", err.Error())
 		return
@@ -212,7 +211,7 @@ func (r *azureADApplicationResource) Delete(
 		return
 	}
 
-	if err := r.client.DeleteAzureADApplication(state.ID.ValueInt64()); err != nil {
+	if err := r.client.DeleteAzureADApplication(ctx, state.ID.ValueInt64()); err != nil {
 		resp.Diagnostics.AddError("Unable to delete Azure AD application", err.Error())
 	}
 }
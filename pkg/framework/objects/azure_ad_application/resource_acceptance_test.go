@@ -1,6 +1,7 @@
 package azure_ad_application_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -126,7 +127,7 @@ func testAccCheckDbtCloudAzureADApplicationDestroy(s *terraform.State) error {
 			return fmt.Errorf("could not parse Azure AD application ID %q: %w", rs.Primary.ID, err)
 		}
 
-		_, err = apiClient.GetAzureADApplication(id)
+		_, err = apiClient.GetAzureADApplication(context.Background(), id)
 		if err == nil {
 			return fmt.Errorf("Azure AD application %d still exists", id)
 		}
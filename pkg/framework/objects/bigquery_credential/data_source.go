@@ -84,7 +84,7 @@ func (d *bigqueryCredentialDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetBigQueryCredential(projectID, credentialID)
+	credential, err := d.client.GetBigQueryCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Bigquery credential",
@@ -96,7 +96,7 @@ func (r *bigqueryCredentialResource) Create(
 	var adapterVersion string
 	if !plan.ConnectionID.IsNull() && !plan.ConnectionID.IsUnknown() {
 		connectionID := plan.ConnectionID.ValueInt64()
-		connection, err := r.client.GetGlobalConnectionAdapter(connectionID)
+		connection, err := r.client.GetGlobalConnectionAdapter(ctx, connectionID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error fetching global connection",
@@ -109,6 +109,7 @@ func (r *bigqueryCredentialResource) Create(
 
 	// Create new credential
 	credential, err := r.client.CreateBigQueryCredential(
+		ctx,
 		projectID,
 		"bigquery",
 		isActive,
@@ -154,7 +155,7 @@ func (r *bigqueryCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetBigQueryCredential(projectID, credentialID)
+	credential, err := r.client.GetBigQueryCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "bigquery credential") {
 			return
@@ -211,7 +212,7 @@ func (r *bigqueryCredentialResource) Update(
 	numThreads := int(plan.NumThreads.ValueInt64())
 
 	if (state.Dataset.ValueString() != dataset) || (state.NumThreads.ValueInt64() != int64(numThreads)) {
-		credential, err := r.client.GetBigQueryCredential(projectID, credentialID)
+		credential, err := r.client.GetBigQueryCredential(ctx, projectID, credentialID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading Bigquery credential",
@@ -228,6 +229,7 @@ func (r *bigqueryCredentialResource) Update(
 		}
 
 		_, err = r.client.UpdateBigQueryCredential(
+			ctx,
 			projectID,
 			credentialID,
 			*credential,
@@ -267,6 +269,7 @@ func (r *bigqueryCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
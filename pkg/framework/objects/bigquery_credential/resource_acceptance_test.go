@@ -1,6 +1,7 @@
 package bigquery_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -87,7 +88,7 @@ func testAccCheckDbtCloudBigQueryCredentialExists(resource string) resource.Test
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetBigQueryCredential(projectId, credentialId)
+		_, err = apiClient.GetBigQueryCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -113,7 +114,7 @@ func testAccCheckDbtCloudBigQueryCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetBigQueryCredential(projectId, credentialId)
+		_, err = apiClient.GetBigQueryCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("BigQuery credential still exists")
 		}
@@ -78,7 +78,7 @@ func (r *connectionCatalogConfigResource) Create(
 	config := r.buildConfigFromModel(ctx, plan)
 
 	// Create uses PATCH since there's no POST endpoint
-	_, err := r.client.UpdateConnectionCatalogConfig(connectionID, config)
+	_, err := r.client.UpdateConnectionCatalogConfig(ctx, connectionID, config)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating connection catalog config",
@@ -108,7 +108,7 @@ func (r *connectionCatalogConfigResource) Read(
 
 	connectionID := state.ConnectionID.ValueInt64()
 
-	config, err := r.client.GetConnectionCatalogConfig(connectionID)
+	config, err := r.client.GetConnectionCatalogConfig(ctx, connectionID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "connection catalog config") {
 			return
@@ -152,7 +152,7 @@ func (r *connectionCatalogConfigResource) Update(
 	config := r.buildConfigFromModel(ctx, plan)
 
 	// Update the config
-	_, err := r.client.UpdateConnectionCatalogConfig(connectionID, config)
+	_, err := r.client.UpdateConnectionCatalogConfig(ctx, connectionID, config)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating connection catalog config",
@@ -180,7 +180,7 @@ func (r *connectionCatalogConfigResource) Delete(
 	connectionID := state.ConnectionID.ValueInt64()
 
 	// Delete by setting all fields to null
-	err := r.client.DeleteConnectionCatalogConfig(connectionID)
+	err := r.client.DeleteConnectionCatalogConfig(ctx, connectionID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting connection catalog config",
@@ -0,0 +1,15 @@
+package connection_ssh_tunnel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectionSSHTunnelResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	ConnectionID       types.Int64  `tfsdk:"connection_id"`
+	HostName           types.String `tfsdk:"hostname"`
+	Port               types.Int64  `tfsdk:"port"`
+	Username           types.String `tfsdk:"username"`
+	PublicKey          types.String `tfsdk:"public_key"`
+	KeyRotationTrigger types.String `tfsdk:"key_rotation_trigger"`
+}
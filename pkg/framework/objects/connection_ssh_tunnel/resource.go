@@ -0,0 +1,327 @@
+package connection_ssh_tunnel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &connectionSSHTunnelResource{}
+	_ resource.ResourceWithConfigure   = &connectionSSHTunnelResource{}
+	_ resource.ResourceWithImportState = &connectionSSHTunnelResource{}
+	_ resource.ResourceWithModifyPlan  = &connectionSSHTunnelResource{}
+
+	// the adapters of the connections supporting SSH tunnels
+	sshTunnelAdapters = []string{"postgres_", "redshift_"}
+)
+
+func ConnectionSSHTunnelResource() resource.Resource {
+	return &connectionSSHTunnelResource{}
+}
+
+type connectionSSHTunnelResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *connectionSSHTunnelResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_connection_ssh_tunnel"
+}
+
+func (r *connectionSSHTunnelResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+func (r *connectionSSHTunnelResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+func (r *connectionSSHTunnelResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ConnectionSSHTunnelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectionID := plan.ConnectionID.ValueInt64()
+	adapter, err := r.client.GetGlobalConnectionAdapter(ctx, connectionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error getting the connection %d", connectionID),
+			err.Error(),
+		)
+		return
+	}
+	if !supportsSSHTunnel(adapter.Data.AdapterVersion) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connection_id"),
+			"SSH tunnel not supported",
+			fmt.Sprintf(
+				"The connection %d uses the adapter %s, only Postgres and Redshift connections support SSH tunnels.",
+				connectionID,
+				adapter.Data.AdapterVersion,
+			),
+		)
+		return
+	}
+
+	// a second SSH tunnel would break the connection
+	existing, err := r.client.GetEncryptionsForConnection(ctx, connectionID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting the SSH tunnels of the connection", err.Error())
+		return
+	}
+	if len(*existing) > 0 {
+		existingID := "with an unknown ID"
+		if id := (*existing)[0].ID; id != nil {
+			existingID = strconv.FormatInt(*id, 10)
+		}
+		resp.Diagnostics.AddError(
+			"SSH tunnel already exists",
+			fmt.Sprintf(
+				"The connection %d already has the SSH tunnel %s. Import it with `terraform import` instead, and remove `ssh_tunnel` from the connection if it is set there.",
+				connectionID,
+				existingID,
+			),
+		)
+		return
+	}
+
+	sshTunnel, err := r.client.CreateUpdateEncryption(ctx, dbt_cloud.GlobalConnectionEncryptionPayload{
+		AccountID:    int64(r.client.AccountID),
+		ConnectionID: connectionID,
+		Username:     plan.Username.ValueString(),
+		Port:         plan.Port.ValueInt64(),
+		HostName:     plan.HostName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating the SSH tunnel", err.Error())
+		return
+	}
+
+	plan.ID = types.Int64PointerValue(sshTunnel.ID)
+	plan.PublicKey = types.StringValue(sshTunnel.PublicKey)
+	// the state is saved first so that the SSH tunnel is tracked even if it
+	// can't be enabled on the connection
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SetGlobalConnectionSSHTunnelEnabled(ctx, connectionID, true); err != nil {
+		resp.Diagnostics.AddError("Error enabling the SSH tunnel on the connection", err.Error())
+	}
+}
+
+func (r *connectionSSHTunnelResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ConnectionSSHTunnelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshTunnel, err := r.client.GetConnectionSSHTunnel(ctx, state.ID.ValueInt64())
+	if err != nil {
+		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "SSH tunnel") {
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the SSH tunnel", err.Error())
+		return
+	}
+
+	state.ConnectionID = types.Int64Value(sshTunnel.ConnectionID)
+	state.HostName = types.StringValue(sshTunnel.HostName)
+	state.Port = types.Int64Value(sshTunnel.Port)
+	state.Username = types.StringValue(sshTunnel.Username)
+	state.PublicKey = types.StringValue(sshTunnel.PublicKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *connectionSSHTunnelResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state ConnectionSSHTunnelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshTunnelID := state.ID.ValueInt64()
+	connectionID := state.ConnectionID.ValueInt64()
+	payload := dbt_cloud.GlobalConnectionEncryptionPayload{
+		ID:           &sshTunnelID,
+		AccountID:    int64(r.client.AccountID),
+		ConnectionID: connectionID,
+		Username:     plan.Username.ValueString(),
+		Port:         plan.Port.ValueInt64(),
+		HostName:     plan.HostName.ValueString(),
+	}
+
+	// the key pair is kept when updating the bastion host
+	if plan.KeyRotationTrigger.Equal(state.KeyRotationTrigger) {
+		if _, err := r.client.CreateUpdateEncryption(ctx, payload); err != nil {
+			resp.Diagnostics.AddError("Error updating the SSH tunnel", err.Error())
+			return
+		}
+		plan.ID = state.ID
+		plan.PublicKey = state.PublicKey
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// dbt Cloud only generates a key pair when creating an SSH tunnel, and a
+	// connection can't have a second one, so the key pair is rotated by deleting
+	// the SSH tunnel and creating it again, without disabling it on the connection
+	err := r.client.DeleteConnectionSSHTunnel(ctx, dbt_cloud.GlobalConnectionEncryptionPayload{
+		ID:           &sshTunnelID,
+		ConnectionID: connectionID,
+		Username:     state.Username.ValueString(),
+		Port:         state.Port.ValueInt64(),
+		HostName:     state.HostName.ValueString(),
+	})
+	if err != nil && !dbt_cloud.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting the SSH tunnel to rotate its key pair", err.Error())
+		return
+	}
+
+	payload.ID = nil
+	sshTunnel, err := r.client.CreateUpdateEncryption(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating the SSH tunnel with a new key pair",
+			fmt.Sprintf(
+				"The previous SSH tunnel of the connection %d was deleted, the next apply creates it again: %s",
+				connectionID,
+				err,
+			),
+		)
+		return
+	}
+
+	plan.ID = types.Int64PointerValue(sshTunnel.ID)
+	plan.PublicKey = types.StringValue(sshTunnel.PublicKey)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SetGlobalConnectionSSHTunnelEnabled(ctx, connectionID, true); err != nil {
+		resp.Diagnostics.AddError("Error enabling the SSH tunnel on the connection", err.Error())
+	}
+}
+
+// ModifyPlan shows that rotating the key pair changes the ID and the public key
+// of the SSH tunnel, see Update
+func (r *connectionSSHTunnelResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// nothing to do on creation and deletion
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ConnectionSSHTunnelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.KeyRotationTrigger.Equal(state.KeyRotationTrigger) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_key"), types.StringUnknown())...)
+}
+
+func (r *connectionSSHTunnelResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ConnectionSSHTunnelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshTunnelID := state.ID.ValueInt64()
+	connectionID := state.ConnectionID.ValueInt64()
+	err := r.client.DeleteConnectionSSHTunnel(ctx, dbt_cloud.GlobalConnectionEncryptionPayload{
+		ID:           &sshTunnelID,
+		ConnectionID: connectionID,
+		Username:     state.Username.ValueString(),
+		Port:         state.Port.ValueInt64(),
+		HostName:     state.HostName.ValueString(),
+	})
+	if err != nil && !dbt_cloud.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting the SSH tunnel", err.Error())
+		return
+	}
+
+	// the connection might have been deleted already
+	err = r.client.SetGlobalConnectionSSHTunnelEnabled(ctx, connectionID, false)
+	if err != nil && !dbt_cloud.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error disabling the SSH tunnel on the connection", err.Error())
+	}
+}
+
+func (r *connectionSSHTunnelResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	sshTunnelID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing the SSH tunnel ID",
+			fmt.Sprintf("The ID must be the ID of the SSH tunnel, got %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), sshTunnelID)...)
+}
+
+func supportsSSHTunnel(adapterVersion string) bool {
+	for _, prefix := range sshTunnelAdapters {
+		if strings.HasPrefix(adapterVersion, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package connection_ssh_tunnel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// TestConnectionSSHTunnelResourceFakeAPI creates, updates, rotates, imports
+// and destroys the SSH tunnel of a Postgres connection, against the in-memory
+// fake of the dbt Cloud API.
+func TestConnectionSSHTunnelResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	connection := server.Seed(fakeapi.Connections, fakeapi.Object{
+		"name":                  "Postgres",
+		"adapter_version":       "postgres_v0",
+		"is_ssh_tunnel_enabled": false,
+	})
+	connectionID := fmt.Sprint(connection["id"])
+	snowflake := server.Seed(fakeapi.Connections, fakeapi.Object{
+		"name":            "Snowflake",
+		"adapter_version": "snowflake_v0",
+	})
+
+	checkSSHTunnelEnabled := func(enabled bool) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			if value := server.Get(fakeapi.Connections, connection["id"])["is_ssh_tunnel_enabled"]; value != enabled {
+				return fmt.Errorf("expected is_ssh_tunnel_enabled to be %t, got %v", enabled, value)
+			}
+			return nil
+		}
+	}
+	var publicKey string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if sshTunnels := server.List(fakeapi.Encryptions); len(sshTunnels) != 0 {
+				return fmt.Errorf("expected the SSH tunnel to be deleted, got %v", sshTunnels)
+			}
+			return checkSSHTunnelEnabled(false)(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testConnectionSSHTunnelConfig(connectionID, "dbt", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_connection_ssh_tunnel.test", "id"),
+					resource.TestCheckResourceAttrWith("dbtcloud_connection_ssh_tunnel.test", "public_key", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("expected a public key")
+						}
+						publicKey = value
+						return nil
+					}),
+					checkSSHTunnelEnabled(true),
+				),
+			},
+			{
+				// the key pair is kept when the bastion host changes
+				Config: server.ProviderConfig() + testConnectionSSHTunnelConfig(connectionID, "dbt_cloud", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dbtcloud_connection_ssh_tunnel.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_connection_ssh_tunnel.test", "username", "dbt_cloud"),
+					resource.TestCheckResourceAttrWith("dbtcloud_connection_ssh_tunnel.test", "public_key", func(value string) error {
+						if value != publicKey {
+							return fmt.Errorf("expected the public key to be kept, got %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testConnectionSSHTunnelConfig(connectionID, "dbt_cloud", "2026-10"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dbtcloud_connection_ssh_tunnel.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("dbtcloud_connection_ssh_tunnel.test", tfjsonpath.New("public_key")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("dbtcloud_connection_ssh_tunnel.test", "public_key", func(value string) error {
+						if value == publicKey {
+							return fmt.Errorf("expected the public key to be rotated")
+						}
+						return nil
+					}),
+					func(_ *terraform.State) error {
+						if sshTunnels := server.List(fakeapi.Encryptions); len(sshTunnels) != 1 {
+							return fmt.Errorf("expected a single SSH tunnel after the rotation, got %v", sshTunnels)
+						}
+						return nil
+					},
+					checkSSHTunnelEnabled(true),
+				),
+			},
+			{
+				ResourceName:            "dbtcloud_connection_ssh_tunnel.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_rotation_trigger"},
+			},
+			{
+				Config: server.ProviderConfig() + testConnectionSSHTunnelConfig(connectionID, "dbt_cloud", "2026-10") +
+					fmt.Sprintf(`
+resource "dbtcloud_connection_ssh_tunnel" "snowflake" {
+  connection_id = %v
+  hostname      = "bastion.example.com"
+  port          = 22
+  username      = "dbt"
+}
+`, snowflake["id"]),
+				ExpectError: regexp.MustCompile(`only Postgres and Redshift connections support SSH tunnels`),
+			},
+		},
+	})
+}
+
+func testConnectionSSHTunnelConfig(connectionID, username, keyRotationTrigger string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_connection_ssh_tunnel" "test" {
+  connection_id        = %s
+  hostname             = "bastion.example.com"
+  port                 = 22
+  username             = %q
+  key_rotation_trigger = %q
+}
+`, connectionID, username, keyRotationTrigger)
+}
@@ -0,0 +1,65 @@
+package connection_ssh_tunnel
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var resourceSchema = schema.Schema{
+	Description: helper.DocString(
+		`Manage the SSH tunnel of a Postgres or Redshift global connection, and expose the public key dbt Cloud generated for it, to authorize it on the bastion host.
+
+		Creating the SSH tunnel enables it on the connection and destroying it disables it. The ~~~ssh_tunnel~~~ attribute of the ~~~postgres~~~ or ~~~redshift~~~ config of ~~~dbtcloud_global_connection~~~ must not be set for the same connection.
+
+		Changing ~~~key_rotation_trigger~~~ rotates the key pair in place: dbt Cloud only generates a key pair when creating an SSH tunnel, so the SSH tunnel is deleted and created again with a new ID and public key, while staying enabled on the connection. The runs using the connection fail until the new public key is authorized on the bastion host, plan the rotation at a time without runs, or authorize the new key right after the apply.
+
+		~~~create_before_destroy~~~ is not supported, as a connection can only have a single SSH tunnel.`,
+	),
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the SSH tunnel",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"connection_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "The ID of the Postgres or Redshift global connection to tunnel",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"hostname": schema.StringAttribute{
+			Required:    true,
+			Description: "The hostname of the bastion host",
+		},
+		"port": schema.Int64Attribute{
+			Required:    true,
+			Description: "The SSH port of the bastion host",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		},
+		"username": schema.StringAttribute{
+			Required:    true,
+			Description: "The username to connect to the bastion host with",
+		},
+		"public_key": schema.StringAttribute{
+			Computed:    true,
+			Description: "The public key generated by dbt Cloud, to add to the authorized keys of the user on the bastion host",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"key_rotation_trigger": schema.StringAttribute{
+			Optional:    true,
+			Description: "An arbitrary value, changing it rotates the key pair of the SSH tunnel, which changes its `id` and `public_key`",
+		},
+	},
+}
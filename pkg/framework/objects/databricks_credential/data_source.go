@@ -57,7 +57,7 @@ func (d *databricksCredentialDataSource) Read(ctx context.Context, req datasourc
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetDatabricksCredential(projectID, credentialID)
+	credential, err := d.client.GetDatabricksCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading Databricks credential", "Could not read Databricks credential ID "+state.ID.ValueString()+": "+err.Error())
 		return
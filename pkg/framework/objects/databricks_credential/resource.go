@@ -55,7 +55,7 @@ func (d *databricksCredentialResource) ImportState(ctx context.Context, req reso
 		return
 	}
 
-	credentialResponse, err := d.client.GetDatabricksCredential(projectID, credentialID)
+	credentialResponse, err := d.client.GetDatabricksCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting databricks credential", err.Error())
 		return
@@ -167,6 +167,7 @@ func (d *databricksCredentialResource) createGlobal(ctx context.Context, plan *D
 	}
 
 	databricksCredential, err := d.client.CreateDatabricksCredential(
+		ctx,
 		projectID,
 		token,
 		schema,
@@ -199,17 +200,18 @@ func (d *databricksCredentialResource) Delete(ctx context.Context, req resource.
 	d.deleteGlobal(ctx, &state, resp)
 }
 
-func (d *databricksCredentialResource) deleteGlobal(_ context.Context, state *DatabricksCredentialResourceModel, resp *resource.DeleteResponse) {
+func (d *databricksCredentialResource) deleteGlobal(ctx context.Context, state *DatabricksCredentialResourceModel, resp *resource.DeleteResponse) {
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := d.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
 	if err != nil {
 		// If the resource is already deleted (404), treat as success
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		if dbt_cloud.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting Databricks credential", err.Error())
@@ -232,7 +234,7 @@ func (d *databricksCredentialResource) Read(ctx context.Context, req resource.Re
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetDatabricksCredential(projectID, credentialID)
+	credential, err := d.client.GetDatabricksCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading Databricks credential", "Could not read Databricks credential ID "+state.ID.ValueString()+": "+err.Error())
 		return
@@ -335,7 +337,7 @@ func (d *databricksCredentialResource) updateGlobal(ctx context.Context, plan, s
 			CredentialDetails: patchCredentialsDetails,
 		}
 
-		_, err = d.client.UpdateDatabricksCredentialGlobConn(projectID, credentialID, databricksPatch)
+		_, err = d.client.UpdateDatabricksCredentialGlobConn(ctx, projectID, credentialID, databricksPatch)
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating Databricks credential", err.Error())
 			return
@@ -1,6 +1,7 @@
 package databricks_credential_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -169,7 +170,7 @@ func testAccCheckDbtCloudDatabricksCredentialExists(resource string) resource.Te
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetDatabricksCredential(projectId, credentialId)
+		_, err = apiClient.GetDatabricksCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -195,7 +196,7 @@ func testAccCheckDbtCloudDatabricksCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetDatabricksCredential(projectId, credentialId)
+		_, err = apiClient.GetDatabricksCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Databricks credential still exists")
 		}
@@ -0,0 +1,140 @@
+package effective_permissions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &effectivePermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &effectivePermissionsDataSource{}
+)
+
+func EffectivePermissionsDataSource() datasource.DataSource {
+	return &effectivePermissionsDataSource{}
+}
+
+type effectivePermissionsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *effectivePermissionsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_effective_permissions"
+}
+
+func (d *effectivePermissionsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasourceSchema
+}
+
+func (d *effectivePermissionsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state EffectivePermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var grants []dbt_cloud.PermissionGrant
+	var err error
+	if !state.UserID.IsNull() {
+		userID := state.UserID.ValueInt64()
+		state.ID = types.StringValue(fmt.Sprintf("user:%d", userID))
+		grants, err = d.client.GetUserPermissionGrants(ctx, int(userID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Issue when retrieving the permissions of the user %d", userID),
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		serviceTokenID := state.ServiceTokenID.ValueInt64()
+		state.ID = types.StringValue(fmt.Sprintf("service_token:%d", serviceTokenID))
+		grants, err = d.client.GetServiceTokenPermissionGrants(ctx, int(serviceTokenID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Issue when retrieving the permissions of the service token %d", serviceTokenID),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	permissionSets := []string{}
+	state.Grants = []PermissionGrantModel{}
+	for _, grant := range grants {
+		permissionSets = append(permissionSets, grant.Set)
+		state.Grants = append(state.Grants, PermissionGrantModel{
+			GroupID:                       optionalID(grant.GroupID),
+			PermissionSet:                 types.StringValue(grant.Set),
+			ProjectID:                     projectID(grant.ProjectID, grant.AllProjects),
+			AllProjects:                   types.BoolValue(grant.AllProjects),
+			WritableEnvironmentCategories: stringValues(grant.WritableEnvironmentCategories),
+		})
+	}
+	state.PermissionSets = stringValues(slices.Compact(slices.Sorted(slices.Values(permissionSets))))
+
+	state.Projects = []ProjectPermissionsModel{}
+	for _, permission := range dbt_cloud.MergePermissionGrants(grants) {
+		state.Projects = append(state.Projects, ProjectPermissionsModel{
+			ProjectID:                     projectID(permission.ProjectID, permission.AllProjects),
+			AllProjects:                   types.BoolValue(permission.AllProjects),
+			PermissionSets:                stringValues(permission.PermissionSets),
+			WritableEnvironmentCategories: stringValues(permission.WritableEnvironmentCategories),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *effectivePermissionsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// optionalID returns null for the IDs not set
+func optionalID(id int) types.Int64 {
+	if id == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(id))
+}
+
+func projectID(id int, allProjects bool) types.Int64 {
+	if allProjects {
+		return types.Int64Null()
+	}
+	return optionalID(id)
+}
+
+func stringValues(values []string) []types.String {
+	stringValues := make([]types.String, len(values))
+	for i, value := range values {
+		stringValues[i] = types.StringValue(value)
+	}
+	return stringValues
+}
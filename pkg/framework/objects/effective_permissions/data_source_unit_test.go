@@ -0,0 +1,101 @@
+package effective_permissions_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestEffectivePermissionsDataSourceFakeAPI resolves the permissions of a user
+// member of two groups and of a service token, against the in-memory fake of
+// the dbt Cloud API.
+func TestEffectivePermissionsDataSourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	developers := server.Seed(fakeapi.Groups, fakeapi.Object{"name": "Developers"})
+	server.Seed(fakeapi.GroupPermissions, fakeapi.Object{
+		"group_id":                        developers["id"],
+		"permission_set":                  "developer",
+		"project_id":                      100,
+		"writable_environment_categories": []any{"development"},
+	})
+	viewers := server.Seed(fakeapi.Groups, fakeapi.Object{"name": "Viewers"})
+	server.Seed(fakeapi.GroupPermissions, fakeapi.Object{
+		"group_id":       viewers["id"],
+		"permission_set": "job_viewer",
+		"all_projects":   true,
+	})
+	// a group the user is not a member of
+	admins := server.Seed(fakeapi.Groups, fakeapi.Object{"name": "Admins"})
+	server.Seed(fakeapi.GroupPermissions, fakeapi.Object{
+		"group_id":       admins["id"],
+		"permission_set": "account_admin",
+		"all_projects":   true,
+	})
+
+	user := server.Seed(fakeapi.Users, fakeapi.Object{"email": "ada@example.com"})
+	server.Seed(fakeapi.UserPermissions, fakeapi.Object{
+		"user_id":      user["id"],
+		"license_type": "developer",
+		"groups":       []any{fakeapi.Object{"id": developers["id"]}, fakeapi.Object{"id": viewers["id"]}},
+	})
+
+	serviceToken := server.Seed(fakeapi.ServiceTokens, fakeapi.Object{"name": "CI"})
+	server.Seed(fakeapi.ServiceTokenPermissions, fakeapi.Object{
+		"service_token_id":                serviceToken["id"],
+		"permission_set":                  "job_admin",
+		"project_id":                      200,
+		"writable_environment_categories": []any{"all"},
+	})
+
+	config := fmt.Sprintf(`
+data "dbtcloud_effective_permissions" "user" {
+  user_id = %v
+}
+
+data "dbtcloud_effective_permissions" "service_token" {
+  service_token_id = %v
+}
+`, user["id"], serviceToken["id"])
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "id", fmt.Sprintf("user:%v", user["id"])),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "permission_sets.#", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "grants.#", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "projects.0.all_projects", "true"),
+					resource.TestCheckNoResourceAttr("data.dbtcloud_effective_permissions.user", "projects.0.project_id"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "projects.0.permission_sets.0", "job_viewer"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "projects.1.project_id", "100"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "projects.1.permission_sets.#", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "projects.1.permission_sets.0", "developer"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "projects.1.permission_sets.1", "job_viewer"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.user", "projects.1.writable_environment_categories.0", "development"),
+
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.service_token", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.service_token", "projects.0.project_id", "200"),
+					resource.TestCheckResourceAttr("data.dbtcloud_effective_permissions.service_token", "projects.0.writable_environment_categories.0", "all"),
+					resource.TestCheckNoResourceAttr("data.dbtcloud_effective_permissions.service_token", "grants.0.group_id"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "dbtcloud_effective_permissions" "both" {
+  user_id          = 1
+  service_token_id = 2
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package effective_permissions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EffectivePermissionsDataSourceModel struct {
+	ID             types.String              `tfsdk:"id"`
+	UserID         types.Int64               `tfsdk:"user_id"`
+	ServiceTokenID types.Int64               `tfsdk:"service_token_id"`
+	PermissionSets []types.String            `tfsdk:"permission_sets"`
+	Projects       []ProjectPermissionsModel `tfsdk:"projects"`
+	Grants         []PermissionGrantModel    `tfsdk:"grants"`
+}
+
+type ProjectPermissionsModel struct {
+	ProjectID                     types.Int64    `tfsdk:"project_id"`
+	AllProjects                   types.Bool     `tfsdk:"all_projects"`
+	PermissionSets                []types.String `tfsdk:"permission_sets"`
+	WritableEnvironmentCategories []types.String `tfsdk:"writable_environment_categories"`
+}
+
+type PermissionGrantModel struct {
+	GroupID                       types.Int64    `tfsdk:"group_id"`
+	PermissionSet                 types.String   `tfsdk:"permission_set"`
+	ProjectID                     types.Int64    `tfsdk:"project_id"`
+	AllProjects                   types.Bool     `tfsdk:"all_projects"`
+	WritableEnvironmentCategories []types.String `tfsdk:"writable_environment_categories"`
+}
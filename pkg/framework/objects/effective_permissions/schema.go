@@ -0,0 +1,95 @@
+package effective_permissions
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var datasourceSchema = schema.Schema{
+	Description: helper.DocString(
+		`Resolves the effective permissions of a user or of a service token, merging the permissions of all the groups of the user, or the permissions of the service token.
+
+		The permissions granted on all projects are returned in the first element of ~~~projects~~~, with ~~~all_projects~~~ set to ~~~true~~~, and are included in the permissions of each of the other projects as they apply to them as well. This is meant to be used in ~~~check~~~ blocks, to assert that users and tokens only have the access they need.`,
+	),
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the data source, `user:<user_id>` or `service_token:<service_token_id>`",
+		},
+		"user_id": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The ID of the user to resolve the permissions of. One of `user_id` and `service_token_id` is required",
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("service_token_id")),
+			},
+		},
+		"service_token_id": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The ID of the service token to resolve the permissions of",
+		},
+		"permission_sets": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "All the permission sets granted, on any project, sorted",
+		},
+		"projects": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The effective permissions, the ones on all projects first and then the ones of each project sorted by project ID",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the project, null for the permissions on all projects",
+					},
+					"all_projects": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether these are the permissions granted on all projects",
+					},
+					"permission_sets": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "The permission sets granted on the project, including the ones granted on all projects, sorted",
+					},
+					"writable_environment_categories": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "The environment categories that can be written to in the project, including the ones granted on all projects. Only `all` is returned when all the categories can be written to",
+					},
+				},
+			},
+		},
+		"grants": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The permissions merged, as granted by the groups of the user or to the service token",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"group_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the group granting the permission, null for service tokens",
+					},
+					"permission_set": schema.StringAttribute{
+						Computed:    true,
+						Description: "The permission set granted",
+					},
+					"project_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the project the permission is granted on, null when granted on all projects",
+					},
+					"all_projects": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the permission is granted on all projects",
+					},
+					"writable_environment_categories": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "The environment categories that can be written to with the permission",
+					},
+				},
+			},
+		},
+	},
+}
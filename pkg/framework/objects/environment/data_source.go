@@ -41,6 +41,7 @@ func (d *environmentDataSource) Read(
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	environment, err := d.client.GetEnvironment(
+		ctx,
 		int(config.ProjectID.ValueInt64()),
 		int(config.EnvironmentID.ValueInt64()),
 	)
@@ -46,7 +46,7 @@ func (d *environmentsDataSources) Read(
 		projectID = int(config.ProjectID.ValueInt64())
 	}
 
-	environments, err := d.client.GetAllEnvironments(projectID)
+	environments, err := d.client.GetAllEnvironments(ctx, projectID)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1,6 +1,7 @@
 package environment_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -202,7 +203,7 @@ func testAccCheckDbtCloudEnvironmentExists(resource string) resource.TestCheckFu
 			return fmt.Errorf("Issue getting the client")
 		}
 
-		_, err = apiClient.GetEnvironment(projectId, environmentId)
+		_, err = apiClient.GetEnvironment(context.Background(), projectId, environmentId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -244,7 +245,7 @@ func testAccCheckDbtCloudEnvironmentDestroy(s *terraform.State) error {
 			return fmt.Errorf("Error converting environment_id to integer: %s", err)
 		}
 
-		_, err = apiClient.GetEnvironment(projectIDInt, environmentIDInt)
+		_, err = apiClient.GetEnvironment(context.Background(), projectIDInt, environmentIDInt)
 		if err == nil {
 			return fmt.Errorf("Environment still exists")
 		}
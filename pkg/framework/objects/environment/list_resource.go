@@ -0,0 +1,78 @@
+package environment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &environmentListResource{}
+	_ list.ListResourceWithConfigure = &environmentListResource{}
+)
+
+func EnvironmentListResource() list.ListResource {
+	return &environmentListResource{}
+}
+
+// environmentListResource reuses the environment resource for Metadata,
+// Configure, ImportState and Read so that listed environments are identical
+// to imported ones.
+type environmentListResource struct {
+	environmentResource
+}
+
+func (r *environmentListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the environments of the account.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only list the environments of this project",
+			},
+		},
+	}
+}
+
+func (r *environmentListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config EnvironmentListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	environments, err := r.client.GetAllEnvironments(ctx, int(config.ProjectID.ValueInt64()))
+	if err != nil {
+		diags.AddError("Unable to list the environments", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = helper.ListResults(
+		ctx,
+		req,
+		&r.environmentResource,
+		environments,
+		func(environment dbt_cloud.Environment) helper.ListResultItem {
+			return helper.ListResultItem{
+				DisplayName: environment.Name,
+				ImportID:    fmt.Sprintf("%d:%d", environment.Project_Id, *environment.ID),
+				Identity: EnvironmentResourceIdentityModel{
+					ProjectID:     types.Int64Value(int64(environment.Project_Id)),
+					EnvironmentID: types.Int64Value(int64(*environment.ID)),
+				},
+			}
+		},
+	)
+}
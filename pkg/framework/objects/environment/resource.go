@@ -8,8 +8,10 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,6 +19,7 @@ var (
 	_ resource.Resource                = &environmentResource{}
 	_ resource.ResourceWithConfigure   = &environmentResource{}
 	_ resource.ResourceWithImportState = &environmentResource{}
+	_ resource.ResourceWithIdentity    = &environmentResource{}
 )
 
 // connectionIDFromAPI converts the API connection_id pointer to a types.Int64 value,
@@ -53,13 +56,21 @@ func (r *environmentResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	readTimeout, diags := state.Timeouts.Read(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	projectID, environmentID, err := helper.SplitIDToInts(fmt.Sprintf("%d:%d", state.ProjectID.ValueInt64(), state.EnvironmentID.ValueInt64()), "dbtcloud_environment")
 	if err != nil {
 		resp.Diagnostics.AddError("Error parsing ID", err.Error())
 		return
 	}
 
-	environment, err := r.client.GetEnvironment(projectID, environmentID)
+	environment, err := r.client.GetEnvironment(ctx, projectID, environmentID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the environment", err.Error())
 		return
@@ -108,6 +119,7 @@ func (r *environmentResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	r.setIdentity(ctx, state.ProjectID, state.EnvironmentID, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *environmentResource) Create(
@@ -123,6 +135,14 @@ func (r *environmentResource) Create(
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	customBranchValue := plan.CustomBranch.ValueString()
 	if plan.CustomBranch.IsUnknown() {
 		customBranchValue = types.StringNull().ValueString()
@@ -135,6 +155,7 @@ func (r *environmentResource) Create(
 	}
 
 	environment, err := r.client.CreateEnvironment(
+		ctx,
 		plan.IsActive.ValueBool(),
 		int(plan.ProjectID.ValueInt64()),
 		plan.Name.ValueString(),
@@ -207,6 +228,7 @@ func (r *environmentResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	r.setIdentity(ctx, plan.ProjectID, plan.EnvironmentID, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *environmentResource) Update(
@@ -223,13 +245,21 @@ func (r *environmentResource) Update(
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	projectID, environmentID, err := helper.SplitIDToInts(fmt.Sprintf("%d:%d", state.ProjectID.ValueInt64(), state.EnvironmentID.ValueInt64()), "dbtcloud_environment")
 	if err != nil {
 		resp.Diagnostics.AddError("Error parsing ID", err.Error())
 		return
 	}
 
-	envToUpdate, err := r.client.GetEnvironment(projectID, environmentID)
+	envToUpdate, err := r.client.GetEnvironment(ctx, projectID, environmentID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the environment", err.Error())
 		return
@@ -320,6 +350,7 @@ func (r *environmentResource) Update(
 	}
 
 	updatedEnv, err := r.client.UpdateEnvironment(
+		ctx,
 		projectID,
 		environmentID,
 		*envToUpdate,
@@ -356,6 +387,7 @@ func (r *environmentResource) Update(
 	plan.ID = types.StringValue(fmt.Sprintf("%d:%d", plan.ProjectID.ValueInt64(), plan.EnvironmentID.ValueInt64()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	r.setIdentity(ctx, plan.ProjectID, plan.EnvironmentID, resp.Identity, &resp.Diagnostics)
 }
 
 func (r *environmentResource) Delete(
@@ -371,6 +403,14 @@ func (r *environmentResource) Delete(
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	projectID, environmentID, err := helper.SplitIDToInts(fmt.Sprintf("%d:%d", state.ProjectID.ValueInt64(), state.EnvironmentID.ValueInt64()), "dbtcloud_environment")
 	if err != nil {
 		resp.Diagnostics.AddError("Error parsing ID", err.Error())
@@ -378,6 +418,7 @@ func (r *environmentResource) Delete(
 	}
 
 	_, err = r.client.DeleteEnvironment(
+		ctx,
 		projectID,
 		environmentID,
 	)
@@ -387,6 +428,22 @@ func (r *environmentResource) Delete(
 	}
 }
 
+func (r *environmentResource) setIdentity(
+	ctx context.Context,
+	projectID types.Int64,
+	environmentID types.Int64,
+	identity *tfsdk.ResourceIdentity,
+	diags *diag.Diagnostics,
+) {
+	if diags.HasError() {
+		return
+	}
+	diags.Append(identity.Set(ctx, EnvironmentResourceIdentityModel{
+		ProjectID:     projectID,
+		EnvironmentID: environmentID,
+	})...)
+}
+
 func splitEnvironmentID(id string) (int, int, error) {
 	parts := strings.Split(id, ":")
 	if len(parts) != 2 {
@@ -409,11 +466,23 @@ func (r *environmentResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	// The import ID is in the format "project_id:environment_id"
-	projectID, environmentID, err := splitEnvironmentID(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Error splitting environment ID", err.Error())
-		return
+	var projectID, environmentID int
+	if req.ID == "" {
+		var identity EnvironmentResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		projectID = int(identity.ProjectID.ValueInt64())
+		environmentID = int(identity.EnvironmentID.ValueInt64())
+	} else {
+		// The import ID is in the format "project_id:environment_id"
+		var err error
+		projectID, environmentID, err = splitEnvironmentID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error splitting environment ID", err.Error())
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
@@ -427,7 +496,7 @@ func (r *environmentResource) ImportState(
 	}
 
 	// Set the id to match the import ID
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(fmt.Sprintf("%d:%d", projectID, environmentID)))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
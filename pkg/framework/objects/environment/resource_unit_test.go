@@ -1,6 +1,7 @@
 package environment_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,6 +9,10 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/dbt_cloud/testutil"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // Helper to create int pointer
@@ -80,6 +85,7 @@ func TestUpdateEnvironmentRemoveExtendedAttributesID(t *testing.T) {
 	timeoutSeconds := 30
 
 	client, err := dbt_cloud.NewClient(
+		context.Background(),
 		&accountID,
 		strPtr(token),
 		strPtr(hostURL),
@@ -106,7 +112,7 @@ func TestUpdateEnvironmentRemoveExtendedAttributesID(t *testing.T) {
 	}
 
 	// Call UpdateEnvironment
-	_, err = client.UpdateEnvironment(projectID, environmentID, envWithNullExtAttrs)
+	_, err = client.UpdateEnvironment(context.Background(), projectID, environmentID, envWithNullExtAttrs)
 	if err != nil {
 		t.Fatalf("UpdateEnvironment failed: %v", err)
 	}
@@ -182,6 +188,7 @@ func TestUpdateEnvironmentWithExtendedAttributesID(t *testing.T) {
 	timeoutSeconds := 30
 
 	client, err := dbt_cloud.NewClient(
+		context.Background(),
 		&accountID,
 		strPtr(token),
 		strPtr(hostURL),
@@ -208,7 +215,7 @@ func TestUpdateEnvironmentWithExtendedAttributesID(t *testing.T) {
 	}
 
 	// Call UpdateEnvironment
-	_, err = client.UpdateEnvironment(projectID, environmentID, envWithExtAttrs)
+	_, err = client.UpdateEnvironment(context.Background(), projectID, environmentID, envWithExtAttrs)
 	if err != nil {
 		t.Fatalf("UpdateEnvironment failed: %v", err)
 	}
@@ -223,3 +230,68 @@ func TestUpdateEnvironmentWithExtendedAttributesID(t *testing.T) {
 		}
 	}
 }
+
+// TestEnvironmentResourceFakeAPI runs the create, update, import and delete
+// cycle of the environment resource against the in-memory fake of the dbt
+// Cloud API.
+func TestEnvironmentResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if environments := server.List(fakeapi.Environments); len(environments) != 0 {
+				return fmt.Errorf("expected the environment to be deleted, got %v", environments)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testEnvironmentResourceFakeAPIConfig("Production", "production", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_environment.test", "name", "Production"),
+					resource.TestCheckResourceAttr("dbtcloud_environment.test", "deployment_type", "production"),
+					resource.TestCheckResourceAttr("dbtcloud_environment.test", "use_custom_branch", "false"),
+					resource.TestCheckResourceAttrSet("dbtcloud_environment.test", "environment_id"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testEnvironmentResourceFakeAPIConfig("Staging", "staging", "main"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_environment.test", "name", "Staging"),
+					resource.TestCheckResourceAttr("dbtcloud_environment.test", "deployment_type", "staging"),
+					resource.TestCheckResourceAttr("dbtcloud_environment.test", "use_custom_branch", "true"),
+					resource.TestCheckResourceAttr("dbtcloud_environment.test", "custom_branch", "main"),
+				),
+			},
+			{
+				ResourceName:      "dbtcloud_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testEnvironmentResourceFakeAPIConfig(name, deploymentType, customBranch string) string {
+	useCustomBranch := customBranch != ""
+	customBranchConfig := "null"
+	if useCustomBranch {
+		customBranchConfig = fmt.Sprintf("%q", customBranch)
+	}
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name = "analytics"
+}
+
+resource "dbtcloud_environment" "test" {
+  project_id        = dbtcloud_project.test.id
+  name              = %q
+  type              = "deployment"
+  dbt_version       = "latest"
+  deployment_type   = %q
+  use_custom_branch = %t
+  custom_branch     = %s
+}
+`, name, deploymentType, useCustomBranch, customBranchConfig)
+}
@@ -85,7 +85,7 @@ func (d *environmentVariableDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	name := state.Name.ValueString()
 
-	envVar, err := d.client.GetEnvironmentVariable(projectID, name)
+	envVar, err := d.client.GetEnvironmentVariable(ctx, projectID, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading environment variable",
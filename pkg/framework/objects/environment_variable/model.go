@@ -6,10 +6,12 @@ import (
 
 // EnvironmentVariableResourceModel is the model for the resource
 type EnvironmentVariableResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	ProjectID         types.Int64  `tfsdk:"project_id"`
-	Name              types.String `tfsdk:"name"`
-	EnvironmentValues types.Map    `tfsdk:"environment_values"`
+	ID                         types.String `tfsdk:"id"`
+	ProjectID                  types.Int64  `tfsdk:"project_id"`
+	Name                       types.String `tfsdk:"name"`
+	EnvironmentValues          types.Map    `tfsdk:"environment_values"`
+	EnvironmentValuesWo        types.Map    `tfsdk:"environment_values_wo"`
+	EnvironmentValuesWoVersion types.Int64  `tfsdk:"environment_values_wo_version"`
 }
 
 // EnvironmentVariableDataSourceModel is the model for the data source
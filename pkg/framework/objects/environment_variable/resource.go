@@ -16,9 +16,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &environmentVariableResource{}
-	_ resource.ResourceWithConfigure   = &environmentVariableResource{}
-	_ resource.ResourceWithImportState = &environmentVariableResource{}
+	_ resource.Resource                   = &environmentVariableResource{}
+	_ resource.ResourceWithConfigure      = &environmentVariableResource{}
+	_ resource.ResourceWithImportState    = &environmentVariableResource{}
+	_ resource.ResourceWithValidateConfig = &environmentVariableResource{}
 )
 
 // EnvironmentVariableResource is a helper function to simplify the provider implementation.
@@ -74,6 +75,34 @@ func (r *environmentVariableResource) Schema(
 	resp.Schema = resourceSchema
 }
 
+// ValidateConfig recommends environment_values_wo for the secret environment
+// variables, whose values would otherwise be stored in state.
+func (r *environmentVariableResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	if !req.ClientCapabilities.WriteOnlyAttributesAllowed {
+		return
+	}
+
+	var config EnvironmentVariableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.EnvironmentValues.IsNull() || config.Name.IsUnknown() ||
+		!strings.HasPrefix(config.Name.ValueString(), "DBT_ENV_SECRET") {
+		return
+	}
+	helper.AddPreferWriteOnlyAttributeWarning(
+		&resp.Diagnostics,
+		path.Root("environment_values"),
+		path.Root("environment_values_wo"),
+	)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *environmentVariableResource) Create(
 	ctx context.Context,
@@ -88,9 +117,16 @@ func (r *environmentVariableResource) Create(
 		return
 	}
 
+	// Retrieve config to access write-only attributes
+	var config EnvironmentVariableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projectID := int(plan.ProjectID.ValueInt64())
 	name := plan.Name.ValueString()
-	environmentValues := plan.EnvironmentValues.Elements()
+	environmentValues := resolveEnvironmentValues(config, plan)
 
 	envValuesMap := make(map[string]string)
 	for key, value := range environmentValues {
@@ -101,6 +137,7 @@ func (r *environmentVariableResource) Create(
 
 	// Create new envVar
 	envVar, err := r.client.CreateEnvironmentVariable(
+		ctx,
 		int(projectID),
 		name,
 		envValuesMap,
@@ -142,7 +179,7 @@ func (r *environmentVariableResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	name := state.Name.ValueString()
 
-	envVar, err := r.client.GetEnvironmentVariable(projectID, name)
+	envVar, err := r.client.GetEnvironmentVariable(ctx, projectID, name)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "environment variable") {
 			return
@@ -160,7 +197,11 @@ func (r *environmentVariableResource) Read(
 
 	isSecret := strings.HasPrefix(envVar.Name, "DBT_ENV_SECRET")
 
-	if !isSecret {
+	switch {
+	case state.EnvironmentValues.IsNull():
+		// The values are set with environment_values_wo and are not stored in
+		// state, so they can't be compared with the API.
+	case !isSecret:
 		// Non-secret: sync full values from API to detect drift.
 		envVarElements := make(map[string]attr.Value)
 		for key, value := range envVar.EnvironmentNameValues {
@@ -172,7 +213,7 @@ func (r *environmentVariableResource) Read(
 			return
 		}
 		state.EnvironmentValues = envVarMap
-	} else {
+	default:
 		// Secret: API masks values so we can't sync them, but we can still detect
 		// structural changes (environments added/removed) by reconciling the key
 		// set from the API against the preserved values from state.
@@ -227,10 +268,17 @@ func (r *environmentVariableResource) Update(
 		return
 	}
 
+	// Retrieve config to access write-only attributes
+	var config EnvironmentVariableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projectID := int(plan.ProjectID.ValueInt64())
 	name := plan.Name.ValueString()
 	// Get current environment variable from API
-	currentEnvVar, err := r.client.GetEnvironmentVariable(projectID, name)
+	currentEnvVar, err := r.client.GetEnvironmentVariable(ctx, projectID, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the environment variable",
@@ -240,7 +288,7 @@ func (r *environmentVariableResource) Update(
 	}
 
 	// Update values for previously existing environments and disregard those missing from plan
-	environmentValues := plan.EnvironmentValues.Elements()
+	environmentValues := resolveEnvironmentValues(config, plan)
 	envValuesMap := make(map[string]string)
 	for key, keyValuePair := range currentEnvVar.EnvironmentNameValues {
 		idStr := strconv.Itoa(keyValuePair.ID)
@@ -271,6 +319,7 @@ func (r *environmentVariableResource) Update(
 
 	// Update credential
 	_, err = r.client.UpdateEnvironmentVariable(
+		ctx,
 		projectID,
 		envVar,
 	)
@@ -310,6 +359,7 @@ func (r *environmentVariableResource) Delete(
 	name := state.Name.ValueString()
 
 	_, err := r.client.DeleteEnvironmentVariable(
+		ctx,
 		name,
 		int(projectID),
 	)
@@ -369,4 +419,20 @@ func (r *environmentVariableResource) ImportState(
 		path.Root("name"),
 		name,
 	)...)
+	// The values are read from the API, a null map is only kept in state when
+	// environment_values_wo is used.
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("environment_values"),
+		types.MapValueMust(types.StringType, map[string]attr.Value{}),
+	)...)
+}
+
+// resolveEnvironmentValues returns the values of environment_values_wo if set,
+// otherwise the ones of environment_values.
+func resolveEnvironmentValues(config, plan EnvironmentVariableResourceModel) map[string]attr.Value {
+	if !config.EnvironmentValuesWo.IsNull() {
+		return config.EnvironmentValuesWo.Elements()
+	}
+	return plan.EnvironmentValues.Elements()
 }
@@ -1,6 +1,7 @@
 package environment_variable_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func getTestInputData() (string, string, string) {
@@ -298,7 +300,7 @@ func TestAccDbtCloudEnvironmentVariableResourceDrift(t *testing.T) {
 					if err != nil {
 						panic(fmt.Sprintf("could not get shared client: %s", err))
 					}
-					envVar, err := client.GetEnvironmentVariable(capturedProjectID, capturedEnvVarName)
+					envVar, err := client.GetEnvironmentVariable(context.Background(), capturedProjectID, capturedEnvVarName)
 					if err != nil {
 						panic(fmt.Sprintf("could not read env var for out-of-band change: %s", err))
 					}
@@ -307,6 +309,7 @@ func TestAccDbtCloudEnvironmentVariableResourceDrift(t *testing.T) {
 						envValuesMap[strconv.Itoa(v.ID)] = "OutOfBandValue"
 					}
 					if _, err := client.UpdateEnvironmentVariable(
+						context.Background(),
 						capturedProjectID,
 						dbt_cloud.AbstractedEnvironmentVariable{
 							Name:              capturedEnvVarName,
@@ -352,7 +355,7 @@ func testAccCheckDbtCloudEnvironmentVariableExists(resource string) resource.Tes
 
 		environmentVariableName := strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)[1]
 
-		_, err = apiClient.GetEnvironmentVariable(projectId, environmentVariableName)
+		_, err = apiClient.GetEnvironmentVariable(context.Background(), projectId, environmentVariableName)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -376,7 +379,7 @@ func testAccCheckDbtCloudEnvironmentVariableDestroy(s *terraform.State) error {
 		}
 
 		environmentVariableName := strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)[1]
-		_, err = apiClient.GetEnvironmentVariable(projectId, environmentVariableName)
+		_, err = apiClient.GetEnvironmentVariable(context.Background(), projectId, environmentVariableName)
 		if err == nil {
 			return fmt.Errorf("Environment variable still exists")
 		}
@@ -389,3 +392,75 @@ func testAccCheckDbtCloudEnvironmentVariableDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestAccDbtCloudEnvironmentVariableResourceWriteOnly(t *testing.T) {
+	projectName, environmentName, environmentVariableName := getTestInputData()
+
+	resourceName := "dbtcloud_environment_variable.test_env_var"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDbtCloudEnvironmentVariableDestroy,
+		Steps: []resource.TestStep{
+			// Step 1: Create with the write-only values
+			{
+				Config: testAccDbtCloudEnvironmentVariableResourceWriteOnlyConfig(
+					projectName, environmentName, environmentVariableName, "Baa", 1,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "environment_values.%"),
+					resource.TestCheckNoResourceAttr(resourceName, "environment_values_wo.%"),
+					resource.TestCheckResourceAttr(resourceName, "environment_values_wo_version", "1"),
+				),
+			},
+			// Step 2: Update by incrementing environment_values_wo_version
+			{
+				Config: testAccDbtCloudEnvironmentVariableResourceWriteOnlyConfig(
+					projectName, environmentName, environmentVariableName, "Moo", 2,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "environment_values_wo.%"),
+					resource.TestCheckResourceAttr(resourceName, "environment_values_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudEnvironmentVariableResourceWriteOnlyConfig(
+	projectName, environmentName, environmentVariableName, value string,
+	valuesVersion int,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "%s"
+  project_id = dbtcloud_project.test_project.id
+}
+
+resource "dbtcloud_environment_variable" "test_env_var" {
+  name        = "DBT_ENV_SECRET_%s"
+  project_id = dbtcloud_project.test_project.id
+  environment_values_wo = {
+    "project": "%s",
+    "%s": "%s"
+  }
+  environment_values_wo_version = %d
+  depends_on = [
+    dbtcloud_project.test_project,
+    dbtcloud_environment.test_env
+  ]
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, environmentVariableName, value, environmentName, value, valuesVersion)
+}
@@ -1,11 +1,14 @@
 package environment_variable
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,9 +34,22 @@ var resourceSchema = resource_schema.Schema{
 			Description: "Name for the variable, must be unique within a project, must be prefixed with 'DBT_'",
 		},
 		"environment_values": resource_schema.MapAttribute{
-			Required:    true,
+			Optional:    true,
 			ElementType: types.StringType,
-			Description: "Map from environment names to respective variable value, a special key `project` should be set for the project default variable value. This field is not set as sensitive so take precautions when using secret environment variables.",
+			Description: "Map from environment names to respective variable value, a special key `project` should be set for the project default variable value. This field is not set as sensitive so take precautions when using secret environment variables, or use `environment_values_wo` instead.",
+			Validators: []validator.Map{
+				mapvalidator.ExactlyOneOf(path.MatchRoot("environment_values_wo")),
+			},
+		},
+		"environment_values_wo": resource_schema.MapAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			ElementType: types.StringType,
+			Description: "Write-only alternative to `environment_values`, recommended for the secret environment variables (prefixed with 'DBT_ENV_SECRET'). The values are not stored in state. Requires `environment_values_wo_version` to trigger updates.",
+		},
+		"environment_values_wo_version": resource_schema.Int64Attribute{
+			Optional:    true,
+			Description: "Version number for `environment_values_wo`. Increment this value to trigger an update of the values when using `environment_values_wo`.",
 		},
 	},
 }
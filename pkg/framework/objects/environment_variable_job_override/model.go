@@ -12,5 +12,7 @@ type EnvironmentVariableJobOverrideResourceModel struct {
 	Name                             types.String `tfsdk:"name"`
 	JobDefinitionID                  types.Int64  `tfsdk:"job_definition_id"`
 	RawValue                         types.String `tfsdk:"raw_value"`
+	RawValueWo                       types.String `tfsdk:"raw_value_wo"`
+	RawValueWoVersion                types.Int64  `tfsdk:"raw_value_wo_version"`
 	EnvironmentVariableJobOverrideID types.Int64  `tfsdk:"environment_variable_job_override_id"`
 }
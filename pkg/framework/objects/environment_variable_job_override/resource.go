@@ -16,9 +16,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &environmentVariableJobOverrideResource{}
-	_ resource.ResourceWithConfigure   = &environmentVariableJobOverrideResource{}
-	_ resource.ResourceWithImportState = &environmentVariableJobOverrideResource{}
+	_ resource.Resource                   = &environmentVariableJobOverrideResource{}
+	_ resource.ResourceWithConfigure      = &environmentVariableJobOverrideResource{}
+	_ resource.ResourceWithImportState    = &environmentVariableJobOverrideResource{}
+	_ resource.ResourceWithValidateConfig = &environmentVariableJobOverrideResource{}
 )
 
 // EnvironmentVariableJobOverrideResource is a helper function to simplify the provider implementation.
@@ -74,6 +75,34 @@ func (r *environmentVariableJobOverrideResource) Schema(
 	resp.Schema = resourceSchema
 }
 
+// ValidateConfig recommends raw_value_wo for the secret environment variables,
+// whose value would otherwise be stored in state.
+func (r *environmentVariableJobOverrideResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	if !req.ClientCapabilities.WriteOnlyAttributesAllowed {
+		return
+	}
+
+	var config EnvironmentVariableJobOverrideResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RawValue.IsNull() || config.Name.IsUnknown() ||
+		!strings.HasPrefix(config.Name.ValueString(), "DBT_ENV_SECRET") {
+		return
+	}
+	helper.AddPreferWriteOnlyAttributeWarning(
+		&resp.Diagnostics,
+		path.Root("raw_value"),
+		path.Root("raw_value_wo"),
+	)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *environmentVariableJobOverrideResource) Create(
 	ctx context.Context,
@@ -88,13 +117,21 @@ func (r *environmentVariableJobOverrideResource) Create(
 		return
 	}
 
+	// Retrieve config to access write-only attributes
+	var config EnvironmentVariableJobOverrideResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projectID := int(plan.ProjectID.ValueInt64())
 	name := plan.Name.ValueString()
-	rawValue := plan.RawValue.ValueString()
+	rawValue := helper.ResolveWriteOnlyString(config.RawValueWo, plan.RawValue)
 	jobDefinitionID := int(plan.JobDefinitionID.ValueInt64())
 
 	// Create new envVar
 	environmentVariableJobOverride, err := r.client.CreateEnvironmentVariableJobOverride(
+		ctx,
 		int(projectID),
 		name,
 		rawValue,
@@ -149,7 +186,7 @@ func (r *environmentVariableJobOverrideResource) Read(
 	jobDefinitionID := int(state.JobDefinitionID.ValueInt64())
 	id := state.EnvironmentVariableJobOverrideID.ValueInt64()
 
-	environmentVariableJobOverride, err := r.client.GetEnvironmentVariableJobOverride(projectID, jobDefinitionID, int(id))
+	environmentVariableJobOverride, err := r.client.GetEnvironmentVariableJobOverride(ctx, projectID, jobDefinitionID, int(id))
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "environment variable job override") {
 			return
@@ -207,6 +244,13 @@ func (r *environmentVariableJobOverrideResource) Update(
 		return
 	}
 
+	// Retrieve config to access write-only attributes
+	var config EnvironmentVariableJobOverrideResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projectID := int(plan.ProjectID.ValueInt64())
 	id := plan.EnvironmentVariableJobOverrideID.ValueInt64()
 
@@ -216,12 +260,12 @@ func (r *environmentVariableJobOverrideResource) Update(
 		Name:            plan.Name.ValueString(),
 		ID:              helper.Int64ToIntPointer(id),
 		JobDefinitionID: int(plan.JobDefinitionID.ValueInt64()),
-		RawValue:        plan.RawValue.ValueString(),
+		RawValue:        helper.ResolveWriteOnlyString(config.RawValueWo, plan.RawValue),
 		Type:            "job",
 	}
 
 	// Update credential
-	_, err := r.client.UpdateEnvironmentVariableJobOverride(projectID, int(id), envVarJobOverride)
+	_, err := r.client.UpdateEnvironmentVariableJobOverride(ctx, projectID, int(id), envVarJobOverride)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -258,6 +302,7 @@ func (r *environmentVariableJobOverrideResource) Delete(
 	id := helper.Int64ToIntPointer(state.EnvironmentVariableJobOverrideID.ValueInt64())
 
 	_, err := r.client.DeleteEnvironmentVariableJobOverride(
+		ctx,
 		int(projectID), *id,
 	)
 	if err != nil {
@@ -342,7 +387,7 @@ func (r *environmentVariableJobOverrideResource) ImportState(
 		envVarJobOverrideID,
 	)...)
 
-	envVarJobOverride, err := r.client.GetEnvironmentVariableJobOverride(projectID, jobDefinitionID, envVarJobOverrideID)
+	envVarJobOverride, err := r.client.GetEnvironmentVariableJobOverride(ctx, projectID, jobDefinitionID, envVarJobOverrideID)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
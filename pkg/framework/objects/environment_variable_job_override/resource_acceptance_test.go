@@ -1,6 +1,7 @@
 package environment_variable_job_override_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDbtCloudEnvironmentVariableJobOverrideResource(t *testing.T) {
@@ -205,6 +207,7 @@ func testAccCheckDbtCloudEnvironmentVariableJobOverrideExists(
 		}
 
 		_, err = apiClient.GetEnvironmentVariableJobOverride(
+			context.Background(),
 			projectId,
 			jobID,
 			envVarOverrideID,
@@ -243,6 +246,7 @@ func testAccCheckDbtCloudEnvironmentVariableJobOverrideDestroy(s *terraform.Stat
 		}
 
 		_, err = apiClient.GetEnvironmentVariableJobOverride(
+			context.Background(),
 			projectId,
 			jobID,
 			envVarOverrideID,
@@ -259,3 +263,105 @@ func testAccCheckDbtCloudEnvironmentVariableJobOverrideDestroy(s *terraform.Stat
 
 	return nil
 }
+
+func TestAccDbtCloudEnvironmentVariableJobOverrideResourceWriteOnly(t *testing.T) {
+
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentVariableName := fmt.Sprintf(
+		"ENV_SECRET_%s",
+		strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)),
+	)
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resourceName := "dbtcloud_environment_variable_job_override.test_env_var_job_override"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDbtCloudEnvironmentVariableJobOverrideDestroy,
+		Steps: []resource.TestStep{
+			// Step 1: Create with the write-only value
+			{
+				Config: testAccDbtCloudEnvironmentVariableJobOverrideResourceWriteOnlyConfig(
+					projectName, environmentName, environmentVariableName, jobName, "Baa", 1,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableJobOverrideExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "raw_value"),
+					resource.TestCheckNoResourceAttr(resourceName, "raw_value_wo"),
+					resource.TestCheckResourceAttr(resourceName, "raw_value_wo_version", "1"),
+				),
+			},
+			// Step 2: Update by incrementing raw_value_wo_version
+			{
+				Config: testAccDbtCloudEnvironmentVariableJobOverrideResourceWriteOnlyConfig(
+					projectName, environmentName, environmentVariableName, jobName, "Moo", 2,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentVariableJobOverrideExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "raw_value_wo"),
+					resource.TestCheckResourceAttr(resourceName, "raw_value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudEnvironmentVariableJobOverrideResourceWriteOnlyConfig(
+	projectName, environmentName, environmentVariableName, jobName, rawValue string,
+	rawValueVersion int,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+
+resource "dbtcloud_environment" "test_env" {
+  name        = "%s"
+  type = "deployment"
+  dbt_version = "%s"
+  project_id = dbtcloud_project.test_project.id
+}
+
+resource "dbtcloud_environment_variable" "test_env_var" {
+  name        = "DBT_%s"
+  project_id = dbtcloud_project.test_project.id
+  environment_values_wo = {
+    "project": "Oink",
+  }
+  environment_values_wo_version = 1
+  depends_on = [
+    dbtcloud_project.test_project,
+    dbtcloud_environment.test_env
+  ]
+}
+
+resource dbtcloud_job test_job_sched {
+  environment_id = dbtcloud_environment.test_env.environment_id
+  execute_steps = ["dbt test"]
+  name = "%s"
+  project_id = dbtcloud_project.test_project.id
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false
+  }
+  num_threads = 4
+  schedule_days     = [0, 1, 2, 3, 4, 5, 6]
+  schedule_type     = "days_of_week"
+  schedule_interval = 6
+}
+
+resource dbtcloud_environment_variable_job_override test_env_var_job_override {
+	job_definition_id = dbtcloud_job.test_job_sched.id
+	project_id = dbtcloud_project.test_project.id
+	name = dbtcloud_environment_variable.test_env_var.name
+	raw_value_wo = "%s"
+	raw_value_wo_version = %d
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, environmentVariableName, jobName, rawValue, rawValueVersion)
+}
@@ -1,10 +1,13 @@
 package environment_variable_job_override
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var resourceSchema = resource_schema.Schema{
@@ -46,8 +49,20 @@ var resourceSchema = resource_schema.Schema{
 			},
 		},
 		"raw_value": resource_schema.StringAttribute{
-			Required:    true,
-			Description: "The value for the override of the environment variable",
+			Optional:    true,
+			Description: "The value for the override of the environment variable. Consider using `raw_value_wo` instead for the secret environment variables, which is not stored in state.",
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("raw_value_wo")),
+			},
+		},
+		"raw_value_wo": resource_schema.StringAttribute{
+			Optional:    true,
+			WriteOnly:   true,
+			Description: "Write-only alternative to `raw_value`, recommended for the secret environment variables (prefixed with 'DBT_ENV_SECRET'). The value is not stored in state. Requires `raw_value_wo_version` to trigger updates.",
+		},
+		"raw_value_wo_version": resource_schema.Int64Attribute{
+			Optional:    true,
+			Description: "Version number for `raw_value_wo`. Increment this value to trigger an update of the value when using `raw_value_wo`.",
 		},
 		"name": resource_schema.StringAttribute{
 			Required:    true,
@@ -0,0 +1,12 @@
+package environment_variables
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EnvironmentVariablesResourceModel is the model for the resource
+type EnvironmentVariablesResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.Int64  `tfsdk:"project_id"`
+	Variables types.Map    `tfsdk:"variables"`
+}
@@ -0,0 +1,362 @@
+package environment_variables
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &environmentVariablesResource{}
+	_ resource.ResourceWithConfigure   = &environmentVariablesResource{}
+	_ resource.ResourceWithImportState = &environmentVariablesResource{}
+)
+
+// EnvironmentVariablesResource is a helper function to simplify the provider implementation.
+func EnvironmentVariablesResource() resource.Resource {
+	return &environmentVariablesResource{}
+}
+
+// environmentVariablesResource is the resource implementation.
+type environmentVariablesResource struct {
+	client *dbt_cloud.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *environmentVariablesResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *environmentVariablesResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variables"
+}
+
+// Schema defines the schema for the resource.
+func (r *environmentVariablesResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+// Create sets the environment variables of the project and sets the initial Terraform state.
+func (r *environmentVariablesResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, diags := variablesFromModel(ctx, plan.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(plan.ProjectID.ValueInt64())
+	if err := r.applyVariables(ctx, projectID, variables, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating the environment variables",
+			"Could not set the environment variables of the project, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(projectID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with all the environment variables of the project.
+func (r *environmentVariablesResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+	environmentVariables, err := r.client.GetEnvironmentVariables(ctx, projectID)
+	if err != nil {
+		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "environment variables") {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading the environment variables",
+			"Could not read the environment variables of the project ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	previous, diags := variablesFromModel(ctx, state.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the variables created outside of Terraform are added to the state, so
+	// that the next plan deletes them
+	variables := map[string]map[string]string{}
+	for name, environmentValues := range environmentVariables.Variables {
+		variables[name] = map[string]string{}
+		for environmentName, value := range environmentValues {
+			// the API masks the values of the secrets, the ones known are kept
+			// and only the environments they are set for can drift
+			if previousValue, ok := previous[name][environmentName]; ok && isSecret(name) {
+				variables[name][environmentName] = previousValue
+				continue
+			}
+			variables[name][environmentName] = value.Value
+		}
+	}
+
+	state.ID = types.StringValue(strconv.Itoa(projectID))
+	state.Variables, diags = variablesToModel(ctx, variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update sets the environment variables of the project and sets the updated Terraform state on success.
+func (r *environmentVariablesResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, diags := variablesFromModel(ctx, plan.Variables)
+	resp.Diagnostics.Append(diags...)
+	previous, diags := variablesFromModel(ctx, state.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(plan.ProjectID.ValueInt64())
+	if err := r.applyVariables(ctx, projectID, variables, previous); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating the environment variables",
+			"Could not set the environment variables of the project, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes all the environment variables of the project and removes the Terraform state on success.
+func (r *environmentVariablesResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state EnvironmentVariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, diags := variablesFromModel(ctx, state.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectID.ValueInt64())
+	for _, name := range slices.Sorted(maps.Keys(variables)) {
+		_, err := r.client.DeleteEnvironmentVariable(ctx, name, projectID)
+		if err != nil && !dbt_cloud.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting the environment variables",
+				fmt.Sprintf("Could not delete the environment variable %s, unexpected error: %s", name, err),
+			)
+			return
+		}
+	}
+}
+
+// ImportState imports all the environment variables of a project into Terraform state.
+func (r *environmentVariablesResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	projectID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier with format: project_id. Got: %q",
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+}
+
+// applyVariables creates, updates and deletes the environment variables of
+// the project so that they are exactly the planned ones. previous are the
+// values in the state, used for the secrets which can't be read back.
+func (r *environmentVariablesResource) applyVariables(
+	ctx context.Context,
+	projectID int,
+	planned map[string]map[string]string,
+	previous map[string]map[string]string,
+) error {
+	current, err := r.client.GetEnvironmentVariables(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(current.Variables)) {
+		if _, ok := planned[name]; ok {
+			continue
+		}
+		if _, err := r.client.DeleteEnvironmentVariable(ctx, name, projectID); err != nil {
+			return fmt.Errorf("deleting %s: %w", name, err)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(planned)) {
+		currentValues, exists := current.Variables[name]
+		if !exists {
+			// CreateEnvironmentVariable adds the name to the values it gets
+			_, err := r.client.CreateEnvironmentVariable(ctx, projectID, name, maps.Clone(planned[name]))
+			if err != nil {
+				return fmt.Errorf("creating %s: %w", name, err)
+			}
+			continue
+		}
+
+		changes := valueChanges(name, currentValues, planned[name], previous[name])
+		if len(changes) == 0 {
+			continue
+		}
+		_, err := r.client.UpdateEnvironmentVariable(ctx, projectID, dbt_cloud.AbstractedEnvironmentVariable{
+			Name:              name,
+			ProjectID:         projectID,
+			EnvironmentValues: changes,
+		})
+		if err != nil {
+			return fmt.Errorf("updating %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// valueChanges returns the values to send to update a variable: the existing
+// values are keyed by ID and set to "" to be deleted, the new ones are keyed
+// by environment name.
+func valueChanges(
+	name string,
+	current map[string]dbt_cloud.EnvironmentVariableNameValue,
+	planned map[string]string,
+	previous map[string]string,
+) map[string]string {
+	changes := map[string]string{}
+	for environmentName, currentValue := range current {
+		plannedValue, ok := planned[environmentName]
+		if !ok {
+			changes[strconv.Itoa(currentValue.ID)] = ""
+			continue
+		}
+
+		knownValue, known := currentValue.Value, true
+		if isSecret(name) {
+			knownValue, known = previous[environmentName]
+		}
+		if !known || knownValue != plannedValue {
+			changes[strconv.Itoa(currentValue.ID)] = plannedValue
+		}
+	}
+
+	for environmentName, plannedValue := range planned {
+		if _, ok := current[environmentName]; !ok {
+			changes[environmentName] = plannedValue
+		}
+	}
+	return changes
+}
+
+// isSecret reports whether the values of the variable are masked by the API.
+func isSecret(name string) bool {
+	return strings.HasPrefix(name, "DBT_ENV_SECRET")
+}
+
+func variablesFromModel(
+	ctx context.Context,
+	variables types.Map,
+) (map[string]map[string]string, diag.Diagnostics) {
+	values := map[string]map[string]string{}
+	if variables.IsNull() || variables.IsUnknown() {
+		return values, nil
+	}
+	diags := variables.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+func variablesToModel(
+	ctx context.Context,
+	variables map[string]map[string]string,
+) (types.Map, diag.Diagnostics) {
+	return types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, variables)
+}
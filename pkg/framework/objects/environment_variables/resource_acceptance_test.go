@@ -0,0 +1,191 @@
+package environment_variables_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudEnvironmentVariablesResource(t *testing.T) {
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	var projectID int
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudEnvironmentVariablesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudEnvironmentVariablesResourceConfig(
+					projectName,
+					environmentName,
+					fmt.Sprintf(`
+    DBT_TARGET = {
+      project = "dev"
+      %s      = "prod"
+    }
+    DBT_THREADS = {
+      project = "4"
+    }`, environmentName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.%", "2"),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.DBT_TARGET.project", "dev"),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_environment_variables.test",
+						fmt.Sprintf("variables.DBT_TARGET.%s", environmentName),
+						"prod",
+					),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.DBT_THREADS.project", "4"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["dbtcloud_environment_variables.test"]
+						var err error
+						projectID, err = strconv.Atoi(rs.Primary.ID)
+						return err
+					},
+				),
+			},
+			// a variable created outside of Terraform is detected and deleted
+			{
+				PreConfig: func() {
+					client, err := acctest_helper.SharedClient()
+					if err != nil {
+						panic(fmt.Sprintf("could not get shared client: %s", err))
+					}
+					if _, err := client.CreateEnvironmentVariable(
+						context.Background(),
+						projectID,
+						"DBT_CREATED_IN_UI",
+						map[string]string{"project": "unmanaged"},
+					); err != nil {
+						panic(fmt.Sprintf("out-of-band env var creation failed: %s", err))
+					}
+				},
+				Config: testAccDbtCloudEnvironmentVariablesResourceConfig(
+					projectName,
+					environmentName,
+					`
+    DBT_TARGET = {
+      project = "development"
+    }
+    DBT_ENV_SECRET_TOKEN = {
+      project = "secret"
+    }`,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dbtcloud_environment_variables.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.%", "2"),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.DBT_TARGET.%", "1"),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.DBT_TARGET.project", "development"),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.DBT_ENV_SECRET_TOKEN.project", "secret"),
+					testAccCheckDbtCloudEnvironmentVariablesInAPI("dbtcloud_environment_variables.test", 2),
+				),
+			},
+			// the values of the secrets can't be imported
+			{
+				ResourceName:            "dbtcloud_environment_variables.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"variables.DBT_ENV_SECRET_TOKEN.project"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudEnvironmentVariablesResourceConfig(
+	projectName, environmentName, variables string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_environment" "test_env" {
+  name        = "%s"
+  type        = "deployment"
+  dbt_version = "%s"
+  project_id  = dbtcloud_project.test_project.id
+}
+
+resource "dbtcloud_environment_variables" "test" {
+  project_id = dbtcloud_project.test_project.id
+  variables = {%s
+  }
+  depends_on = [
+    dbtcloud_environment.test_env
+  ]
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, variables)
+}
+
+func testAccCheckDbtCloudEnvironmentVariablesInAPI(resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		projectID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := acctest_helper.SharedClient()
+		if err != nil {
+			return fmt.Errorf("Issue getting the client: %s", err)
+		}
+		environmentVariables, err := client.GetEnvironmentVariables(context.Background(), projectID)
+		if err != nil {
+			return fmt.Errorf("Can't get the environment variables: %s", err)
+		}
+		if len(environmentVariables.Variables) != count {
+			return fmt.Errorf(
+				"expected %d environment variables, got %d",
+				count,
+				len(environmentVariables.Variables),
+			)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudEnvironmentVariablesDestroy(s *terraform.State) error {
+	client, err := acctest_helper.SharedClient()
+	if err != nil {
+		return fmt.Errorf("Issue getting the client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbtcloud_environment_variables" {
+			continue
+		}
+		projectID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		environmentVariables, err := client.GetEnvironmentVariables(context.Background(), projectID)
+		if err != nil {
+			// the project is deleted too
+			continue
+		}
+		if len(environmentVariables.Variables) != 0 {
+			return fmt.Errorf("environment variables still exist in the project %d", projectID)
+		}
+	}
+
+	return nil
+}
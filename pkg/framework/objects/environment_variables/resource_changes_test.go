@@ -0,0 +1,72 @@
+package environment_variables
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+)
+
+func TestValueChanges(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]dbt_cloud.EnvironmentVariableNameValue{
+		"project": {ID: 1, Value: "dev"},
+		"Prod":    {ID: 2, Value: "prod"},
+	}
+
+	tests := []struct {
+		name     string
+		variable string
+		current  map[string]dbt_cloud.EnvironmentVariableNameValue
+		planned  map[string]string
+		previous map[string]string
+		want     map[string]string
+	}{
+		{
+			name:     "unchanged",
+			variable: "DBT_TARGET",
+			current:  current,
+			planned:  map[string]string{"project": "dev", "Prod": "prod"},
+			want:     map[string]string{},
+		},
+		{
+			name:     "updated, removed and added values",
+			variable: "DBT_TARGET",
+			current:  current,
+			planned:  map[string]string{"project": "development", "CI": "ci"},
+			want:     map[string]string{"1": "development", "2": "", "CI": "ci"},
+		},
+		{
+			name:     "secret compared with the state",
+			variable: "DBT_ENV_SECRET_TOKEN",
+			current: map[string]dbt_cloud.EnvironmentVariableNameValue{
+				"project": {ID: 1, Value: "*****"},
+				"Prod":    {ID: 2, Value: "*****"},
+			},
+			planned:  map[string]string{"project": "a", "Prod": "c"},
+			previous: map[string]string{"project": "a", "Prod": "b"},
+			want:     map[string]string{"2": "c"},
+		},
+		{
+			name:     "secret not in the state",
+			variable: "DBT_ENV_SECRET_TOKEN",
+			current: map[string]dbt_cloud.EnvironmentVariableNameValue{
+				"project": {ID: 1, Value: "*****"},
+			},
+			planned: map[string]string{"project": "a"},
+			want:    map[string]string{"1": "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := valueChanges(tt.variable, tt.current, tt.planned, tt.previous)
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("valueChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package environment_variables_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestEnvironmentVariablesResourceFakeAPI checks that the variables created
+// outside of Terraform are detected and deleted, against the in-memory fake
+// of the dbt Cloud API.
+func TestEnvironmentVariablesResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	project := server.Seed(fakeapi.Projects, fakeapi.Object{"name": "analytics"})
+	server.Seed(fakeapi.Environments, fakeapi.Object{"project_id": project["id"], "name": "Prod"})
+	projectID := fmt.Sprint(project["id"])
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if values := server.List(fakeapi.EnvironmentVariables); len(values) != 0 {
+				return fmt.Errorf("expected the environment variables to be deleted, got %v", values)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testEnvironmentVariablesConfig(projectID, `
+    DBT_TARGET = {
+      project = "dev"
+      Prod    = "prod"
+    }
+    DBT_THREADS = {
+      project = "4"
+    }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "id", projectID),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.%", "2"),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.DBT_TARGET.Prod", "prod"),
+				),
+			},
+			{
+				// a variable created in the UI is deleted, and the values
+				// removed from the config are deleted
+				PreConfig: func() {
+					server.Seed(fakeapi.EnvironmentVariables, fakeapi.Object{
+						"project_id":       project["id"],
+						"name":             "DBT_FROM_UI",
+						"environment_name": "project",
+						"value":            "unmanaged",
+					})
+				},
+				Config: server.ProviderConfig() + testEnvironmentVariablesConfig(projectID, `
+    DBT_TARGET = {
+      project = "development"
+    }
+    DBT_THREADS = {
+      project = "4"
+    }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dbtcloud_environment_variables.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.%", "2"),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.DBT_TARGET.%", "1"),
+					resource.TestCheckResourceAttr("dbtcloud_environment_variables.test", "variables.DBT_TARGET.project", "development"),
+					func(_ *terraform.State) error {
+						if values := server.List(fakeapi.EnvironmentVariables); len(values) != 2 {
+							return fmt.Errorf("expected 2 environment variable values, got %v", values)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "dbtcloud_environment_variables.test",
+				ImportState:       true,
+				ImportStateId:     projectID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testEnvironmentVariablesConfig(projectID, variables string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_environment_variables" "test" {
+  project_id = %s
+  variables = {%s
+  }
+}
+`, projectID, variables)
+}
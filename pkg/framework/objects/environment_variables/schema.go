@@ -0,0 +1,52 @@
+package environment_variables
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var resourceSchema = schema.Schema{
+	Description: "Authoritative management of all the environment variables of a project. The variables of the project which are not in `variables`, including the ones created in the dbt Cloud UI, are deleted. This resource should not be used together with `dbtcloud_environment_variable` or `dbtcloud_partial_environment_variable` for the same project.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of this resource. Contains the project ID.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "Project ID to manage the environment variables of",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"variables": schema.MapAttribute{
+			Required: true,
+			ElementType: types.MapType{
+				ElemType: types.StringType,
+			},
+			Description: "Map from the variable names, which must be prefixed with 'DBT_', to the map from environment names to respective variable value. A special key `project` should be set for the project default variable value. The values of the secret variables (prefixed with 'DBT_ENV_SECRET') can't be read from the API, so only the environments they are set for are checked for drift. This field is not set as sensitive so take precautions when using secret environment variables.",
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^DBT_`),
+						"must be prefixed with 'DBT_'",
+					),
+				),
+				mapvalidator.ValueMapsAre(
+					mapvalidator.SizeAtLeast(1),
+				),
+			},
+		},
+	},
+}
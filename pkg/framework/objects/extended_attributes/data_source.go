@@ -54,7 +54,7 @@ func (p *extendedAttributesDataSource) Read(ctx context.Context, req datasource.
 	projectId := int(state.ProjectID.ValueInt64())
 	extendedAttributesId := int(state.ExtendedAttributesID.ValueInt64())
 
-	extendedAttributes, err := p.client.GetExtendedAttributes(projectId, extendedAttributesId)
+	extendedAttributes, err := p.client.GetExtendedAttributes(ctx, projectId, extendedAttributesId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Extended attributes",
@@ -127,7 +127,7 @@ func (r *extendedAttributesResource) Create(
 	extendedAttributesRaw := json.RawMessage([]byte(plan.ExtendedAttributes.ValueString()))
 
 	// Create new extended attributes
-	extendedAttributes, err := r.client.CreateExtendedAttributes(state, projectID, extendedAttributesRaw)
+	extendedAttributes, err := r.client.CreateExtendedAttributes(ctx, state, projectID, extendedAttributesRaw)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating extended attributes",
@@ -176,7 +176,7 @@ func (r *extendedAttributesResource) Read(
 		return
 	}
 
-	extendedAttributes, err := r.client.GetExtendedAttributes(projectID, extendedAttributesID)
+	extendedAttributes, err := r.client.GetExtendedAttributes(ctx, projectID, extendedAttributesID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "extended attributes") {
 			return
@@ -237,6 +237,7 @@ func (r *extendedAttributesResource) Update(
 		(plan.ExtendedAttributes != state.ExtendedAttributes) {
 
 		extendedAttributes, err := r.client.GetExtendedAttributes(
+			ctx,
 			projectID,
 			extendedAttributesID,
 		)
@@ -255,6 +256,7 @@ func (r *extendedAttributesResource) Update(
 		extendedAttributes.ExtendedAttributes = json.RawMessage([]byte(attributes))
 
 		_, err = r.client.UpdateExtendedAttributes(
+			ctx,
 			projectID,
 			extendedAttributesID,
 			*extendedAttributes,
@@ -302,6 +304,7 @@ func (r *extendedAttributesResource) Delete(
 	}
 
 	_, err = r.client.DeleteExtendedAttributes(
+		ctx,
 		projectID,
 		extendedAttributesID,
 	)
@@ -106,6 +106,7 @@ func (r *fabricCredentialResource) Create(
 
 	// Create new credential
 	credential, err := r.client.CreateFabricCredential(
+		ctx,
 		projectID,
 		user,
 		password,
@@ -153,7 +154,7 @@ func (r *fabricCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetFabricCredential(projectID, credentialID)
+	credential, err := r.client.GetFabricCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "fabric credential") {
 			return
@@ -250,6 +251,7 @@ func (r *fabricCredentialResource) Update(
 
 	// Update credential
 	_, err = r.client.UpdateFabricCredential(
+		ctx,
 		projectID,
 		credentialID,
 		updateCredential,
@@ -289,6 +291,7 @@ func (r *fabricCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
@@ -345,7 +348,7 @@ func (r *fabricCredentialResource) ImportState(
 	}
 
 	// Get credential details from API
-	credential, err := r.client.GetFabricCredential(projectID, credentialID)
+	credential, err := r.client.GetFabricCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting fabric credential", err.Error())
 		return
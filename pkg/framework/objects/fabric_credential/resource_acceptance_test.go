@@ -1,6 +1,7 @@
 package fabric_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -389,7 +390,7 @@ func testAccCheckDbtCloudFabricCredentialExists(resource string) resource.TestCh
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetFabricCredential(projectId, credentialId)
+		_, err = apiClient.GetFabricCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -415,7 +416,7 @@ func testAccCheckDbtCloudFabricCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetFabricCredential(projectId, credentialId)
+		_, err = apiClient.GetFabricCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Fabric credential still exists")
 		}
@@ -1,6 +1,7 @@
 package global_connection
 
 import (
+	"context"
 	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
@@ -9,6 +10,7 @@ import (
 )
 
 func readGeneric(
+	ctx context.Context,
 	client *dbt_cloud.Client,
 	state *GlobalConnectionResourceModel,
 	adapter string,
@@ -25,9 +27,9 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SnowflakeConfig](client)
 
-		common, snowflakeCfg, err := c.Get(connectionID)
+		common, snowflakeCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -80,9 +82,9 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.BigQueryConfig](client)
 
-		common, bigqueryCfg, adapterVersion, err := c.GetWithAdapterVersion(connectionID)
+		common, bigqueryCfg, adapterVersion, err := c.GetWithAdapterVersion(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -229,9 +231,9 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.DatabricksConfig](client)
 
-		common, databricksCfg, err := c.Get(connectionID)
+		common, databricksCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -270,6 +272,11 @@ func readGeneric(
 		// sensitive fields: ClientID, ClientSecret
 
 	case state.RedshiftConfig != nil || strings.HasPrefix(adapter, "redshift_"):
+		// the SSH tunnel is only read when it is managed in the connection, or
+		// when importing the connection, so that it can be managed with
+		// dbtcloud_connection_ssh_tunnel instead
+		readSSHTunnel := state.RedshiftConfig == nil || state.RedshiftConfig.SSHTunnel != nil
+
 		// in case we use it for a datasource, we need to set the Config to not be nil
 		if state.RedshiftConfig == nil {
 			state.RedshiftConfig = &RedshiftConfig{}
@@ -277,17 +284,20 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.RedshiftConfig](client)
 
-		common, redshiftCfg, err := c.Get(connectionID)
+		common, redshiftCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
 		}
 
-		sshTunnel, err := c.GetEncryptionsForConnection(connectionID)
-		if err != nil {
-			return nil, "", err
+		sshTunnel := &[]dbt_cloud.GlobalConnectionEncryptionPayload{}
+		if readSSHTunnel {
+			sshTunnel, err = c.GetEncryptionsForConnection(ctx, connectionID)
+			if err != nil {
+				return nil, "", err
+			}
 		}
 
 		// global settings
@@ -336,6 +346,11 @@ func readGeneric(
 		// sensitive fields: N/A for Redshift
 
 	case state.PostgresConfig != nil || strings.HasPrefix(adapter, "postgres_"):
+		// the SSH tunnel is only read when it is managed in the connection, or
+		// when importing the connection, so that it can be managed with
+		// dbtcloud_connection_ssh_tunnel instead
+		readSSHTunnel := state.PostgresConfig == nil || state.PostgresConfig.SSHTunnel != nil
+
 		// in case we use it for a datasource, we need to set the Config to not be nil
 		if state.PostgresConfig == nil {
 			state.PostgresConfig = &PostgresConfig{}
@@ -343,17 +358,20 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.PostgresConfig](client)
 
-		common, postgresCfg, err := c.Get(connectionID)
+		common, postgresCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
 		}
 
-		sshTunnel, err := c.GetEncryptionsForConnection(connectionID)
-		if err != nil {
-			return nil, "", err
+		sshTunnel := &[]dbt_cloud.GlobalConnectionEncryptionPayload{}
+		if readSSHTunnel {
+			sshTunnel, err = c.GetEncryptionsForConnection(ctx, connectionID)
+			if err != nil {
+				return nil, "", err
+			}
 		}
 
 		// global settings
@@ -409,9 +427,9 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.FabricConfig](client)
 
-		common, fabricCfg, err := c.Get(connectionID)
+		common, fabricCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -454,9 +472,9 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SynapseConfig](client)
 
-		common, synapseCfg, err := c.Get(connectionID)
+		common, synapseCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -499,9 +517,9 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.StarburstConfig](client)
 
-		common, starburstCfg, err := c.Get(connectionID)
+		common, starburstCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -541,9 +559,9 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.AthenaConfig](client)
 
-		common, athenaCfg, err := c.Get(connectionID)
+		common, athenaCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -636,9 +654,9 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.ApacheSparkConfig](client)
 
-		common, sparkCfg, err := c.Get(connectionID)
+		common, sparkCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -698,9 +716,9 @@ func readGeneric(
 		}
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.TeradataConfig](client)
-		common, teradataCfg, err := c.Get(connectionID)
+		common, teradataCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -725,9 +743,9 @@ func readGeneric(
 		}
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SalesforceConfig](client)
-		common, salesforceCfg, err := c.Get(connectionID)
+		common, salesforceCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			if dbt_cloud.IsNotFound(err) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -35,17 +35,18 @@ func (d *globalConnectionDataSource) Read(
 ) {
 	var state GlobalConnectionResourceModel
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state.GlobalConnectionModel)...)
 
 	connectionID := state.ID.ValueInt64()
 
-	globalConnectionResponse, err := d.client.GetGlobalConnectionAdapter(connectionID)
+	globalConnectionResponse, err := d.client.GetGlobalConnectionAdapter(ctx, connectionID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the connection type", err.Error())
 		return
 	}
 
 	newState, action, err := readGeneric(
+		ctx,
 		d.client,
 		&state,
 		globalConnectionResponse.Data.AdapterVersion,
@@ -64,7 +65,7 @@ func (d *globalConnectionDataSource) Read(
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState.GlobalConnectionModel)...)
 }
 
 func (d *globalConnectionDataSource) Configure(
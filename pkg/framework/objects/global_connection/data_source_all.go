@@ -38,7 +38,7 @@ func (d *globalConnectionsDataSource) Read(
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	apiAllConnections, err := d.client.GetAllConnections()
+	apiAllConnections, err := d.client.GetAllConnections(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue when retrieving connections",
@@ -0,0 +1,67 @@
+package global_connection
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &globalConnectionListResource{}
+	_ list.ListResourceWithConfigure = &globalConnectionListResource{}
+)
+
+func GlobalConnectionListResource() list.ListResource {
+	return &globalConnectionListResource{}
+}
+
+// globalConnectionListResource reuses the global connection resource for
+// Metadata, Configure, ImportState and Read so that listed connections are
+// identical to imported ones.
+type globalConnectionListResource struct {
+	globalConnectionResource
+}
+
+func (r *globalConnectionListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all the global connections of the account.",
+	}
+}
+
+func (r *globalConnectionListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	connections, err := r.client.GetAllConnections(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to list the connections", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = helper.ListResults(
+		ctx,
+		req,
+		&r.globalConnectionResource,
+		connections,
+		func(connection dbt_cloud.GlobalConnectionSummary) helper.ListResultItem {
+			return helper.ListResultItem{
+				DisplayName: connection.Name,
+				ImportID:    strconv.FormatInt(connection.ID, 10),
+				Identity:    GlobalConnectionResourceIdentityModel{ID: types.Int64Value(connection.ID)},
+			}
+		},
+	)
+}
@@ -1,6 +1,7 @@
 package global_connection
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
 )
@@ -132,7 +133,9 @@ var mappingAdapterDetails = map[string]ConfigDetails{
 
 var supportedGlobalConfigTypes = lo.Keys(mappingAdapterDetails)
 
-type GlobalConnectionResourceModel struct {
+// GlobalConnectionModel holds the attributes shared by the resource and the
+// data source
+type GlobalConnectionModel struct {
 	ID                    types.Int64        `tfsdk:"id"`
 	AdapterVersion        types.String       `tfsdk:"adapter_version"`
 	Name                  types.String       `tfsdk:"name"`
@@ -153,6 +156,11 @@ type GlobalConnectionResourceModel struct {
 	SalesforceConfig      *SalesforceConfig  `tfsdk:"salesforce"`
 }
 
+type GlobalConnectionResourceModel struct {
+	GlobalConnectionModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 type SSHTunnelConfig struct {
 	ID        types.Int64  `tfsdk:"id"`
 	Username  types.String `tfsdk:"username"`
@@ -312,3 +320,7 @@ type GlobalConnectionSummary struct {
 	OauthConfigurationID  types.Int64  `tfsdk:"oauth_configuration_id"`
 	EnvironmentCount      types.Int64  `tfsdk:"environment__count"`
 }
+
+type GlobalConnectionResourceIdentityModel struct {
+	ID types.Int64 `tfsdk:"id"`
+}
@@ -21,6 +21,7 @@ var (
 	_ resource.ResourceWithImportState      = &globalConnectionResource{}
 	_ resource.ResourceWithConfigValidators = &globalConnectionResource{}
 	_ resource.ResourceWithModifyPlan       = &globalConnectionResource{}
+	_ resource.ResourceWithIdentity         = &globalConnectionResource{}
 )
 
 func GlobalConnectionResource() resource.Resource {
@@ -124,7 +125,15 @@ func (r *globalConnectionResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	newState, action, err := readGeneric(r.client, &state, "")
+	readTimeout, diags := state.Timeouts.Read(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	newState, action, err := readGeneric(ctx, r.client, &state, "")
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading the connection", err.Error())
 		return
@@ -140,6 +149,7 @@ func (r *globalConnectionResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GlobalConnectionResourceIdentityModel{ID: newState.ID})...)
 
 }
 
@@ -155,6 +165,14 @@ func (r *globalConnectionResource) Create(
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	commonCfg := dbt_cloud.GlobalConnectionCommon{
 		Name: plan.Name.ValueStringPointer(),
 	}
@@ -188,7 +206,7 @@ func (r *globalConnectionResource) Create(
 			snowflakeCfg.Role.Set(plan.SnowflakeConfig.Role.ValueString())
 		}
 
-		commonResp, _, err := c.Create(commonCfg, snowflakeCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, snowflakeCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -282,6 +300,7 @@ func (r *globalConnectionResource) Create(
 		var adapterVersion string
 		if plan.BigQueryConfig.UseLatestAdapter.ValueBool() {
 			payloadData, err := c.CreateWithLatestAdapter(
+				ctx,
 				commonCfg,
 				bigqueryCfg,
 				bigqueryCfg.LatestAdapterVersion(),
@@ -293,7 +312,7 @@ func (r *globalConnectionResource) Create(
 			createdID = *payloadData.GlobalConnectionCommon.ID
 			adapterVersion = *payloadData.AdapterVersion
 		} else {
-			commonResp, _, err := c.Create(commonCfg, bigqueryCfg)
+			commonResp, _, err := c.Create(ctx, commonCfg, bigqueryCfg)
 			if err != nil {
 				resp.Diagnostics.AddError("Error creating the connection", err.Error())
 				return
@@ -313,7 +332,7 @@ func (r *globalConnectionResource) Create(
 		newState.BigQueryConfig.ApplicationSecret = plan.BigQueryConfig.ApplicationSecret
 		newState.AdapterVersion = types.StringValue(adapterVersion)
 
-		readState, action, err := readGeneric(r.client, &newState, adapterVersion)
+		readState, action, err := readGeneric(ctx, r.client, &newState, adapterVersion)
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading the connection after creation", err.Error())
 			return
@@ -335,6 +354,7 @@ func (r *globalConnectionResource) Create(
 		// preserve DeploymentEnvAuthType from plan since API may not return it
 		readState.BigQueryConfig.DeploymentEnvAuthType = plan.BigQueryConfig.DeploymentEnvAuthType
 
+		readState.Timeouts = plan.Timeouts
 		plan = *readState
 
 	case plan.DatabricksConfig != nil:
@@ -357,7 +377,7 @@ func (r *globalConnectionResource) Create(
 			databricksCfg.ClientSecret.Set(plan.DatabricksConfig.ClientSecret.ValueString())
 		}
 
-		commonResp, _, err := c.Create(commonCfg, databricksCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, databricksCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -389,7 +409,7 @@ func (r *globalConnectionResource) Create(
 		}
 		commonCfg.IsSshTunnelEnabled = &sshTunnelEnabled
 
-		commonResp, _, err := c.Create(commonCfg, redshiftCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, redshiftCfg)
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
 			return
@@ -404,7 +424,7 @@ func (r *globalConnectionResource) Create(
 				Port:         plan.RedshiftConfig.SSHTunnel.Port.ValueInt64(),
 				HostName:     plan.RedshiftConfig.SSHTunnel.HostName.ValueString(),
 			}
-			sshTunnel, err := c.CreateUpdateEncryption(sshTunnelPayload)
+			sshTunnel, err := c.CreateUpdateEncryption(ctx, sshTunnelPayload)
 
 			if err != nil {
 				resp.Diagnostics.AddError("Error creating the SSH Tunnel", err.Error())
@@ -442,7 +462,7 @@ func (r *globalConnectionResource) Create(
 			postgresCfg.DBName.SetNull()
 		}
 
-		commonResp, _, err := c.Create(commonCfg, postgresCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, postgresCfg)
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
 			return
@@ -463,7 +483,7 @@ func (r *globalConnectionResource) Create(
 				Port:         plan.PostgresConfig.SSHTunnel.Port.ValueInt64(),
 				HostName:     plan.PostgresConfig.SSHTunnel.HostName.ValueString(),
 			}
-			sshTunnel, err := c.CreateUpdateEncryption(sshTunnelPayload)
+			sshTunnel, err := c.CreateUpdateEncryption(ctx, sshTunnelPayload)
 
 			if err != nil {
 				resp.Diagnostics.AddError("Error creating the SSH Tunnel", err.Error())
@@ -501,7 +521,7 @@ func (r *globalConnectionResource) Create(
 		// nullable fields
 		// N/A for Fabric
 
-		commonResp, _, err := c.Create(commonCfg, fabricCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, fabricCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -530,7 +550,7 @@ func (r *globalConnectionResource) Create(
 		// nullable fields
 		// N/A for Synapse
 
-		commonResp, _, err := c.Create(commonCfg, synapseCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, synapseCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -555,7 +575,7 @@ func (r *globalConnectionResource) Create(
 		// nullable fields
 		// N/A for Starburst
 
-		commonResp, _, err := c.Create(commonCfg, starburstCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, starburstCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -606,7 +626,7 @@ func (r *globalConnectionResource) Create(
 			athenaCfg.NumIcebergRetries.Set(plan.AthenaConfig.NumIcebergRetries.ValueInt64())
 		}
 
-		commonResp, _, err := c.Create(commonCfg, athenaCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, athenaCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -649,7 +669,7 @@ func (r *globalConnectionResource) Create(
 			sparkCfg.Auth.SetNull()
 		}
 
-		commonResp, _, err := c.Create(commonCfg, sparkCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, sparkCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -672,7 +692,7 @@ func (r *globalConnectionResource) Create(
 			RequestTimeout: plan.TeradataConfig.RequestTimeout.ValueInt64Pointer(),
 		}
 
-		commonResp, _, err := c.Create(commonCfg, teradaCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, teradaCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -694,7 +714,7 @@ func (r *globalConnectionResource) Create(
 			DataTransformRunTimeout: plan.SalesforceConfig.DataTransformRunTimeout.ValueInt64Pointer(),
 		}
 
-		commonResp, _, err := c.Create(commonCfg, salesforceCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, salesforceCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -711,6 +731,7 @@ func (r *globalConnectionResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GlobalConnectionResourceIdentityModel{ID: plan.ID})...)
 }
 
 func (r *globalConnectionResource) Delete(
@@ -725,9 +746,17 @@ func (r *globalConnectionResource) Delete(
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	connectionID := state.ID.ValueInt64()
 
-	_, err := r.client.DeleteGlobalConnection(connectionID)
+	_, err := r.client.DeleteGlobalConnection(ctx, connectionID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting the connection", err.Error())
 		return
@@ -753,7 +782,7 @@ func (r *globalConnectionResource) Delete(
 			// we use Redshift here but it is the same function for all
 			// we could change the function to use a generic client rather than a global connection client
 			c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.RedshiftConfig](r.client)
-			_, err := c.CreateUpdateEncryption(sshTunnelPayload)
+			_, err := c.CreateUpdateEncryption(ctx, sshTunnelPayload)
 			if err != nil {
 				resp.Diagnostics.AddError("Error deleting the SSH Tunnel", err.Error())
 				return
@@ -776,6 +805,14 @@ func (r *globalConnectionResource) Update(
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	globalConfigChanges := dbt_cloud.GlobalConnectionCommon{}
 
 	if plan.Name != state.Name {
@@ -853,6 +890,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1040,6 +1078,7 @@ func (r *globalConnectionResource) Update(
 		// layer also blocks adapter version changes upstream (ModifyPlan + the runtime
 		// check below), so v0 and v1 share the same PATCH call here.
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1099,6 +1138,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1142,6 +1182,7 @@ func (r *globalConnectionResource) Update(
 
 		if warehouseConfigChanged || sshTunnelStateChanged {
 			updateCommon, _, err := c.Update(
+				ctx,
 				state.ID.ValueInt64(),
 				globalConfigChanges,
 				warehouseConfigChanges,
@@ -1162,6 +1203,7 @@ func (r *globalConnectionResource) Update(
 
 		// SSH tunnel settings
 		sshTunnel, err := r.handleSSHTunnelUpdates(
+			ctx,
 			plan.RedshiftConfig.SSHTunnel,
 			state.RedshiftConfig.SSHTunnel,
 			int64(r.client.AccountID),
@@ -1203,6 +1245,7 @@ func (r *globalConnectionResource) Update(
 
 		if warehouseConfigChanged || sshTunnelStateChanged {
 			updateCommon, _, err := c.Update(
+				ctx,
 				state.ID.ValueInt64(),
 				globalConfigChanges,
 				warehouseConfigChanges,
@@ -1223,6 +1266,7 @@ func (r *globalConnectionResource) Update(
 
 		// SSH tunnel settings
 		sshTunnel, err := r.handleSSHTunnelUpdates(
+			ctx,
 			plan.PostgresConfig.SSHTunnel,
 			state.PostgresConfig.SSHTunnel,
 			int64(r.client.AccountID),
@@ -1264,6 +1308,7 @@ func (r *globalConnectionResource) Update(
 		// N/A for Fabric
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1307,6 +1352,7 @@ func (r *globalConnectionResource) Update(
 		// N/A for Synapse
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1341,6 +1387,7 @@ func (r *globalConnectionResource) Update(
 		// N/A for Starburst
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1438,6 +1485,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1502,6 +1550,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1543,6 +1592,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1583,6 +1633,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1602,6 +1653,7 @@ func (r *globalConnectionResource) Update(
 
 	// Set the updated state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GlobalConnectionResourceIdentityModel{ID: plan.ID})...)
 
 }
 
@@ -1610,16 +1662,27 @@ func (r *globalConnectionResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	connectionID, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error parsing the connection ID",
-			err.Error(),
-		)
-		return
+	var connectionID int
+	if req.ID == "" {
+		var identity GlobalConnectionResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		connectionID = int(identity.ID.ValueInt64())
+	} else {
+		var err error
+		connectionID, err = strconv.Atoi(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error parsing the connection ID",
+				err.Error(),
+			)
+			return
+		}
 	}
 
-	globalConnectionResponse, err := r.client.GetGlobalConnectionAdapter(int64(connectionID))
+	globalConnectionResponse, err := r.client.GetGlobalConnectionAdapter(ctx, int64(connectionID))
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the connection type", err.Error())
 		return
@@ -1661,6 +1724,7 @@ func (r *globalConnectionResource) Configure(
 }
 
 func (r *globalConnectionResource) handleSSHTunnelUpdates(
+	ctx context.Context,
 	sshTunnelPlan *SSHTunnelConfig,
 	sshTunnelState *SSHTunnelConfig,
 	accountID int64,
@@ -1679,7 +1743,7 @@ func (r *globalConnectionResource) handleSSHTunnelUpdates(
 			HostName:     sshTunnelState.HostName.ValueString(),
 			State:        dbt_cloud.STATE_DELETED,
 		}
-		_, err := c.CreateUpdateEncryption(sshTunnelPayload)
+		_, err := c.CreateUpdateEncryption(ctx, sshTunnelPayload)
 		if err != nil {
 			return nil, err
 		}
@@ -1692,7 +1756,7 @@ func (r *globalConnectionResource) handleSSHTunnelUpdates(
 			Port:         sshTunnelPlan.Port.ValueInt64(),
 			HostName:     sshTunnelPlan.HostName.ValueString(),
 		}
-		sshTunnel, err := c.CreateUpdateEncryption(sshPayload)
+		sshTunnel, err := c.CreateUpdateEncryption(ctx, sshPayload)
 		if err != nil {
 			return nil, err
 		}
@@ -1714,7 +1778,7 @@ func (r *globalConnectionResource) handleSSHTunnelUpdates(
 			Port:         sshTunnelPlan.Port.ValueInt64(),
 			HostName:     sshTunnelPlan.HostName.ValueString(),
 		}
-		sshTunnel, err := c.CreateUpdateEncryption(sshPayload)
+		sshTunnel, err := c.CreateUpdateEncryption(ctx, sshPayload)
 		if err != nil {
 			return nil, err
 		}
@@ -5,11 +5,13 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection/validators"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -22,7 +24,7 @@ import (
 )
 
 func (r *globalConnectionResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -296,7 +298,7 @@ func (r *globalConnectionResource) Schema(
 					// for SSH tunnel details
 					"ssh_tunnel": resource_schema.SingleNestedAttribute{
 						Optional:    true,
-						Description: "Redshift SSH Tunnel configuration",
+						Description: "Redshift SSH Tunnel configuration. Not to be set when the SSH tunnel is managed with `dbtcloud_connection_ssh_tunnel`",
 						Attributes: map[string]resource_schema.Attribute{
 							"username": resource_schema.StringAttribute{
 								Required:    true,
@@ -343,7 +345,7 @@ func (r *globalConnectionResource) Schema(
 					// for SSH tunnel details
 					"ssh_tunnel": resource_schema.SingleNestedAttribute{
 						Optional:    true,
-						Description: "PostgreSQL SSH Tunnel configuration",
+						Description: "PostgreSQL SSH Tunnel configuration. Not to be set when the SSH tunnel is managed with `dbtcloud_connection_ssh_tunnel`",
 						Attributes: map[string]resource_schema.Attribute{
 							"username": resource_schema.StringAttribute{
 								Required:    true,
@@ -644,6 +646,14 @@ func (r *globalConnectionResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]resource_schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -1226,3 +1236,18 @@ func (r *globalConnectionsDataSource) Schema(
 		},
 	}
 }
+
+func (r *globalConnectionResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Connection Identifier",
+			},
+		},
+	}
+}
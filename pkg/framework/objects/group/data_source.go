@@ -41,7 +41,7 @@ func (d *groupDataSource) Read(
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	groupID := data.GroupID.ValueInt64()
-	retrievedGroup, err := d.client.GetGroup(int(groupID))
+	retrievedGroup, err := d.client.GetGroup(ctx, int(groupID))
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "group") {
@@ -42,7 +42,7 @@ func (d *groupsDataSource) Read(
 	nameContains := config.NameContains.ValueString()
 	stateFilter := config.State.ValueString()
 
-	apiGroups, err := d.client.GetAllGroups(name, nameContains, stateFilter)
+	apiGroups, err := d.client.GetAllGroups(ctx, name, nameContains, stateFilter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
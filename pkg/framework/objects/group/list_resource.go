@@ -0,0 +1,83 @@
+package group
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &groupListResource{}
+	_ list.ListResourceWithConfigure = &groupListResource{}
+)
+
+func GroupListResource() list.ListResource {
+	return &groupListResource{}
+}
+
+// groupListResource reuses the group resource for Metadata, Configure,
+// ImportState and Read so that listed groups are identical to imported ones.
+type groupListResource struct {
+	groupResource
+}
+
+func (r *groupListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the active groups of the account.",
+		Attributes: map[string]schema.Attribute{
+			"name_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the groups with a name containing this value",
+			},
+		},
+	}
+}
+
+func (r *groupListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var config GroupListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	groups, err := r.client.GetAllGroups(
+		ctx,
+		"",
+		config.NameContains.ValueString(),
+		strconv.Itoa(dbt_cloud.STATE_ACTIVE),
+	)
+	if err != nil {
+		diags.AddError("Unable to list the groups", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = helper.ListResults(
+		ctx,
+		req,
+		&r.groupResource,
+		groups,
+		func(group dbt_cloud.Group) helper.ListResultItem {
+			return helper.ListResultItem{
+				DisplayName: group.Name,
+				ImportID:    strconv.Itoa(*group.ID),
+				Identity:    GroupResourceIdentityModel{ID: types.Int64Value(int64(*group.ID))},
+			}
+		},
+	)
+}
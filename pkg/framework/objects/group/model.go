@@ -109,3 +109,11 @@ func CompareGroupPermissions(
 		len(diffEnv1) == 0 &&
 		len(diffEnv2) == 0
 }
+
+type GroupResourceIdentityModel struct {
+	ID types.Int64 `tfsdk:"id"`
+}
+
+type GroupListResourceModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+}
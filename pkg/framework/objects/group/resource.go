@@ -15,6 +15,7 @@ var (
 	_ resource.Resource                = &groupResource{}
 	_ resource.ResourceWithConfigure   = &groupResource{}
 	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.ResourceWithIdentity    = &groupResource{}
 )
 
 func GroupResource() resource.Resource {
@@ -43,7 +44,7 @@ func (r *groupResource) Read(
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	groupID := state.ID.ValueInt64()
-	retrievedGroup, err := r.client.GetGroup(int(groupID))
+	retrievedGroup, err := r.client.GetGroup(ctx, int(groupID))
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "group") {
@@ -73,7 +74,7 @@ func (r *groupResource) Read(
 	state.GroupPermissions = remotePermissions
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupResourceIdentityModel{ID: state.ID})...)
 }
 
 func (r *groupResource) Create(
@@ -96,7 +97,7 @@ func (r *groupResource) Create(
 		return
 	}
 
-	createdGroup, err := r.client.CreateGroup(name, assignByDefault, ssoMappingGroups)
+	createdGroup, err := r.client.CreateGroup(ctx, name, assignByDefault, ssoMappingGroups)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create group",
@@ -111,7 +112,7 @@ func (r *groupResource) Create(
 		createdGroup.AccountID,
 	)
 
-	_, err = r.client.UpdateGroupPermissions(*createdGroup.ID, groupPermissions)
+	_, err = r.client.UpdateGroupPermissions(ctx, *createdGroup.ID, groupPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to assign permissions to the group",
@@ -120,7 +121,7 @@ func (r *groupResource) Create(
 
 		// Delete the group if the permissions update fails
 		createdGroup.State = dbt_cloud.STATE_DELETED
-		_, deleteErr := r.client.UpdateGroup(*createdGroup.ID, *createdGroup)
+		_, deleteErr := r.client.UpdateGroup(ctx, *createdGroup.ID, *createdGroup)
 		if deleteErr != nil {
 			resp.Diagnostics.AddError(
 				"Unable to delete group after permissions failure",
@@ -132,6 +133,7 @@ func (r *groupResource) Create(
 
 	plan.ID = types.Int64Value(int64(*createdGroup.ID))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupResourceIdentityModel{ID: plan.ID})...)
 }
 
 func (r *groupResource) Delete(
@@ -147,7 +149,7 @@ func (r *groupResource) Delete(
 	}
 
 	groupID := int(state.ID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Group",
@@ -155,7 +157,7 @@ func (r *groupResource) Delete(
 		)
 	}
 	retrievedGroup.State = dbt_cloud.STATE_DELETED
-	_, err = r.client.UpdateGroup(groupID, *retrievedGroup)
+	_, err = r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete group",
@@ -184,7 +186,7 @@ func (r *groupResource) Update(
 	}
 
 	groupID := int(state.ID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Group",
@@ -217,7 +219,7 @@ func (r *groupResource) Update(
 		retrievedGroup.AssignByDefault = planAssignByDefault
 		retrievedGroup.SSOMappingGroups = planSsoMappingGroups
 
-		_, err = r.client.UpdateGroup(groupID, *retrievedGroup)
+		_, err = r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update group",
@@ -244,7 +246,7 @@ func (r *groupResource) Update(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(groupID, groupPermissions)
+		_, err = r.client.UpdateGroupPermissions(ctx, groupID, groupPermissions)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update group permissions",
@@ -254,6 +256,7 @@ func (r *groupResource) Update(
 		state.GroupPermissions = plan.GroupPermissions
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GroupResourceIdentityModel{ID: plan.ID})...)
 }
 
 func (r *groupResource) ImportState(
@@ -262,12 +265,23 @@ func (r *groupResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 
-	// I think we need this conversion because the ID is a string
-	groupIDStr := req.ID
-	groupID, err := strconv.Atoi(groupIDStr)
-	if err != nil {
-		resp.Diagnostics.AddError("Error parsing group ID for import", err.Error())
-		return
+	var groupID int
+	if req.ID == "" {
+		// importing with the identity of the group
+		var identity GroupResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		groupID = int(identity.ID.ValueInt64())
+	} else {
+		// I think we need this conversion because the ID is a string
+		var err error
+		groupID, err = strconv.Atoi(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error parsing group ID for import", err.Error())
+			return
+		}
 	}
 
 	// and for some arcane reason, we need to initiate the SSO Set to its type
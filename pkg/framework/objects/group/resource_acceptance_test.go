@@ -1,6 +1,7 @@
 package group_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -183,7 +184,7 @@ func testAccCheckDbtCloudGroupExists(resource string) resource.TestCheckFunc {
 		if err != nil {
 			return fmt.Errorf("Can't get groupID")
 		}
-		_, err = apiClient.GetGroup(groupID)
+		_, err = apiClient.GetGroup(context.Background(), groupID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -205,7 +206,7 @@ func testAccCheckDbtCloudGroupDestroy(s *terraform.State) error {
 		if err != nil {
 			return fmt.Errorf("Can't get groupID")
 		}
-		_, err = apiClient.GetGroup(groupID)
+		_, err = apiClient.GetGroup(context.Background(), groupID)
 		if err == nil {
 			return fmt.Errorf("Group still exists")
 		}
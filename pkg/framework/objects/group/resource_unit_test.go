@@ -0,0 +1,64 @@
+package group_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestGroupResourceFakeAPI runs the create, update, import and delete cycle of
+// the group resource against the in-memory fake of the dbt Cloud API.
+func TestGroupResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if groups := server.List(fakeapi.Groups); len(groups) != 0 {
+				return fmt.Errorf("expected the group to be deleted, got %v", groups)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testGroupResourceFakeAPIConfig("analysts", "member"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_group.test", "name", "analysts"),
+					resource.TestCheckResourceAttr("dbtcloud_group.test", "group_permissions.#", "1"),
+					resource.TestCheckResourceAttr("dbtcloud_group.test", "group_permissions.0.permission_set", "member"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testGroupResourceFakeAPIConfig("analytics engineers", "analyst"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_group.test", "name", "analytics engineers"),
+					resource.TestCheckResourceAttr("dbtcloud_group.test", "group_permissions.0.permission_set", "analyst"),
+				),
+			},
+			{
+				ResourceName:      "dbtcloud_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGroupResourceFakeAPIConfig(name, permissionSet string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_group" "test" {
+  name               = %q
+  assign_by_default  = false
+  sso_mapping_groups = ["sso-group"]
+
+  group_permissions {
+    permission_set = %q
+    all_projects   = true
+  }
+}
+`, name, permissionSet)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -99,6 +100,9 @@ func (r *groupResource) Schema(
 			"group_permissions": resource_schema.SetNestedBlock{
 				Description: "The complete set of permissions to apply to the group. Each block defines one permission set; remove or modify blocks to adjust the group's permissions.",
 				NestedObject: resource_schema.NestedBlockObject{
+					Validators: []validator.Object{
+						helper.PermissionSetValidator(),
+					},
 					Attributes: map[string]resource_schema.Attribute{
 						"permission_set": resource_schema.StringAttribute{
 							Required: true,
@@ -246,3 +250,18 @@ var groupsDataSourceSchema = datasource_schema.Schema{
 		},
 	},
 }
+
+func (r *groupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "The ID of the group",
+			},
+		},
+	}
+}
@@ -45,7 +45,7 @@ func (r *groupPartialPermissionsResource) Read(
 
 	// check if the ID exists
 	groupIDFromState := state.ID.ValueInt64()
-	retrievedGroup, err := r.client.GetGroup(int(groupIDFromState))
+	retrievedGroup, err := r.client.GetGroup(ctx, int(groupIDFromState))
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "group") {
 			return
@@ -60,7 +60,14 @@ func (r *groupPartialPermissionsResource) Read(
 	// if the ID exists, make sure that it is the one we are looking for
 	if retrievedGroup.Name != state.Name.ValueString() {
 		// it doesn't match, we need to find the correct one
-		groupIDs := r.client.GetAllGroupIDsByName(state.Name.ValueString())
+		groupIDs, err := r.client.GetAllGroupIDsByName(ctx, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Issue getting Groups",
+				"Error: "+err.Error(),
+			)
+			return
+		}
 		if len(groupIDs) > 1 {
 			resp.Diagnostics.AddError(
 				"More than one group with the same name",
@@ -78,7 +85,7 @@ func (r *groupPartialPermissionsResource) Read(
 		}
 
 		groupID := groupIDs[0]
-		retrievedGroup, err = r.client.GetGroup(groupID)
+		retrievedGroup, err = r.client.GetGroup(ctx, groupID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Issue getting Group",
@@ -147,7 +154,14 @@ func (r *groupPartialPermissionsResource) Create(
 	}
 
 	// check if it exists and if there is only one with the given name
-	groupIDs := r.client.GetAllGroupIDsByName(name)
+	groupIDs, err := r.client.GetAllGroupIDsByName(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Groups",
+			"Error: "+err.Error(),
+		)
+		return
+	}
 	if len(groupIDs) > 1 {
 		resp.Diagnostics.AddError(
 			"More than one group with the same name",
@@ -162,7 +176,7 @@ func (r *groupPartialPermissionsResource) Create(
 		//   B. add the permission needed for the partial field
 		groupID := groupIDs[0]
 
-		retrievedGroup, err := r.client.GetGroup(groupID)
+		retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Issue getting Group",
@@ -180,7 +194,7 @@ func (r *groupPartialPermissionsResource) Create(
 			retrievedGroup.AssignByDefault = assignByDefault
 			retrievedGroup.SSOMappingGroups = ssoMappingGroups
 
-			r.client.UpdateGroup(groupID, *retrievedGroup)
+			r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 		}
 
 		// B. add the permissions that are missing
@@ -215,7 +229,7 @@ func (r *groupPartialPermissionsResource) Create(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(*retrievedGroup.ID, allPermissionsRequest)
+		_, err = r.client.UpdateGroupPermissions(ctx, *retrievedGroup.ID, allPermissionsRequest)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to assign permissions to the group",
@@ -228,7 +242,7 @@ func (r *groupPartialPermissionsResource) Create(
 
 	} else {
 		// if the group with the name given doesn't exist , create it
-		createdGroup, err := r.client.CreateGroup(name, assignByDefault, ssoMappingGroups)
+		createdGroup, err := r.client.CreateGroup(ctx, name, assignByDefault, ssoMappingGroups)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to create group",
@@ -239,7 +253,7 @@ func (r *groupPartialPermissionsResource) Create(
 
 		groupPermissions := group.ConvertGroupPermissionModelToData(plan.GroupPermissions, *createdGroup.ID, createdGroup.AccountID)
 
-		_, err = r.client.UpdateGroupPermissions(*createdGroup.ID, groupPermissions)
+		_, err = r.client.UpdateGroupPermissions(ctx, *createdGroup.ID, groupPermissions)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to assign permissions to the group",
@@ -265,7 +279,7 @@ func (r *groupPartialPermissionsResource) Delete(
 	}
 
 	groupID := int(state.ID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Group",
@@ -294,7 +308,7 @@ func (r *groupPartialPermissionsResource) Delete(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(groupID, allPermissionsRequest)
+		_, err = r.client.UpdateGroupPermissions(ctx, groupID, allPermissionsRequest)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to assign permissions to the group",
@@ -306,7 +320,7 @@ func (r *groupPartialPermissionsResource) Delete(
 	} else {
 		// otherwise, we delete the group entirely if there is no permission
 		retrievedGroup.State = dbt_cloud.STATE_DELETED
-		_, err = r.client.UpdateGroup(groupID, *retrievedGroup)
+		_, err = r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to delete group",
@@ -330,7 +344,7 @@ func (r *groupPartialPermissionsResource) Update(
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	groupID := int(state.ID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Group",
@@ -363,7 +377,7 @@ func (r *groupPartialPermissionsResource) Update(
 		retrievedGroup.AssignByDefault = planAssignByDefault
 		retrievedGroup.SSOMappingGroups = planSsoMappingGroups
 
-		_, err = r.client.UpdateGroup(groupID, *retrievedGroup)
+		_, err = r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update group",
@@ -418,7 +432,7 @@ func (r *groupPartialPermissionsResource) Update(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(groupID, allPermissionsRequest)
+		_, err = r.client.UpdateGroupPermissions(ctx, groupID, allPermissionsRequest)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to assign permissions to the group",
@@ -75,6 +75,9 @@ func (r *groupPartialPermissionsResource) Schema(
 			"group_permissions": schema.SetNestedAttribute{
 				Description: "Partial permissions for the group. Those permissions will be added/removed when config is added/removed.",
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						helper.PermissionSetValidator(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"permission_set": schema.StringAttribute{
 							Required: true,
@@ -83,7 +83,7 @@ func (d *groupUsersDataSource) Read(
 
 	groupID := int(state.GroupID.ValueInt64())
 
-	users, err := d.client.GetUsers()
+	users, err := d.client.GetUsers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading users",
@@ -98,7 +98,7 @@ func (d *groupUsersDataSource) Read(
 
 		userInGroup := false
 		for _, userGroup := range userGroups {
-			if userGroup.ID == groupID {
+			if userGroup.ID != nil && *userGroup.ID == groupID {
 				userInGroup = true
 				// we can stop looping
 				break
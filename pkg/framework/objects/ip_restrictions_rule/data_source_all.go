@@ -0,0 +1,152 @@
+package ip_restrictions_rule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ipRestrictionsRulesDataSourceAll{}
+	_ datasource.DataSourceWithConfigure = &ipRestrictionsRulesDataSourceAll{}
+)
+
+func IPRestrictionsRulesDataSourceAll() datasource.DataSource {
+	return &ipRestrictionsRulesDataSourceAll{}
+}
+
+type ipRestrictionsRulesDataSourceAll struct {
+	client *dbt_cloud.Client
+}
+
+func (d *ipRestrictionsRulesDataSourceAll) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ipRestrictionsRulesDataSourceAll) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_ip_restrictions_rules"
+}
+
+func (d *ipRestrictionsRulesDataSourceAll) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve all the IP restriction rules of the dbt Cloud account, including the ones not managed by Terraform.",
+		Attributes: map[string]datasource_schema.Attribute{
+			"rules": datasource_schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "A list of all the IP restriction rules in the account",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the IP restriction rule",
+						},
+						"name": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the IP restriction rule",
+						},
+						"type": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the IP restriction rule (allow or deny)",
+						},
+						"description": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "A description of the IP restriction rule",
+						},
+						"rule_set_enabled": datasource_schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the IP restriction rule set is enabled or not",
+						},
+						"cidrs": datasource_schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The CIDR ranges of the rule",
+							NestedObject: datasource_schema.NestedAttributeObject{
+								Attributes: map[string]datasource_schema.Attribute{
+									"id": datasource_schema.Int64Attribute{
+										Computed:    true,
+										Description: "ID of the CIDR range",
+									},
+									"cidr": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "IP CIDR range (can be IPv4 or IPv6)",
+									},
+									"cidr_ipv6": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "IPv6 CIDR range",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ipRestrictionsRulesDataSourceAll) Read(
+	ctx context.Context,
+	_ datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	ipRestrictions, err := d.client.GetIPRestrictions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading IP Restrictions Rules",
+			"Could not read the IP Restrictions Rules: "+err.Error(),
+		)
+		return
+	}
+
+	state := IPRestrictionsRulesDataSourceModel{
+		Rules: make([]IPRestrictionsRuleDataSourceModel, 0, len(*ipRestrictions)),
+	}
+	for _, rule := range *ipRestrictions {
+		currentRule := IPRestrictionsRuleDataSourceModel{
+			ID:             types.Int64Value(rule.ID),
+			Name:           types.StringValue(rule.Name),
+			Type:           types.StringValue(ipRestrictionTypeIDToNameMapping[rule.Type]),
+			Description:    types.StringValue(rule.Description),
+			RuleSetEnabled: types.BoolValue(rule.RuleSetEnabled),
+			Cidrs:          make([]CidrDataSourceModel, 0, len(rule.Cidrs)),
+		}
+		for _, cidr := range rule.Cidrs {
+			currentRule.Cidrs = append(currentRule.Cidrs, CidrDataSourceModel{
+				ID:       types.Int64Value(cidr.ID),
+				Cidr:     types.StringValue(cidr.Cidr),
+				CidrIpv6: types.StringValue(cidr.CidrIpv6),
+			})
+		}
+		state.Rules = append(state.Rules, currentRule)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
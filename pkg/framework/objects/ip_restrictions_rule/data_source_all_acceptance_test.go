@@ -0,0 +1,40 @@
+package ip_restrictions_rule_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIPRestrictionsRulesDataSource(t *testing.T) {
+	mockServer := testIPRestrictionsMockServer(t)
+	defer mockServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "dbtcloud" {
+  host_url   = "%s"
+  token      = "test-token"
+  account_id = 123
+}
+
+data "dbtcloud_ip_restrictions_rules" "test" {}
+`, mockServer.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dbtcloud_ip_restrictions_rules.test", "rules.#", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ip_restrictions_rules.test", "rules.0.name", "office"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ip_restrictions_rules.test", "rules.0.type", "allow"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ip_restrictions_rules.test", "rules.0.cidrs.#", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ip_restrictions_rules.test", "rules.0.cidrs.1.cidr_ipv6", "2001:db8::/32"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ip_restrictions_rules.test", "rules.1.type", "deny"),
+					resource.TestCheckResourceAttr("data.dbtcloud_ip_restrictions_rules.test", "rules.1.cidrs.0.id", "21"),
+				),
+			},
+		},
+	})
+}
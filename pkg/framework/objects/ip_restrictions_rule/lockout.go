@@ -0,0 +1,216 @@
+package ip_restrictions_rule
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	lockoutCheckError = "error"
+	lockoutCheckWarn  = "warn"
+	lockoutCheckOff   = "off"
+)
+
+// egressIPURL returns the public IP address the request is sent from, it is a
+// variable so that the tests can replace it
+var egressIPURL = "https://checkip.amazonaws.com"
+
+// detectEgressIP returns the public IP address Terraform reaches the internet from
+func detectEgressIP(ctx context.Context) (netip.Addr, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, egressIPURL, nil)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return netip.Addr{}, fmt.Errorf("%s returned the status %s", egressIPURL, res.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, 256))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return netip.ParseAddr(strings.TrimSpace(string(body)))
+}
+
+// lockoutReason returns why the IP address would be blocked once the rules are
+// enabled, or "" when it would still be allowed. Deny rules take precedence and
+// as soon as there is an allow rule, only the IPs listed in allow rules are
+// allowed.
+func lockoutReason(ip netip.Addr, rules []dbt_cloud.IPRestrictionsRule) string {
+	for _, rule := range rules {
+		if ipRestrictionTypeIDToNameMapping[rule.Type] == "deny" && ruleContains(rule, ip) {
+			return fmt.Sprintf("%s is denied by the rule %q", ip, rule.Name)
+		}
+	}
+
+	var allowRules []string
+	for _, rule := range rules {
+		if ipRestrictionTypeIDToNameMapping[rule.Type] != "allow" {
+			continue
+		}
+		if ruleContains(rule, ip) {
+			return ""
+		}
+		allowRules = append(allowRules, fmt.Sprintf("%q", rule.Name))
+	}
+
+	if len(allowRules) > 0 {
+		return fmt.Sprintf(
+			"%s is not part of any of the allow rules %s",
+			ip,
+			strings.Join(allowRules, ", "),
+		)
+	}
+	return ""
+}
+
+// ruleContains reports whether one of the CIDR ranges of the rule contains the IP address
+func ruleContains(rule dbt_cloud.IPRestrictionsRule, ip netip.Addr) bool {
+	for _, cidr := range rule.Cidrs {
+		for _, value := range []string{cidr.Cidr, cidr.CidrIpv6} {
+			prefix, ok := parseCidr(value)
+			if ok && (prefix.Contains(ip) || prefix.Contains(ip.Unmap())) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseCidr parses a CIDR range, a single IP address being a range of its own
+func parseCidr(value string) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		return prefix.Masked(), true
+	}
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), true
+	}
+	return netip.Prefix{}, false
+}
+
+// plannedRules returns the rules of the account once the planned rule is applied
+func plannedRules(
+	existing []dbt_cloud.IPRestrictionsRule,
+	planned dbt_cloud.IPRestrictionsRule,
+) []dbt_cloud.IPRestrictionsRule {
+	rules := make([]dbt_cloud.IPRestrictionsRule, 0, len(existing)+1)
+	for _, rule := range existing {
+		if planned.ID != 0 && rule.ID == planned.ID {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return append(rules, planned)
+}
+
+// needsLockoutCheck reports whether the plan enables the IP restrictions or
+// changes the IP addresses they allow while they are enabled
+func needsLockoutCheck(plan IPRestrictionsRuleResourceModel, state *IPRestrictionsRuleResourceModel) bool {
+	if !plan.RuleSetEnabled.ValueBool() || plan.LockoutCheck.ValueString() == lockoutCheckOff {
+		return false
+	}
+	if state == nil || !state.RuleSetEnabled.ValueBool() || !plan.Type.Equal(state.Type) {
+		return true
+	}
+	return !slices.Equal(sortedCidrs(plan.Cidrs), sortedCidrs(state.Cidrs))
+}
+
+// cidrsKnown reports whether all the CIDR ranges are known, the IP addresses
+// they contain can't be checked otherwise
+func cidrsKnown(cidrs []CidrModel) bool {
+	for _, cidr := range cidrs {
+		if cidr.Cidr.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedCidrs(cidrs []CidrModel) []string {
+	values := make([]string, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		values = append(values, cidr.Cidr.String())
+	}
+	slices.Sort(values)
+	return values
+}
+
+// checkLockout adds a diagnostic when enabling the planned rule would block the
+// IP address Terraform reaches dbt Cloud from
+func (r *ipRestrictionsRuleResource) checkLockout(
+	ctx context.Context,
+	plan IPRestrictionsRuleResourceModel,
+	diags *diag.Diagnostics,
+) {
+	if r.client == nil {
+		return
+	}
+
+	ip, err := r.egressIP(ctx)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("rule_set_enabled"),
+			"Could not check the IP restrictions for a lockout",
+			"The IP address Terraform reaches dbt Cloud from could not be detected: "+err.Error()+
+				". Set it in the provider `egress_ip` to check that enabling the IP restrictions doesn't block Terraform, or set `lockout_check` to \"off\".",
+		)
+		return
+	}
+
+	existing, err := r.client.GetIPRestrictions(ctx)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("rule_set_enabled"),
+			"Could not check the IP restrictions for a lockout",
+			"The existing IP restriction rules could not be read: "+err.Error(),
+		)
+		return
+	}
+
+	reason := lockoutReason(ip, plannedRules(*existing, ruleFromModel(plan)))
+	if reason == "" {
+		return
+	}
+
+	addDiagnostic := diags.AddAttributeError
+	if plan.LockoutCheck.ValueString() == lockoutCheckWarn {
+		addDiagnostic = diags.AddAttributeWarning
+	}
+	addDiagnostic(
+		path.Root("rule_set_enabled"),
+		"Enabling the IP restrictions would lock Terraform out",
+		fmt.Sprintf(
+			"Once the IP restrictions are enabled, %s and the requests Terraform sends to dbt Cloud would be rejected. "+
+				"Add a CIDR range containing %s to an allow rule, set the provider `egress_ip` if it is not the IP address Terraform reaches dbt Cloud from, "+
+				"or set `lockout_check` to \"warn\" or \"off\" to proceed anyway.",
+			reason,
+			ip,
+		),
+	)
+}
+
+// egressIP returns the IP address set in the provider config, or detects it
+func (r *ipRestrictionsRuleResource) egressIP(ctx context.Context) (netip.Addr, error) {
+	if r.client.EgressIP != "" {
+		return netip.ParseAddr(r.client.EgressIP)
+	}
+	return detectEgressIP(ctx)
+}
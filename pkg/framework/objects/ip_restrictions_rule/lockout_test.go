@@ -0,0 +1,191 @@
+package ip_restrictions_rule
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRule(id int64, name, ruleType string, cidrs ...string) dbt_cloud.IPRestrictionsRule {
+	rule := dbt_cloud.IPRestrictionsRule{
+		ID:   id,
+		Name: name,
+		Type: ipRestrictionTypeNameToIDMapping[ruleType],
+	}
+	for _, cidr := range cidrs {
+		rule.Cidrs = append(rule.Cidrs, dbt_cloud.Cidrs{Cidr: cidr})
+	}
+	return rule
+}
+
+func TestLockoutReason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		ip          string
+		rules       []dbt_cloud.IPRestrictionsRule
+		wantBlocked string
+	}{
+		{
+			name: "no rules",
+			ip:   "203.0.113.10",
+		},
+		{
+			name:  "in an allow rule",
+			ip:    "203.0.113.10",
+			rules: []dbt_cloud.IPRestrictionsRule{testRule(1, "office", "allow", "10.0.0.0/8", "203.0.113.0/24")},
+		},
+		{
+			name:  "single IP in an allow rule",
+			ip:    "203.0.113.10",
+			rules: []dbt_cloud.IPRestrictionsRule{testRule(1, "runner", "allow", "203.0.113.10")},
+		},
+		{
+			name: "not in any allow rule",
+			ip:   "203.0.113.10",
+			rules: []dbt_cloud.IPRestrictionsRule{
+				testRule(1, "office", "allow", "10.0.0.0/8"),
+				testRule(2, "vpn", "allow", "192.168.0.0/16"),
+			},
+			wantBlocked: `not part of any of the allow rules "office", "vpn"`,
+		},
+		{
+			name: "deny takes precedence",
+			ip:   "203.0.113.10",
+			rules: []dbt_cloud.IPRestrictionsRule{
+				testRule(1, "everything", "allow", "0.0.0.0/0"),
+				testRule(2, "blocked", "deny", "203.0.113.0/28"),
+			},
+			wantBlocked: `denied by the rule "blocked"`,
+		},
+		{
+			name:  "only deny rules",
+			ip:    "203.0.113.10",
+			rules: []dbt_cloud.IPRestrictionsRule{testRule(1, "blocked", "deny", "198.51.100.0/24")},
+		},
+		{
+			name:  "IPv6",
+			ip:    "2001:db8::1",
+			rules: []dbt_cloud.IPRestrictionsRule{testRule(1, "office", "allow", "2001:db8::/32")},
+		},
+		{
+			name:  "IPv4-mapped IPv6",
+			ip:    "::ffff:203.0.113.10",
+			rules: []dbt_cloud.IPRestrictionsRule{testRule(1, "office", "allow", "203.0.113.0/24")},
+		},
+		{
+			name:        "invalid CIDR ignored",
+			ip:          "203.0.113.10",
+			rules:       []dbt_cloud.IPRestrictionsRule{testRule(1, "office", "allow", "not-a-cidr")},
+			wantBlocked: `not part of any of the allow rules "office"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := lockoutReason(netip.MustParseAddr(tt.ip), tt.rules)
+			if tt.wantBlocked == "" && got != "" {
+				t.Errorf("lockoutReason() = %q, want the IP to be allowed", got)
+			}
+			if !strings.Contains(got, tt.wantBlocked) {
+				t.Errorf("lockoutReason() = %q, want it to contain %q", got, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestPlannedRules(t *testing.T) {
+	t.Parallel()
+
+	existing := []dbt_cloud.IPRestrictionsRule{
+		testRule(1, "office", "allow", "10.0.0.0/8"),
+		testRule(2, "vpn", "allow", "192.168.0.0/16"),
+	}
+
+	updated := plannedRules(existing, testRule(2, "vpn", "allow", "203.0.113.0/24"))
+	if len(updated) != 2 || updated[1].Cidrs[0].Cidr != "203.0.113.0/24" {
+		t.Errorf("expected the rule 2 to be replaced, got %v", updated)
+	}
+
+	created := plannedRules(existing, testRule(0, "new", "deny", "203.0.113.0/24"))
+	if len(created) != 3 {
+		t.Errorf("expected the new rule to be added, got %v", created)
+	}
+}
+
+func TestKeepCidrIDs(t *testing.T) {
+	t.Parallel()
+
+	existing := []CidrModel{
+		{
+			Cidr:                types.StringValue("10.0.0.0/24"),
+			CidrIpv6:            types.StringValue(""),
+			ID:                  types.Int64Value(11),
+			IPRestrictionRuleID: types.Int64Value(1),
+		},
+		{
+			Cidr:                types.StringValue(""),
+			CidrIpv6:            types.StringValue("2001:db8::/32"),
+			ID:                  types.Int64Value(12),
+			IPRestrictionRuleID: types.Int64Value(1),
+		},
+	}
+	planned := []CidrModel{
+		{
+			Cidr:                types.StringValue("10.0.0.0/24"),
+			CidrIpv6:            types.StringUnknown(),
+			ID:                  types.Int64Unknown(),
+			IPRestrictionRuleID: types.Int64Unknown(),
+		},
+		{
+			Cidr:                types.StringValue("2001:db8::/32"),
+			CidrIpv6:            types.StringUnknown(),
+			ID:                  types.Int64Unknown(),
+			IPRestrictionRuleID: types.Int64Unknown(),
+		},
+		{
+			Cidr:                types.StringValue("192.168.1.0/24"),
+			CidrIpv6:            types.StringUnknown(),
+			ID:                  types.Int64Unknown(),
+			IPRestrictionRuleID: types.Int64Unknown(),
+		},
+	}
+
+	keepCidrIDs(planned, existing)
+
+	if planned[0].ID.ValueInt64() != 11 || planned[1].ID.ValueInt64() != 12 {
+		t.Errorf("expected the existing CIDR ranges to keep their IDs, got %v", planned)
+	}
+	if !planned[2].ID.IsUnknown() {
+		t.Errorf("expected the new CIDR range to have an unknown ID, got %s", planned[2].ID)
+	}
+}
+
+func TestDetectEgressIP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintln(w, "203.0.113.10")
+	}))
+	defer server.Close()
+
+	originalURL := egressIPURL
+	egressIPURL = server.URL
+	defer func() { egressIPURL = originalURL }()
+
+	ip, err := detectEgressIP(context.Background())
+	if err != nil {
+		t.Fatalf("detectEgressIP() error = %v", err)
+	}
+	if ip != netip.MustParseAddr("203.0.113.10") {
+		t.Errorf("detectEgressIP() = %s, want 203.0.113.10", ip)
+	}
+}
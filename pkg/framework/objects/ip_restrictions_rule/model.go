@@ -11,6 +11,7 @@ type IPRestrictionsRuleResourceModel struct {
 	Type           types.String `tfsdk:"type"`
 	Description    types.String `tfsdk:"description"`
 	RuleSetEnabled types.Bool   `tfsdk:"rule_set_enabled"`
+	LockoutCheck   types.String `tfsdk:"lockout_check"`
 	Cidrs          []CidrModel  `tfsdk:"cidrs"`
 }
 
@@ -27,3 +28,22 @@ var ipRestrictionTypeNameToIDMapping = map[string]int64{
 }
 
 var ipRestrictionTypeIDToNameMapping = lo.Invert(ipRestrictionTypeNameToIDMapping)
+
+type IPRestrictionsRulesDataSourceModel struct {
+	Rules []IPRestrictionsRuleDataSourceModel `tfsdk:"rules"`
+}
+
+type IPRestrictionsRuleDataSourceModel struct {
+	ID             types.Int64           `tfsdk:"id"`
+	Name           types.String          `tfsdk:"name"`
+	Type           types.String          `tfsdk:"type"`
+	Description    types.String          `tfsdk:"description"`
+	RuleSetEnabled types.Bool            `tfsdk:"rule_set_enabled"`
+	Cidrs          []CidrDataSourceModel `tfsdk:"cidrs"`
+}
+
+type CidrDataSourceModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Cidr     types.String `tfsdk:"cidr"`
+	CidrIpv6 types.String `tfsdk:"cidr_ipv6"`
+}
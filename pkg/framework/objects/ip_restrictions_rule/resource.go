@@ -16,6 +16,7 @@ var (
 	_ resource.Resource                = &ipRestrictionsRuleResource{}
 	_ resource.ResourceWithConfigure   = &ipRestrictionsRuleResource{}
 	_ resource.ResourceWithImportState = &ipRestrictionsRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &ipRestrictionsRuleResource{}
 )
 
 func IPRestrictionsRuleResource() resource.Resource {
@@ -45,6 +46,45 @@ func (r *ipRestrictionsRuleResource) Configure(
 	r.client = req.ProviderData.(*dbt_cloud.Client)
 }
 
+func (r *ipRestrictionsRuleResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// the CIDR ranges can come from other resources, they can't be decoded in
+	// the model, nor checked, until they are known
+	var cidrs types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cidrs"), &cidrs)...)
+	if resp.Diagnostics.HasError() || cidrs.IsUnknown() {
+		return
+	}
+
+	var plan IPRestrictionsRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *IPRestrictionsRuleResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &IPRestrictionsRuleResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		keepCidrIDs(plan.Cidrs, state.Cidrs)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cidrs"), plan.Cidrs)...)
+	}
+
+	if needsLockoutCheck(plan, state) && cidrsKnown(plan.Cidrs) {
+		r.checkLockout(ctx, plan, &resp.Diagnostics)
+	}
+}
+
 func (r *ipRestrictionsRuleResource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
@@ -57,7 +97,7 @@ func (r *ipRestrictionsRuleResource) Read(
 		return
 	}
 
-	rule, err := r.client.GetIPRestrictionsRule(state.ID.ValueInt64())
+	rule, err := r.client.GetIPRestrictionsRule(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading IP Restrictions Rule",
@@ -75,6 +115,9 @@ func (r *ipRestrictionsRuleResource) Read(
 	state.Type = types.StringValue(ipRestrictionTypeIDToNameMapping[rule.Type])
 	state.Description = types.StringValue(rule.Description)
 	state.RuleSetEnabled = types.BoolValue(rule.RuleSetEnabled)
+	if state.LockoutCheck.IsNull() {
+		state.LockoutCheck = types.StringValue(lockoutCheckError)
+	}
 
 	state.Cidrs = make([]CidrModel, 0, len(rule.Cidrs))
 	for _, cidr := range rule.Cidrs {
@@ -101,24 +144,9 @@ func (r *ipRestrictionsRuleResource) Create(
 		return
 	}
 
-	ipRestriction := dbt_cloud.IPRestrictionsRule{
-		Name:           plan.Name.ValueString(),
-		Type:           ipRestrictionTypeNameToIDMapping[plan.Type.ValueString()],
-		Description:    plan.Description.ValueString(),
-		RuleSetEnabled: plan.RuleSetEnabled.ValueBool(),
-		Cidrs:          make([]dbt_cloud.Cidrs, 0, len(plan.Cidrs)),
-	}
+	ipRestriction := ruleFromModel(plan)
 
-	for _, cidr := range plan.Cidrs {
-		ipRestriction.Cidrs = append(ipRestriction.Cidrs, dbt_cloud.Cidrs{
-			Cidr:                cidr.Cidr.ValueString(),
-			CidrIpv6:            cidr.CidrIpv6.ValueString(),
-			ID:                  cidr.ID.ValueInt64(),
-			IPRestrictionRuleID: cidr.IPRestrictionRuleID.ValueInt64(),
-		})
-	}
-
-	created, err := r.client.CreateIPRestrictionsRule(ipRestriction)
+	created, err := r.client.CreateIPRestrictionsRule(ctx, ipRestriction)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating IP Restrictions Rule",
@@ -162,23 +190,7 @@ func (r *ipRestrictionsRuleResource) Update(
 		return
 	}
 
-	ipRestrictionsRule := dbt_cloud.IPRestrictionsRule{
-		ID:             plan.ID.ValueInt64(),
-		Name:           plan.Name.ValueString(),
-		Type:           ipRestrictionTypeNameToIDMapping[plan.Type.ValueString()],
-		Description:    plan.Description.ValueString(),
-		RuleSetEnabled: plan.RuleSetEnabled.ValueBool(),
-		Cidrs:          []dbt_cloud.Cidrs{},
-	}
-
-	for _, cidr := range plan.Cidrs {
-		ipRestrictionsRule.Cidrs = append(ipRestrictionsRule.Cidrs, dbt_cloud.Cidrs{
-			Cidr:                cidr.Cidr.ValueString(),
-			CidrIpv6:            cidr.CidrIpv6.ValueString(),
-			ID:                  cidr.ID.ValueInt64(),
-			IPRestrictionRuleID: cidr.IPRestrictionRuleID.ValueInt64(),
-		})
-	}
+	ipRestrictionsRule := ruleFromModel(plan)
 
 	for _, cidr := range state.Cidrs {
 		foundInPlan := lo.Filter(plan.Cidrs, func(c CidrModel, _ int) bool {
@@ -198,6 +210,7 @@ func (r *ipRestrictionsRuleResource) Update(
 	}
 
 	created, err := r.client.UpdateIPRestrictionsRule(
+		ctx,
 		strconv.FormatInt(plan.ID.ValueInt64(), 10),
 		ipRestrictionsRule,
 	)
@@ -235,7 +248,7 @@ func (r *ipRestrictionsRuleResource) Delete(
 		return
 	}
 
-	err := r.client.DeleteIPRestrictionsRule(state.ID.ValueInt64())
+	err := r.client.DeleteIPRestrictionsRule(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting IP Restrictions Rule",
@@ -261,3 +274,44 @@ func (r *ipRestrictionsRuleResource) ImportState(
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// ruleFromModel returns the rule to send to the API, without the CIDR ranges to delete
+func ruleFromModel(plan IPRestrictionsRuleResourceModel) dbt_cloud.IPRestrictionsRule {
+	rule := dbt_cloud.IPRestrictionsRule{
+		ID:             plan.ID.ValueInt64(),
+		Name:           plan.Name.ValueString(),
+		Type:           ipRestrictionTypeNameToIDMapping[plan.Type.ValueString()],
+		Description:    plan.Description.ValueString(),
+		RuleSetEnabled: plan.RuleSetEnabled.ValueBool(),
+		Cidrs:          make([]dbt_cloud.Cidrs, 0, len(plan.Cidrs)),
+	}
+
+	for _, cidr := range plan.Cidrs {
+		rule.Cidrs = append(rule.Cidrs, dbt_cloud.Cidrs{
+			Cidr:                cidr.Cidr.ValueString(),
+			CidrIpv6:            cidr.CidrIpv6.ValueString(),
+			ID:                  cidr.ID.ValueInt64(),
+			IPRestrictionRuleID: cidr.IPRestrictionRuleID.ValueInt64(),
+		})
+	}
+	return rule
+}
+
+// keepCidrIDs sets the IDs of the planned CIDR ranges which already exist, so
+// that they are updated in place and only the added or removed ones show in
+// the plan
+func keepCidrIDs(planned []CidrModel, existing []CidrModel) {
+	for i, cidr := range planned {
+		if cidr.Cidr.IsNull() || cidr.Cidr.IsUnknown() {
+			continue
+		}
+		for _, existingCidr := range existing {
+			if cidr.Cidr.Equal(existingCidr.Cidr) || cidr.Cidr.Equal(existingCidr.CidrIpv6) {
+				planned[i].ID = existingCidr.ID
+				planned[i].CidrIpv6 = existingCidr.CidrIpv6
+				planned[i].IPRestrictionRuleID = existingCidr.IPRestrictionRuleID
+				break
+			}
+		}
+	}
+}
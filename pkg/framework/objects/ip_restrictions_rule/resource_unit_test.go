@@ -0,0 +1,131 @@
+package ip_restrictions_rule_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testIPRestrictionsMockServer returns a mock of the API with an allow and a
+// deny rule, none of them containing the egress IP used in the tests
+func testIPRestrictionsMockServer(t *testing.T) *testhelpers.MockServer {
+	existingRules := []map[string]interface{}{
+		{
+			"id":               1,
+			"name":             "office",
+			"type":             1,
+			"description":      "Office network",
+			"rule_set_enabled": false,
+			"cidrs": []map[string]interface{}{
+				{"id": 11, "ip_restriction_rule_id": 1, "cidr": "203.0.113.0/24"},
+				{"id": 12, "ip_restriction_rule_id": 1, "cidr_ipv6": "2001:db8::/32"},
+			},
+		},
+		{
+			"id":               2,
+			"name":             "blocked",
+			"type":             2,
+			"description":      "",
+			"rule_set_enabled": false,
+			"cidrs": []map[string]interface{}{
+				{"id": 21, "ip_restriction_rule_id": 2, "cidr": "198.51.100.7"},
+			},
+		},
+	}
+
+	return testhelpers.SetupMockServer(t, map[string]testhelpers.MockEndpointHandler{
+		"GET /v3/accounts/123/ip-restrictions/": func(r *http.Request) (int, interface{}, error) {
+			return http.StatusOK, map[string]interface{}{"data": existingRules}, nil
+		},
+	})
+}
+
+// TestIPRestrictionsRuleLockoutCheck checks that the plan fails when enabling
+// the IP restrictions would block the egress IP of the provider
+func TestIPRestrictionsRuleLockoutCheck(t *testing.T) {
+	mockServer := testIPRestrictionsMockServer(t)
+	defer mockServer.Close()
+
+	config := func(cidr, lockoutCheck string) string {
+		return fmt.Sprintf(`
+provider "dbtcloud" {
+  host_url   = "%s"
+  token      = "test-token"
+  account_id = 123
+  egress_ip  = "192.0.2.10"
+}
+
+resource "dbtcloud_ip_restrictions_rule" "test" {
+  name             = "runners"
+  type             = "allow"
+  rule_set_enabled = true
+  lockout_check    = "%s"
+  cidrs = [{
+    cidr = "%s"
+  }]
+}
+`, mockServer.URL, lockoutCheck, cidr)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("10.0.0.0/8", "error"),
+				ExpectError: regexp.MustCompile(`not part of any of the allow rules`),
+			},
+			{
+				Config:             config("192.0.2.0/24", "error"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:             config("10.0.0.0/8", "off"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestIPRestrictionsRuleUnknownCidrs checks that the CIDR ranges can come from
+// the output of another resource, unknown until it is applied
+func TestIPRestrictionsRuleUnknownCidrs(t *testing.T) {
+	mockServer := testIPRestrictionsMockServer(t)
+	defer mockServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "dbtcloud" {
+  host_url   = "%s"
+  token      = "test-token"
+  account_id = 123
+  egress_ip  = "192.0.2.10"
+}
+
+resource "terraform_data" "runners" {
+  input = ["10.0.0.0/8"]
+}
+
+resource "dbtcloud_ip_restrictions_rule" "test" {
+  name             = "runners"
+  type             = "allow"
+  rule_set_enabled = true
+  lockout_check    = "error"
+  cidrs            = [for cidr in terraform_data.runners.output : { cidr = cidr }]
+}
+`, mockServer.URL),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
@@ -51,9 +52,24 @@ func (r *ipRestrictionsRuleResource) Schema(
 				Required:    true,
 				Description: "Whether the IP restriction rule set is enabled or not. Important!: This value needs to be the same for all rules if multiple rules are defined. All rules must be active or inactive at the same time.",
 			},
+			"lockout_check": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(lockoutCheckError),
+				Description: helper.DocString(
+					`What to do when enabling the IP restrictions would block the IP address Terraform reaches dbt Cloud from (set in the provider ~~~egress_ip~~~ or detected): ~~~error~~~ (default) fails the plan, ~~~warn~~~ only shows a warning and ~~~off~~~ skips the check`,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						lockoutCheckError,
+						lockoutCheckWarn,
+						lockoutCheckOff,
+					),
+				},
+			},
 			"cidrs": schema.SetNestedAttribute{
 				Required:    true,
-				Description: "Set of CIDR ranges for this rule",
+				Description: "Set of CIDR ranges for this rule. The CIDR ranges already existing keep their ID, so that adding or removing one doesn't recreate the others",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"cidr": schema.StringAttribute{
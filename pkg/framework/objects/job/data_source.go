@@ -76,7 +76,7 @@ func (j *jobDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 
 	jobId := strconv.FormatInt(jobIdValue, 10)
 
-	job, err := j.client.GetJob(jobId)
+	job, err := j.client.GetJob(ctx, jobId)
 
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error getting job: %s", err.Error()))
@@ -55,7 +55,7 @@ func (d *jobsDataSource) Read(
 		environmentID = int(config.EnvironmentID.ValueInt64())
 	}
 
-	apiJobs, err := d.client.GetAllJobs(projectID, environmentID)
+	apiJobs, err := d.client.GetAllJobs(ctx, projectID, environmentID)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
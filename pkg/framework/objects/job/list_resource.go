@@ -0,0 +1,94 @@
+package job
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &jobListResource{}
+	_ list.ListResourceWithConfigure = &jobListResource{}
+)
+
+func JobListResource() list.ListResource {
+	return &jobListResource{}
+}
+
+// jobListResource reuses the job resource for Metadata, Configure, ImportState
+// and Read so that listed jobs are identical to imported ones.
+type jobListResource struct {
+	jobResource
+}
+
+func (j *jobListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the jobs of the account. When neither project_id nor environment_id is set, the jobs of all the projects are returned.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only list the jobs of this project",
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("environment_id")),
+				},
+			},
+			"environment_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only list the jobs of this environment",
+			},
+		},
+	}
+}
+
+func (j *jobListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config JobListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	jobs, err := j.listJobs(ctx, int(config.ProjectID.ValueInt64()), int(config.EnvironmentID.ValueInt64()))
+	if err != nil {
+		diags.AddError("Unable to list the jobs", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = helper.ListResults(
+		ctx,
+		req,
+		&j.jobResource,
+		jobs,
+		func(job dbt_cloud.JobWithEnvironment) helper.ListResultItem {
+			return helper.ListResultItem{
+				DisplayName: job.Name,
+				ImportID:    strconv.Itoa(*job.ID),
+				Identity:    JobResourceIdentityModel{ID: types.Int64Value(int64(*job.ID))},
+			}
+		},
+	)
+}
+
+// listJobs returns the jobs matching the filters, going through all the
+// projects of the account when no filter is set.
+func (j *jobListResource) listJobs(ctx context.Context, projectID, environmentID int) ([]dbt_cloud.JobWithEnvironment, error) {
+	if projectID != 0 || environmentID != 0 {
+		return j.client.GetAllJobs(ctx, projectID, environmentID)
+	}
+
+	return j.client.GetAllAccountJobs(ctx)
+}
@@ -1,6 +1,9 @@
 package job
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type JobsDataSourceModel struct {
 	ProjectID     types.Int64          `tfsdk:"project_id"`
@@ -46,6 +49,11 @@ type JobSchedule struct {
 	Cron types.String `tfsdk:"cron"`
 }
 
+type JobScheduleApplyBlackout struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
 type JobDataSourceModel struct {
 	Execution                     *JobExecution         `tfsdk:"execution"`
 	TimeoutSeconds                types.Int64           `tfsdk:"timeout_seconds"`
@@ -135,7 +143,23 @@ type JobResourceModel struct {
 	ScheduleHours                 []types.Int64                    `tfsdk:"schedule_hours"`
 	ScheduleDays                  []types.Int64                    `tfsdk:"schedule_days"`
 	ScheduleCron                  types.String                     `tfsdk:"schedule_cron"`    // add deprecated move to schedule
+	ScheduleTimezone              types.String                     `tfsdk:"schedule_timezone"`
+	ScheduleCronUTC               types.String                     `tfsdk:"schedule_cron_utc"`
+	ScheduleNextRuns              types.List                       `tfsdk:"schedule_next_runs"`
+	ScheduleWindows               []types.String                   `tfsdk:"schedule_windows"`
+	ScheduleApplyBlackouts        []JobScheduleApplyBlackout       `tfsdk:"schedule_apply_blackouts"`
+	ScheduleInApplyBlackout       types.Bool                       `tfsdk:"schedule_in_apply_blackout"`
 	DeferringJobId                types.Int64                      `tfsdk:"deferring_job_id"` // add deprecated move to deferring_job_definition_id
 	SelfDeferring                 types.Bool                       `tfsdk:"self_deferring"`
 	CompareChangesFlags           types.String                     `tfsdk:"compare_changes_flags"`
+	Timeouts                      timeouts.Value                   `tfsdk:"timeouts"`
+}
+
+type JobResourceIdentityModel struct {
+	ID types.Int64 `tfsdk:"id"`
+}
+
+type JobListResourceModel struct {
+	ProjectID     types.Int64 `tfsdk:"project_id"`
+	EnvironmentID types.Int64 `tfsdk:"environment_id"`
 }
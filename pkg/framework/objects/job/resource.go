@@ -4,9 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -22,6 +22,7 @@ var (
 	_ resource.ResourceWithConfigure   = &jobResource{}
 	_ resource.ResourceWithImportState = &jobResource{}
 	_ resource.ResourceWithModifyPlan  = &jobResource{}
+	_ resource.ResourceWithIdentity    = &jobResource{}
 )
 
 // Job type constants matching the server-side JobType enum
@@ -101,24 +102,12 @@ func reconcileJobType(desired types.String, apiValue string) types.String {
 }
 
 func (j *jobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	if !req.Plan.Raw.IsNull() {
-		var plan JobResourceModel
-		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-
-		if plan.ValidateExecuteSteps.ValueBool() {
-			executeSteps := make([]string, len(plan.ExecuteSteps))
-			for i, step := range plan.ExecuteSteps {
-				executeSteps[i] = step.ValueString()
-			}
-
-			if err := j.validateExecuteSteps(executeSteps); err != nil {
-				resp.Diagnostics.AddError("Error validating execute steps", err.Error())
-				return
-			}
-		}
+	j.modifySchedulePlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Don't do anything on resource creation or deletion
+	// Don't do anything else on resource creation or deletion
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
 	}
@@ -131,6 +120,11 @@ func (j *jobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 		return
 	}
 
+	j.validateCompletionTriggerCycle(ctx, plan, state, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if plan.Triggers == nil || state.Triggers == nil {
 		return
 	}
@@ -175,11 +169,92 @@ func (j *jobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 	}
 }
 
+// modifySchedulePlan previews the UTC cron expression and the next runs of the
+// job, see planSchedule
+func (j *jobResource) modifySchedulePlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var state *JobResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &JobResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planSchedule(&plan, state, time.Now())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("schedule_cron_utc"), plan.ScheduleCronUTC)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("schedule_next_runs"), plan.ScheduleNextRuns)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("schedule_in_apply_blackout"), plan.ScheduleInApplyBlackout)...)
+}
+
+// validateCompletionTriggerCycle rejects a job completion trigger making the
+// job trigger itself through other jobs. A new job can't be upstream of other
+// jobs, so only updates are checked, and only when the upstream job changes to
+// avoid reading all the jobs of the account on every plan.
+func (j *jobResource) validateCompletionTriggerCycle(
+	ctx context.Context,
+	plan, state JobResourceModel,
+	resp *resource.ModifyPlanResponse,
+) {
+	if j.client == nil || len(plan.JobCompletionTriggerCondition) == 0 || state.ID.IsNull() || state.ID.IsUnknown() {
+		return
+	}
+	upstreamJobID := plan.JobCompletionTriggerCondition[0].JobID
+	if upstreamJobID.IsNull() || upstreamJobID.IsUnknown() {
+		return
+	}
+	if len(state.JobCompletionTriggerCondition) > 0 &&
+		state.JobCompletionTriggerCondition[0].JobID.Equal(upstreamJobID) {
+		return
+	}
+
+	cycle, err := j.client.JobCompletionTriggerCycle(
+		ctx,
+		int(state.ID.ValueInt64()),
+		int(upstreamJobID.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("job_completion_trigger_condition"),
+			"Unable to check the job completion trigger for cycles",
+			err.Error(),
+		)
+		return
+	}
+	if cycle != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("job_completion_trigger_condition"),
+			"Job completion trigger creating a cycle",
+			fmt.Sprintf("The job would trigger itself in a loop: %s.", cycle),
+		)
+	}
+}
+
 func JobResource() resource.Resource {
 	return &jobResource{}
 }
 
 func (j *jobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity JobResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("job_id"), identity.ID)...)
+		return
+	}
+
 	jobID, err := strconv.Atoi(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -210,6 +285,14 @@ func (j *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	projectId := plan.ProjectID
 	environmentId := plan.EnvironmentID
 	name := plan.Name.ValueString()
@@ -252,6 +335,25 @@ func (j *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	scheduleCron := plan.ScheduleCron.ValueString()
 
+	// values depending on other resources are only known now
+	if plan.ScheduleCronUTC.IsUnknown() || plan.ScheduleNextRuns.IsUnknown() || plan.ScheduleInApplyBlackout.IsUnknown() {
+		resp.Diagnostics.Append(planSchedule(&plan, nil, time.Now())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if hasLocalSchedule(&plan) {
+		utcSchedule, err := dbt_cloud.ParseJobScheduleCron(plan.ScheduleCronUTC.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error converting the job schedule to UTC", err.Error())
+			return
+		}
+		scheduleType, scheduleInterval, scheduleHours, scheduleDays, scheduleCron = flattenJobSchedule(utcSchedule)
+	}
+	if plan.ScheduleInApplyBlackout.ValueBool() {
+		triggers["schedule"] = false
+	}
+
 	var deferringJobId *int
 	if !plan.DeferringJobId.IsNull() {
 		deferringJobId = helper.Int64ToIntPointer(plan.DeferringJobId.ValueInt64())
@@ -321,7 +423,7 @@ func (j *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 		createDeferringEnvironmentID = *deferringEnvironmentID
 	}
 
-	createdJob, err := j.client.CreateJob(int(projectId.ValueInt64()),
+	createdJob, err := j.client.CreateJob(ctx, int(projectId.ValueInt64()),
 		int(environmentId.ValueInt64()),
 		name,
 		description,
@@ -424,11 +526,14 @@ func (j *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 	plan.SelfDeferring = types.BoolValue(createdSelfDeferring)
 
-	diags := resp.State.Set(ctx, plan)
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	identity := JobResourceIdentityModel{ID: plan.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (j *jobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -438,6 +543,14 @@ func (j *jobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Ensure ID is not null before accessing
 	if state.ID.IsNull() {
 		resp.Diagnostics.AddError("Client Error", "Job ID is null")
@@ -447,7 +560,7 @@ func (j *jobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	jobID := state.ID.ValueInt64()
 	jobIDStr := strconv.FormatInt(jobID, 10)
 
-	job, err := j.client.GetJob(jobIDStr)
+	job, err := j.client.GetJob(ctx, jobIDStr)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "job") {
 			return
@@ -462,7 +575,7 @@ func (j *jobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		job.ForceNodeSelection,
 		job.CostOptimizationFeatures,
 	)
-	_, err = j.client.UpdateJob(jobIDStr, *job)
+	_, err = j.client.UpdateJob(ctx, jobIDStr, *job)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to delete job: "+err.Error())
 		return
@@ -478,10 +591,18 @@ func (j *jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	readTimeout, diags := state.Timeouts.Read(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	jobID := state.ID.ValueInt64()
 	jobIDStr := strconv.FormatInt(jobID, 10)
 
-	retrievedJob, err := j.client.GetJob(jobIDStr)
+	retrievedJob, err := j.client.GetJob(ctx, jobIDStr)
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "job") {
@@ -510,41 +631,67 @@ func (j *jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	state.TargetName = types.StringValue(retrievedJob.Settings.TargetName)
 	state.GenerateDocs = types.BoolValue(retrievedJob.GenerateDocs)
 	state.RunGenerateSources = types.BoolValue(retrievedJob.RunGenerateSources)
-	state.ScheduleType = types.StringValue(retrievedJob.Schedule.Date.Type)
-
-	schedule := 1
-	if retrievedJob.Schedule.Date.Type == "interval_cron" && retrievedJob.Schedule.Date.Cron != nil {
-		// For interval_cron, parse the interval from the cron expression (e.g., "4 */5 * * 0,1,2,3,4,5,6")
-		cronParts := strings.Split(*retrievedJob.Schedule.Date.Cron, " ")
-		if len(cronParts) >= 2 && strings.HasPrefix(cronParts[1], "*/") {
-			if intervalVal, err := strconv.Atoi(strings.TrimPrefix(cronParts[1], "*/")); err == nil {
-				schedule = intervalVal
+	// with schedule_timezone or schedule_windows, the schedule_* attributes are
+	// in local time and dbt Cloud holds their conversion to UTC in
+	// schedule_cron_utc
+	if !hasLocalSchedule(&state) {
+		state.ScheduleType = types.StringValue(retrievedJob.Schedule.Date.Type)
+
+		schedule := 1
+		if retrievedJob.Schedule.Date.Type == "interval_cron" && retrievedJob.Schedule.Date.Cron != nil {
+			// For interval_cron, parse the interval from the cron expression (e.g., "4 */5 * * 0,1,2,3,4,5,6")
+			cronParts := strings.Split(*retrievedJob.Schedule.Date.Cron, " ")
+			if len(cronParts) >= 2 && strings.HasPrefix(cronParts[1], "*/") {
+				if intervalVal, err := strconv.Atoi(strings.TrimPrefix(cronParts[1], "*/")); err == nil {
+					schedule = intervalVal
+				}
 			}
+		} else if retrievedJob.Schedule.Time.Interval > 0 {
+			schedule = retrievedJob.Schedule.Time.Interval
 		}
-	} else if retrievedJob.Schedule.Time.Interval > 0 {
-		schedule = retrievedJob.Schedule.Time.Interval
-	}
-	state.ScheduleInterval = types.Int64Value(int64(schedule))
+		state.ScheduleInterval = types.Int64Value(int64(schedule))
 
-	if retrievedJob.Schedule.Time.Hours != nil {
-		state.ScheduleHours = helper.SliceIntToSliceTypesInt64(*retrievedJob.Schedule.Time.Hours)
-	} else {
-		var scheduleHoursNull []types.Int64
-		state.ScheduleHours = scheduleHoursNull
-	}
+		if retrievedJob.Schedule.Time.Hours != nil {
+			state.ScheduleHours = helper.SliceIntToSliceTypesInt64(*retrievedJob.Schedule.Time.Hours)
+		} else {
+			var scheduleHoursNull []types.Int64
+			state.ScheduleHours = scheduleHoursNull
+		}
 
-	if retrievedJob.Schedule.Date.Days != nil {
-		state.ScheduleDays = helper.SliceIntToSliceTypesInt64(*retrievedJob.Schedule.Date.Days)
-	} else {
-		var scheduleDaysNull []types.Int64
-		state.ScheduleDays = scheduleDaysNull
+		if retrievedJob.Schedule.Date.Days != nil {
+			state.ScheduleDays = helper.SliceIntToSliceTypesInt64(*retrievedJob.Schedule.Date.Days)
+		} else {
+			var scheduleDaysNull []types.Int64
+			state.ScheduleDays = scheduleDaysNull
+		}
+
+		if retrievedJob.Schedule.Date.Cron != nil &&
+			retrievedJob.Schedule.Date.Type != "interval_cron" { // for interval_cron, the cron expression is auto generated in the code
+			state.ScheduleCron = types.StringValue(*retrievedJob.Schedule.Date.Cron)
+		} else {
+			state.ScheduleCron = types.StringNull()
+		}
 	}
 
-	if retrievedJob.Schedule.Date.Cron != nil &&
-		retrievedJob.Schedule.Date.Type != "interval_cron" { // for interval_cron, the cron expression is auto generated in the code
-		state.ScheduleCron = types.StringValue(*retrievedJob.Schedule.Date.Cron)
+	if scheduleCronUTC, err := normalizedScheduleCron(retrievedJob.Schedule); err == nil {
+		state.ScheduleCronUTC = types.StringValue(scheduleCronUTC)
 	} else {
-		state.ScheduleCron = types.StringNull()
+		state.ScheduleCronUTC = types.StringNull()
+	}
+	// during an apply blackout, dbt Cloud holds the schedule trigger disabled
+	// while it stays enabled in the configuration, see planSchedule
+	scheduleTrigger := retrievedJob.Triggers.Schedule
+	if state.ScheduleInApplyBlackout.ValueBool() && state.Triggers != nil && state.Triggers.Schedule.ValueBool() {
+		scheduleTrigger = true
+	}
+	if state.ScheduleInApplyBlackout.IsNull() || state.ScheduleInApplyBlackout.IsUnknown() {
+		state.ScheduleInApplyBlackout = types.BoolValue(false)
+	}
+	if !retrievedJob.Triggers.Schedule || state.ScheduleCronUTC.IsNull() {
+		state.ScheduleNextRuns = types.ListNull(types.StringType)
+	} else if state.ScheduleNextRuns.IsNull() || state.ScheduleNextRuns.IsUnknown() {
+		// the preview is only refreshed when the schedule changes, see planSchedule
+		state.ScheduleNextRuns, _ = scheduleNextRuns(state.ScheduleCronUTC.ValueString(), state.ScheduleTimezone, time.Now())
 	}
 
 	selfDeferring := retrievedJob.DeferringJobId != nil && strconv.Itoa(*retrievedJob.DeferringJobId) == jobIDStr
@@ -582,7 +729,7 @@ func (j *jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	// for now, we allow people to keep the triggers.custom_branch_only config even if the parameter was deprecated in the API
 	// we set the state to the current config value, so it doesn't do anything
 	var customBranchValue types.Bool
-	diags := req.State.GetAttribute(ctx, path.Root("triggers").AtMapKey("custom_branch_only"), &customBranchValue)
+	diags = req.State.GetAttribute(ctx, path.Root("triggers").AtMapKey("custom_branch_only"), &customBranchValue)
 
 	if !diags.HasError() && !customBranchValue.IsNull() {
 		triggers["custom_branch_only"] = customBranchValue.ValueBool()
@@ -605,7 +752,7 @@ func (j *jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	state.Triggers = &JobTriggers{
 		GithubWebhook:      types.BoolValue(retrievedJob.Triggers.GithubWebhook),
 		GitProviderWebhook: types.BoolValue(retrievedJob.Triggers.GitProviderWebhook),
-		Schedule:           types.BoolValue(retrievedJob.Triggers.Schedule),
+		Schedule:           types.BoolValue(scheduleTrigger),
 		OnMerge:            types.BoolValue(retrievedJob.Triggers.OnMerge),
 	}
 
@@ -666,6 +813,12 @@ func (j *jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := JobResourceIdentityModel{ID: state.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -678,10 +831,18 @@ func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helper.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	jobID := state.ID.ValueInt64()
 	jobIDStr := strconv.FormatInt(jobID, 10)
 
-	job, err := j.client.GetJob(jobIDStr)
+	job, err := j.client.GetJob(ctx, jobIDStr)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving job",
@@ -776,6 +937,26 @@ func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		job.Schedule.Date.Cron = &cronExpr
 	}
 
+	// values depending on other resources are only known now
+	if plan.ScheduleCronUTC.IsUnknown() || plan.ScheduleNextRuns.IsUnknown() || plan.ScheduleInApplyBlackout.IsUnknown() {
+		resp.Diagnostics.Append(planSchedule(&plan, &state, time.Now())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if hasLocalSchedule(&plan) {
+		utcSchedule, err := dbt_cloud.ParseJobScheduleCron(plan.ScheduleCronUTC.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error converting the job schedule to UTC", err.Error())
+			return
+		}
+		job.Schedule.Date = utcSchedule.Date
+		job.Schedule.Time = utcSchedule.Time
+	}
+	if plan.ScheduleInApplyBlackout.ValueBool() {
+		job.Triggers.Schedule = false
+	}
+
 	if plan.DeferringEnvironmentID.IsNull() || plan.DeferringEnvironmentID.ValueInt64() == 0 {
 		// For CI and Merge jobs, preserve the API's deferring_environment_id when the plan doesn't set it.
 		// Other job types clear it when not specified.
@@ -866,7 +1047,7 @@ func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	oldName := state.Name.ValueString()
 	newName := plan.Name.ValueString()
 
-	updatedJob, err := j.client.UpdateJob(jobIDStr, *job)
+	updatedJob, err := j.client.UpdateJob(ctx, jobIDStr, *job)
 	if err != nil {
 		// Build a well-formatted, context-aware error message
 		var errorMsg strings.Builder
@@ -886,8 +1067,9 @@ func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 			errorMsg.WriteString("\nAttempted changes:\n")
 			errorMsg.WriteString(strings.Join(changes, "\n"))
 
-			// If environment is changing and it's a permission error, add extra context
-			if oldEnvID != newEnvID && (strings.Contains(err.Error(), "permission") || strings.Contains(err.Error(), "forbidden") || strings.Contains(err.Error(), "resource-not-found-permissions")) {
+			// If environment is changing and it's a permission error, add extra context.
+			// A 404 on update can also mean that the token can't see the new environment
+			if oldEnvID != newEnvID && (dbt_cloud.IsPermissionError(err) || dbt_cloud.IsNotFound(err)) {
 				errorMsg.WriteString(fmt.Sprintf("\n\nℹ️  Note: The API token may not have write access to environment %d.\nEnvironment-level permissions are required to move jobs between environments.", newEnvID))
 			}
 		}
@@ -945,11 +1127,14 @@ func (j *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	updatedSelfDeferring := updatedJob.DeferringJobId != nil && strconv.Itoa(*updatedJob.DeferringJobId) == updatedJobIDStr
 	plan.SelfDeferring = types.BoolValue(updatedSelfDeferring)
 
-	diags := resp.State.Set(ctx, plan)
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	identity := JobResourceIdentityModel{ID: plan.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Cost optimization feature values exposed by the provider. The dbt Cloud API
@@ -1133,60 +1318,3 @@ func isCIOrMergeJobAtCreate(explicitJobType string, triggers *JobTriggers) bool
 	ci := triggers.GithubWebhook.ValueBool() || triggers.GitProviderWebhook.ValueBool()
 	return ci || triggers.OnMerge.ValueBool()
 }
-
-func (j *jobResource) validateExecuteSteps(executeSteps []string) error {
-	dbt_flags := []string{
-		"--warn-error",
-		"--use-experimental-parser",
-		"--no-partial-parse",
-		"--fail-fast",
-	}
-
-	dbt_commands := []string{
-		"run",
-		"test",
-		"archive",
-		"snapshot",
-		"seed",
-		"source",
-		"compile",
-		"ls",
-		"list",
-		`docs\s+generate`,
-		"parse",
-		"build",
-		"clone",
-		"debug",
-		"retry",
-		"compare",
-		"sl",
-	}
-
-	// Build regex pattern for valid commands
-	flagsPattern := strings.Join(dbt_flags, "|")
-	commandsPattern := strings.Join(dbt_commands, "|")
-	validCommandsPattern := fmt.Sprintf(`^\s*dbt\s+((%s)\s+)*(%s)\s*.*$`, flagsPattern, commandsPattern)
-	validCommandsRegex := regexp.MustCompile(validCommandsPattern)
-
-	// Validate each execute step individually
-	for _, step := range executeSteps {
-		// Normalize the step by replacing newlines with spaces to support multi-line commands
-		// This matches how dbt Cloud UI handles commands that span multiple lines
-		normalizedStep := strings.ReplaceAll(step, "\n", " ")
-		normalizedStep = strings.ReplaceAll(normalizedStep, "\r", " ")
-
-		// Check if step matches valid dbt command pattern
-		if !validCommandsRegex.MatchString(normalizedStep) {
-			return fmt.Errorf("invalid command: %s. Allowed commands are: %s", step, strings.Join(dbt_commands, ", "))
-		}
-
-		// Check that each flag isn't used more than once within this step
-		for _, flag := range dbt_flags {
-			if strings.Count(step, flag) > 1 {
-				return fmt.Errorf("flag %s can only be used once per step in: %s", flag, step)
-			}
-		}
-	}
-
-	return nil
-}
@@ -1,6 +1,7 @@
 package job_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -762,7 +763,7 @@ func testAccCheckDbtCloudJobExists(resource string) resource.TestCheckFunc {
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetJob(rs.Primary.ID)
+		_, err = apiClient.GetJob(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -784,7 +785,7 @@ func testAccCheckDbtCloudJobHasDbtState(resource string) resource.TestCheckFunc
 		if err != nil {
 			return fmt.Errorf("issue getting the client: %w", err)
 		}
-		remoteJob, err := apiClient.GetJob(rs.Primary.ID)
+		remoteJob, err := apiClient.GetJob(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching job %s: %w", rs.Primary.ID, err)
 		}
@@ -809,7 +810,7 @@ func testAccCheckDbtCloudJobHasNoCostOptimizationFeatures(resource string) resou
 		if err != nil {
 			return fmt.Errorf("issue getting the client: %w", err)
 		}
-		remoteJob, err := apiClient.GetJob(rs.Primary.ID)
+		remoteJob, err := apiClient.GetJob(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching job %s: %w", rs.Primary.ID, err)
 		}
@@ -839,7 +840,7 @@ func testAccCheckDbtCloudJobDestroy(s *terraform.State) error {
 		if rs.Type != "dbtcloud_job" {
 			continue
 		}
-		_, err := apiClient.GetJob(rs.Primary.ID)
+		_, err := apiClient.GetJob(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Job still exists")
 		}
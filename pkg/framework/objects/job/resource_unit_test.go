@@ -0,0 +1,88 @@
+package job_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestJobResourceFakeAPI runs the create, update, import and delete cycle of
+// the job resource against the in-memory fake of the dbt Cloud API.
+func TestJobResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if jobs := server.List(fakeapi.Jobs); len(jobs) != 0 {
+				return fmt.Errorf("expected the job to be deleted, got %v", jobs)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testJobResourceFakeAPIConfig("daily", `"dbt test"`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_job.test", "name", "daily"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test", "execute_steps.#", "1"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test", "triggers.schedule", "false"),
+					resource.TestCheckResourceAttrPair(
+						"dbtcloud_job.test", "environment_id",
+						"dbtcloud_environment.test", "environment_id",
+					),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testJobResourceFakeAPIConfig("nightly", `"dbt build", "dbt test"`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_job.test", "name", "nightly"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test", "execute_steps.#", "2"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test", "execute_steps.0", "dbt build"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test", "triggers.schedule", "true"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test", "schedule_hours.0", "6"),
+				),
+			},
+			{
+				ResourceName:            "dbtcloud_job.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_execute_steps"},
+			},
+		},
+	})
+}
+
+func testJobResourceFakeAPIConfig(name, executeSteps string, schedule bool) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name = "analytics"
+}
+
+resource "dbtcloud_environment" "test" {
+  project_id      = dbtcloud_project.test.id
+  name            = "Production"
+  type            = "deployment"
+  dbt_version     = "latest"
+  deployment_type = "production"
+}
+
+resource "dbtcloud_job" "test" {
+  project_id     = dbtcloud_project.test.id
+  environment_id = dbtcloud_environment.test.environment_id
+  name           = %q
+  execute_steps  = [%s]
+  triggers = {
+    github_webhook       = false
+    git_provider_webhook = false
+    schedule             = %t
+  }
+  schedule_type  = "days_of_week"
+  schedule_days  = [1, 3]
+  schedule_hours = [6]
+}
+`, name, executeSteps, schedule)
+}
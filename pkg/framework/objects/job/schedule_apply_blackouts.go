@@ -0,0 +1,108 @@
+package job
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// scheduleApplyBlackout is a period of schedule_apply_blackouts, its end is
+// excluded
+type scheduleApplyBlackout struct {
+	start, end time.Time
+}
+
+// parseScheduleApplyBlackouts parses the RFC 3339 timestamps of
+// schedule_apply_blackouts
+func parseScheduleApplyBlackouts(blackouts []JobScheduleApplyBlackout) ([]scheduleApplyBlackout, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parsed := make([]scheduleApplyBlackout, 0, len(blackouts))
+	for i, blackout := range blackouts {
+		blackoutPath := path.Root("schedule_apply_blackouts").AtListIndex(i)
+		start, err := time.Parse(time.RFC3339, blackout.Start.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				blackoutPath.AtName("start"),
+				"Invalid schedule apply blackout",
+				fmt.Sprintf("The start of the blackout must be an RFC 3339 timestamp, e.g. 2026-12-21T00:00:00+01:00: %s", err),
+			)
+			continue
+		}
+		end, err := time.Parse(time.RFC3339, blackout.End.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				blackoutPath.AtName("end"),
+				"Invalid schedule apply blackout",
+				fmt.Sprintf("The end of the blackout must be an RFC 3339 timestamp, e.g. 2027-01-04T00:00:00+01:00: %s", err),
+			)
+			continue
+		}
+		if !end.After(start) {
+			diags.AddAttributeError(
+				blackoutPath.AtName("end"),
+				"Invalid schedule apply blackout",
+				fmt.Sprintf("The end of the blackout (%s) must be after its start (%s).", blackout.End.ValueString(), blackout.Start.ValueString()),
+			)
+			continue
+		}
+		parsed = append(parsed, scheduleApplyBlackout{start: start, end: end})
+	}
+	return parsed, diags
+}
+
+// activeScheduleApplyBlackout returns the blackout containing at, if any
+func activeScheduleApplyBlackout(blackouts []scheduleApplyBlackout, at time.Time) (scheduleApplyBlackout, bool) {
+	for _, blackout := range blackouts {
+		if !at.Before(blackout.start) && at.Before(blackout.end) {
+			return blackout, true
+		}
+	}
+	return scheduleApplyBlackout{}, false
+}
+
+// scheduleApplyBlackoutWarnings reminds that dbt Cloud doesn't know about the
+// blackouts: the schedule trigger is only disabled and enabled again when
+// Terraform applies the job. nextRuns are the next runs of the job when it is
+// not planned during a blackout.
+func scheduleApplyBlackoutWarnings(
+	blackouts []scheduleApplyBlackout,
+	nextRuns []time.Time,
+	location *time.Location,
+	now time.Time,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if blackout, ok := activeScheduleApplyBlackout(blackouts, now); ok {
+		diags.AddAttributeWarning(
+			path.Root("schedule_apply_blackouts"),
+			"Job schedule disabled until the next apply",
+			fmt.Sprintf(
+				"The schedule trigger of the job is disabled in dbt Cloud during the blackout ending at %s. "+
+					"It is only enabled again when `terraform apply` runs after the end of the blackout.",
+				blackout.end.In(location).Format(time.RFC3339),
+			),
+		)
+		return diags
+	}
+
+	var blackedOutRuns []string
+	for _, nextRun := range nextRuns {
+		if _, ok := activeScheduleApplyBlackout(blackouts, nextRun); ok {
+			blackedOutRuns = append(blackedOutRuns, nextRun.In(location).Format(time.RFC3339))
+		}
+	}
+	if len(blackedOutRuns) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("schedule_apply_blackouts"),
+			"Job runs scheduled during a blackout",
+			fmt.Sprintf(
+				"dbt Cloud will still run the job at %s, during the blackouts, "+
+					"unless `terraform apply` runs during the blackouts to disable its schedule trigger.",
+				strings.Join(blackedOutRuns, ", "),
+			),
+		)
+	}
+	return diags
+}
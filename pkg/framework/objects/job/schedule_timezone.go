@@ -0,0 +1,270 @@
+package job
+
+import (
+	"fmt"
+	"slices"
+	"time"
+	// the timezone database is embedded as it is not available on every machine running Terraform
+	_ "time/tzdata"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scheduleNextRunsCount is the number of upcoming runs previewed in
+// schedule_next_runs
+const scheduleNextRunsCount = 5
+
+func hasScheduleTimezone(timezone types.String) bool {
+	return !timezone.IsNull() && !timezone.IsUnknown() && timezone.ValueString() != ""
+}
+
+// hasLocalSchedule reports whether the schedule_* attributes differ from the
+// schedule sent to dbt Cloud, which is then only held in schedule_cron_utc
+func hasLocalSchedule(model *JobResourceModel) bool {
+	return hasScheduleTimezone(model.ScheduleTimezone) || len(model.ScheduleWindows) > 0
+}
+
+// scheduleLocation returns the location of schedule_timezone, or UTC when it
+// is not set
+func scheduleLocation(timezone types.String) (*time.Location, error) {
+	if !hasScheduleTimezone(timezone) {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(timezone.ValueString())
+}
+
+// scheduleKnown reports whether all the attributes defining the schedule are
+// known, which is not the case during plan when they depend on other resources
+func scheduleKnown(model *JobResourceModel) bool {
+	if model.Triggers == nil || model.Triggers.Schedule.IsUnknown() ||
+		model.ScheduleType.IsUnknown() ||
+		model.ScheduleInterval.IsUnknown() ||
+		model.ScheduleCron.IsUnknown() ||
+		model.ScheduleTimezone.IsUnknown() {
+		return false
+	}
+	for _, value := range slices.Concat(model.ScheduleHours, model.ScheduleDays) {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+	for _, window := range model.ScheduleWindows {
+		if window.IsUnknown() {
+			return false
+		}
+	}
+	for _, blackout := range model.ScheduleApplyBlackouts {
+		if blackout.Start.IsUnknown() || blackout.End.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// localScheduleCron renders the schedule_* attributes as a cron expression,
+// in the time of schedule_timezone when it is set
+func localScheduleCron(model *JobResourceModel) (string, error) {
+	scheduleHours := make([]int, len(model.ScheduleHours))
+	for i, hour := range model.ScheduleHours {
+		scheduleHours[i] = int(hour.ValueInt64())
+	}
+	scheduleDays := make([]int, len(model.ScheduleDays))
+	for i, day := range model.ScheduleDays {
+		scheduleDays[i] = int(day.ValueInt64())
+	}
+
+	return dbt_cloud.NewJobSchedule(
+		model.ScheduleType.ValueString(),
+		int(model.ScheduleInterval.ValueInt64()),
+		scheduleHours,
+		scheduleDays,
+		model.ScheduleCron.ValueString(),
+	).CronExpression()
+}
+
+// windowedScheduleCron combines the schedule_* attributes with
+// schedule_windows into a single cron expression
+func windowedScheduleCron(model *JobResourceModel) (string, error) {
+	localCron, err := localScheduleCron(model)
+	if err != nil || len(model.ScheduleWindows) == 0 {
+		return localCron, err
+	}
+
+	crons := []string{localCron}
+	for _, window := range model.ScheduleWindows {
+		crons = append(crons, window.ValueString())
+	}
+	return dbt_cloud.MergeCronExpressions(crons)
+}
+
+// utcScheduleCron renders the cron expression sent to dbt Cloud, converting
+// localCron from schedule_timezone with its UTC offset at the time at. The
+// result is normalized the same way as the schedules read from the API.
+func utcScheduleCron(model *JobResourceModel, localCron string, at time.Time) (string, error) {
+	location, err := scheduleLocation(model.ScheduleTimezone)
+	if err != nil {
+		return "", err
+	}
+
+	utcCron, err := dbt_cloud.ConvertCronToUTC(localCron, location, at)
+	if err != nil {
+		return "", err
+	}
+	schedule, err := dbt_cloud.ParseJobScheduleCron(utcCron)
+	if err != nil {
+		return "", err
+	}
+	return normalizedScheduleCron(schedule)
+}
+
+// normalizedScheduleCron renders the schedule as a cron expression, using the
+// same representation for equivalent schedules, e.g. `1-5` and `1,2,3,4,5`
+func normalizedScheduleCron(schedule dbt_cloud.JobSchedule) (string, error) {
+	cron, err := schedule.CronExpression()
+	if err != nil {
+		return "", err
+	}
+	normalized, err := dbt_cloud.ParseJobScheduleCron(cron)
+	if err != nil {
+		return "", err
+	}
+	return normalized.CronExpression()
+}
+
+// scheduleNextRuns lists the next runs of the UTC cron expression after from,
+// formatted in schedule_timezone
+func scheduleNextRuns(utcCron string, timezone types.String, from time.Time) (types.List, error) {
+	location, err := scheduleLocation(timezone)
+	if err != nil {
+		return types.ListNull(types.StringType), err
+	}
+	nextRuns, err := dbt_cloud.NextCronTimes(utcCron, from.UTC(), scheduleNextRunsCount)
+	if err != nil {
+		return types.ListNull(types.StringType), err
+	}
+
+	values := make([]attr.Value, len(nextRuns))
+	for i, nextRun := range nextRuns {
+		values[i] = types.StringValue(nextRun.In(location).Format(time.RFC3339))
+	}
+	return types.ListValueMust(types.StringType, values), nil
+}
+
+// planSchedule sets schedule_cron_utc, schedule_next_runs and
+// schedule_in_apply_blackout in the plan. state is nil when the job is created.
+//
+// The UTC cron expression and the preview are kept from the state while the
+// schedule doesn't change, so that the preview doesn't show a diff on every
+// plan. With schedule_timezone, the UTC cron expression is rendered again with
+// the current UTC offset, so crossing a daylight saving time boundary updates
+// the job. The same way, planning the job during or after one of its apply
+// blackouts disables or enables its schedule trigger, which is why the plan
+// warns about the runs dbt Cloud will still start during a blackout.
+func planSchedule(plan *JobResourceModel, state *JobResourceModel, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !scheduleKnown(plan) {
+		return diags
+	}
+
+	blackouts, blackoutDiags := parseScheduleApplyBlackouts(plan.ScheduleApplyBlackouts)
+	diags.Append(blackoutDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	_, inBlackout := activeScheduleApplyBlackout(blackouts, now)
+	plan.ScheduleInApplyBlackout = types.BoolValue(inBlackout && plan.Triggers.Schedule.ValueBool())
+
+	localCron, err := windowedScheduleCron(plan)
+	if err != nil && len(plan.ScheduleWindows) > 0 {
+		diags.AddAttributeError(
+			path.Root("schedule_windows"),
+			"Invalid schedule windows",
+			fmt.Sprintf("The schedule windows can't be combined with the schedule of the job: %s", err),
+		)
+		return diags
+	}
+	utcCron := ""
+	if err == nil {
+		utcCron, err = utcScheduleCron(plan, localCron, now)
+	}
+	if err != nil {
+		if hasScheduleTimezone(plan.ScheduleTimezone) {
+			diags.AddAttributeError(
+				path.Root("schedule_timezone"),
+				"Invalid schedule for the timezone",
+				fmt.Sprintf("The schedule can't be converted from %s to UTC: %s", plan.ScheduleTimezone.ValueString(), err),
+			)
+			return diags
+		}
+		// schedules that can't be rendered are sent to dbt Cloud as before
+		plan.ScheduleCronUTC = types.StringNull()
+		plan.ScheduleNextRuns = types.ListNull(types.StringType)
+		return diags
+	}
+
+	if state != nil && !hasLocalSchedule(plan) &&
+		!state.ScheduleCronUTC.IsNull() && !hasLocalSchedule(state) {
+		stateCron, stateErr := localScheduleCron(state)
+		planCron, _ := localScheduleCron(plan)
+		if stateErr == nil && stateCron == planCron {
+			// the state holds the schedule as normalized by dbt Cloud
+			utcCron = state.ScheduleCronUTC.ValueString()
+		}
+	}
+	plan.ScheduleCronUTC = types.StringValue(utcCron)
+
+	if !plan.Triggers.Schedule.ValueBool() {
+		plan.ScheduleNextRuns = types.ListNull(types.StringType)
+		return diags
+	}
+
+	if len(blackouts) > 0 {
+		location, _ := scheduleLocation(plan.ScheduleTimezone)
+		upcomingRuns, _ := dbt_cloud.NextCronTimes(utcCron, now.UTC(), scheduleNextRunsCount)
+		diags.Append(scheduleApplyBlackoutWarnings(blackouts, upcomingRuns, location, now)...)
+	}
+	if inBlackout {
+		// the schedule trigger is disabled in dbt Cloud until the next apply
+		plan.ScheduleNextRuns = types.ListNull(types.StringType)
+		return diags
+	}
+
+	if state != nil && state.Triggers != nil && state.Triggers.Schedule.ValueBool() &&
+		state.ScheduleCronUTC.ValueString() == utcCron &&
+		state.ScheduleTimezone.ValueString() == plan.ScheduleTimezone.ValueString() &&
+		!state.ScheduleNextRuns.IsNull() && !state.ScheduleNextRuns.IsUnknown() {
+		plan.ScheduleNextRuns = state.ScheduleNextRuns
+		return diags
+	}
+
+	nextRuns, err := scheduleNextRuns(utcCron, plan.ScheduleTimezone, now)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("schedule_next_runs"),
+			"Unable to preview the next runs of the job",
+			err.Error(),
+		)
+	}
+	plan.ScheduleNextRuns = nextRuns
+	return diags
+}
+
+// flattenJobSchedule returns the arguments of NewJobSchedule building schedule
+func flattenJobSchedule(schedule dbt_cloud.JobSchedule) (string, int, []int, []int, string) {
+	var hours, days []int
+	if schedule.Time.Hours != nil {
+		hours = *schedule.Time.Hours
+	}
+	if schedule.Date.Days != nil {
+		days = *schedule.Date.Days
+	}
+	cron := ""
+	if schedule.Date.Cron != nil {
+		cron = *schedule.Date.Cron
+	}
+	return schedule.Date.Type, schedule.Time.Interval, hours, days, cron
+}
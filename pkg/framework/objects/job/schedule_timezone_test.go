@@ -0,0 +1,249 @@
+package job
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func scheduleModel(timezone string, hours ...int64) *JobResourceModel {
+	model := &JobResourceModel{
+		Triggers:         &JobTriggers{Schedule: types.BoolValue(true)},
+		ScheduleType:     types.StringValue("days_of_week"),
+		ScheduleInterval: types.Int64Value(1),
+		ScheduleCron:     types.StringNull(),
+		ScheduleTimezone: types.StringNull(),
+		ScheduleCronUTC:  types.StringUnknown(),
+		ScheduleNextRuns: types.ListUnknown(types.StringType),
+		ScheduleDays: []types.Int64{
+			types.Int64Value(1),
+			types.Int64Value(2),
+			types.Int64Value(3),
+			types.Int64Value(4),
+			types.Int64Value(5),
+		},
+	}
+	if timezone != "" {
+		model.ScheduleTimezone = types.StringValue(timezone)
+	}
+	for _, hour := range hours {
+		model.ScheduleHours = append(model.ScheduleHours, types.Int64Value(hour))
+	}
+	return model
+}
+
+func nextRunsOf(t *testing.T, list types.List) []string {
+	t.Helper()
+	nextRuns := []string{}
+	for _, value := range list.Elements() {
+		nextRuns = append(nextRuns, value.(types.String).ValueString())
+	}
+	return nextRuns
+}
+
+func TestPlanSchedule(t *testing.T) {
+	t.Parallel()
+
+	winter := time.Date(2026, time.January, 14, 12, 0, 0, 0, time.UTC) // a Wednesday
+	summer := time.Date(2026, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+	t.Run("converts the schedule to UTC", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("Europe/Paris", 7, 19)
+		if diags := planSchedule(plan, nil, winter); diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags.Errors())
+		}
+		if got := plan.ScheduleCronUTC.ValueString(); got != "0 6,18 * * 1,2,3,4,5" {
+			t.Errorf("unexpected UTC cron %q", got)
+		}
+		want := []string{
+			"2026-01-14T19:00:00+01:00",
+			"2026-01-15T07:00:00+01:00",
+			"2026-01-15T19:00:00+01:00",
+			"2026-01-16T07:00:00+01:00",
+			"2026-01-16T19:00:00+01:00",
+		}
+		if got := nextRunsOf(t, plan.ScheduleNextRuns); !slices.Equal(got, want) {
+			t.Errorf("unexpected next runs %v, want %v", got, want)
+		}
+	})
+
+	t.Run("daylight saving time changes the UTC cron", func(t *testing.T) {
+		t.Parallel()
+
+		state := scheduleModel("Europe/Paris", 7, 19)
+		planSchedule(state, nil, winter)
+
+		plan := scheduleModel("Europe/Paris", 7, 19)
+		if diags := planSchedule(plan, state, summer); diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags.Errors())
+		}
+		if got := plan.ScheduleCronUTC.ValueString(); got != "0 5,17 * * 1,2,3,4,5" {
+			t.Errorf("unexpected UTC cron %q", got)
+		}
+		if plan.ScheduleNextRuns.Equal(state.ScheduleNextRuns) {
+			t.Errorf("expected the next runs to be computed again")
+		}
+	})
+
+	t.Run("keeps the state while the schedule doesn't change", func(t *testing.T) {
+		t.Parallel()
+
+		state := scheduleModel("Europe/Paris", 7, 19)
+		planSchedule(state, nil, winter)
+
+		plan := scheduleModel("Europe/Paris", 7, 19)
+		planSchedule(plan, state, winter.Add(72*time.Hour))
+		if !plan.ScheduleCronUTC.Equal(state.ScheduleCronUTC) || !plan.ScheduleNextRuns.Equal(state.ScheduleNextRuns) {
+			t.Errorf("expected the state to be kept, got %s and %s", plan.ScheduleCronUTC, plan.ScheduleNextRuns)
+		}
+	})
+
+	t.Run("keeps the cron normalized by dbt Cloud without timezone", func(t *testing.T) {
+		t.Parallel()
+
+		state := scheduleModel("", 7)
+		state.ScheduleCronUTC = types.StringValue("0 7 * * 1-5")
+		state.ScheduleNextRuns = types.ListNull(types.StringType)
+
+		plan := scheduleModel("", 7)
+		planSchedule(plan, state, winter)
+		if got := plan.ScheduleCronUTC.ValueString(); got != "0 7 * * 1-5" {
+			t.Errorf("expected the UTC cron from the state, got %q", got)
+		}
+	})
+
+	t.Run("no preview without the schedule trigger", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("Europe/Paris", 7)
+		plan.Triggers.Schedule = types.BoolValue(false)
+		planSchedule(plan, nil, winter)
+		if plan.ScheduleCronUTC.IsNull() || !plan.ScheduleNextRuns.IsNull() {
+			t.Errorf("expected a UTC cron without next runs, got %s and %s", plan.ScheduleCronUTC, plan.ScheduleNextRuns)
+		}
+	})
+
+	t.Run("unknown schedule", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("Europe/Paris", 7)
+		plan.ScheduleHours = []types.Int64{types.Int64Unknown()}
+		planSchedule(plan, nil, winter)
+		if !plan.ScheduleCronUTC.IsUnknown() || !plan.ScheduleNextRuns.IsUnknown() {
+			t.Errorf("expected unknown values, got %s and %s", plan.ScheduleCronUTC, plan.ScheduleNextRuns)
+		}
+	})
+
+	t.Run("combines the schedule windows", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("Europe/Paris", 7)
+		plan.ScheduleWindows = []types.String{types.StringValue("0 19 * * 1-5")}
+		if diags := planSchedule(plan, nil, winter); diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags.Errors())
+		}
+		if got := plan.ScheduleCronUTC.ValueString(); got != "0 6,18 * * 1,2,3,4,5" {
+			t.Errorf("unexpected UTC cron %q", got)
+		}
+	})
+
+	t.Run("schedule windows differing by more than one field", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("", 7)
+		plan.ScheduleWindows = []types.String{types.StringValue("30 19 * * 1-5")}
+		if diags := planSchedule(plan, nil, winter); !diags.HasError() {
+			t.Fatalf("expected an error, got %s", plan.ScheduleCronUTC)
+		}
+	})
+
+	t.Run("disables the schedule during an apply blackout", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("Europe/Paris", 7)
+		plan.ScheduleApplyBlackouts = []JobScheduleApplyBlackout{{
+			Start: types.StringValue("2026-01-14T00:00:00+01:00"),
+			End:   types.StringValue("2026-01-16T00:00:00+01:00"),
+		}}
+		diags := planSchedule(plan, nil, winter)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags.Errors())
+		}
+		if !plan.ScheduleInApplyBlackout.ValueBool() {
+			t.Errorf("expected the job to be in a blackout")
+		}
+		if !plan.ScheduleNextRuns.IsNull() {
+			t.Errorf("expected no next runs while the schedule trigger is disabled, got %v", plan.ScheduleNextRuns)
+		}
+		if diags.WarningsCount() != 1 {
+			t.Errorf("expected a warning about the next apply, got %v", diags)
+		}
+
+		after := scheduleModel("Europe/Paris", 7)
+		after.ScheduleApplyBlackouts = plan.ScheduleApplyBlackouts
+		planSchedule(after, plan, winter.Add(48*time.Hour))
+		if after.ScheduleInApplyBlackout.ValueBool() {
+			t.Errorf("expected the schedule to be enabled again after the blackout")
+		}
+	})
+
+	t.Run("warns about the next runs during an apply blackout", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("Europe/Paris", 7)
+		plan.ScheduleApplyBlackouts = []JobScheduleApplyBlackout{{
+			Start: types.StringValue("2026-01-16T00:00:00+01:00"),
+			End:   types.StringValue("2026-01-20T00:00:00+01:00"),
+		}}
+		diags := planSchedule(plan, nil, winter)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags.Errors())
+		}
+		if plan.ScheduleInApplyBlackout.ValueBool() {
+			t.Errorf("expected the job not to be in a blackout")
+		}
+		// dbt Cloud still runs the job during the blackout unless it is applied then
+		want := []string{
+			"2026-01-15T07:00:00+01:00",
+			"2026-01-16T07:00:00+01:00",
+			"2026-01-19T07:00:00+01:00",
+			"2026-01-20T07:00:00+01:00",
+			"2026-01-21T07:00:00+01:00",
+		}
+		if got := nextRunsOf(t, plan.ScheduleNextRuns); !slices.Equal(got, want) {
+			t.Errorf("unexpected next runs %v, want %v", got, want)
+		}
+		warnings := diags.Warnings()
+		if len(warnings) != 1 ||
+			!strings.Contains(warnings[0].Detail(), "2026-01-16T07:00:00+01:00, 2026-01-19T07:00:00+01:00") {
+			t.Errorf("expected a warning about the runs during the blackout, got %v", warnings)
+		}
+	})
+
+	t.Run("invalid apply blackout", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("", 7)
+		plan.ScheduleApplyBlackouts = []JobScheduleApplyBlackout{{
+			Start: types.StringValue("2026-01-16T00:00:00Z"),
+			End:   types.StringValue("2026-01-14"),
+		}}
+		if diags := planSchedule(plan, nil, winter); !diags.HasError() {
+			t.Fatalf("expected an error")
+		}
+	})
+
+	t.Run("schedule spanning two UTC days", func(t *testing.T) {
+		t.Parallel()
+
+		plan := scheduleModel("Asia/Tokyo", 7, 12)
+		if diags := planSchedule(plan, nil, winter); !diags.HasError() {
+			t.Fatalf("expected an error, got %s", plan.ScheduleCronUTC)
+		}
+	})
+}
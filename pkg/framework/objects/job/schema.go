@@ -4,6 +4,7 @@ import (
 	"context"
 
 	job_validators "github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -429,6 +431,50 @@ func (j *jobResource) Schema(
 					),
 				},
 			},
+			"schedule_timezone": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "IANA timezone (e.g. `Europe/Paris`) in which `schedule_hours`, `schedule_days`, `schedule_cron` and `schedule_windows` are expressed. dbt Cloud only supports schedules in UTC, so the schedule is converted to the UTC cron expression shown in `schedule_cron_utc` and updated when daylight saving time changes the UTC offset. Defaults to UTC",
+				Validators: []validator.String{
+					job_validators.ScheduleTimezoneValidator(),
+				},
+			},
+			"schedule_windows": resource_schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional cron expressions, in `schedule_timezone` when it is set, at which the job also runs, e.g. `[\"0 18 * * 1-5\"]` to run a job scheduled at 8:00 on weekdays at 18:00 as well. dbt Cloud holds a single schedule per job, so the windows are combined with the schedule into the cron expression shown in `schedule_cron_utc`, which is only possible when they differ from the schedule by a single field",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"schedule_apply_blackouts": resource_schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Apply-time only: periods during which Terraform disables the schedule trigger of the job, e.g. during a code freeze. dbt Cloud doesn't know about the blackouts, so they only take effect when `terraform apply` runs during each blackout, which disables the schedule trigger, and again after it, which enables it back, for example from a pipeline scheduled at the start and the end of each period. Until then, dbt Cloud keeps running the job on its schedule: the plan warns when the runs of `schedule_next_runs` fall inside a blackout, and while a blackout disables the schedule trigger",
+				NestedObject: resource_schema.NestedAttributeObject{
+					Attributes: map[string]resource_schema.Attribute{
+						"start": resource_schema.StringAttribute{
+							Required:    true,
+							Description: "Start of the blackout, as an RFC 3339 timestamp (e.g. `2026-12-21T00:00:00+01:00`)",
+						},
+						"end": resource_schema.StringAttribute{
+							Required:    true,
+							Description: "End of the blackout, excluded, as an RFC 3339 timestamp (e.g. `2027-01-04T00:00:00+01:00`)",
+						},
+					},
+				},
+			},
+			"schedule_in_apply_blackout": resource_schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the job was last planned during one of `schedule_apply_blackouts`, in which case its schedule trigger is disabled in dbt Cloud until the next apply after the blackout, while `triggers.schedule` keeps its configured value",
+			},
+			"schedule_cron_utc": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The schedule of the job as the UTC cron expression sent to dbt Cloud",
+			},
+			"schedule_next_runs": resource_schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Preview of the next 5 runs of the job, as RFC 3339 timestamps in `schedule_timezone`. It is computed at plan time when the schedule changes, so that the plan shows when a new schedule takes effect. Null when `triggers.schedule` is `false` or when the job is planned during one of `schedule_apply_blackouts`",
+			},
 			"run_compare_changes": resource_schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -494,13 +540,14 @@ func (j *jobResource) Schema(
 				Description: "List of commands to execute for the job",
 				Validators: []validator.List{
 					listvalidator.SizeAtLeast(1),
+					job_validators.ExecuteStepsValidator(),
 				},
 			},
 			"validate_execute_steps": resource_schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
-				Description: "When set to `true`, the provider will validate the `execute_steps` during plan time to ensure they contain valid dbt commands. If a command is not recognized (e.g., a new dbt command not yet supported by the provider), the validation will fail. Defaults to `false` to allow flexibility with newer dbt commands.",
+				Description: "When set to `true`, the provider will validate the `execute_steps` during plan time to ensure they are valid dbt commands: known command, flags supported by the command and by the `dbt_version` of the job, valid `--select`/`--exclude` selectors, valid YAML for `--vars` and no conflicting or repeated flags. If a command or flag is not recognized (e.g., a new dbt command not yet supported by the provider), the validation will fail. Defaults to `false` to allow flexibility with newer dbt commands.",
 			},
 			"is_active": resource_schema.BoolAttribute{
 				Optional:    true,
@@ -658,7 +705,7 @@ func (j *jobResource) Schema(
 		},
 		Blocks: map[string]resource_schema.Block{
 			"job_completion_trigger_condition": resource_schema.ListNestedBlock{
-				Description: "Which other job should trigger this job when it finishes, and on which conditions (sometimes referred as 'job chaining').",
+				Description: "Which other job should trigger this job when it finishes, and on which conditions (sometimes referred as 'job chaining'). A condition making the job trigger itself through other jobs is rejected at plan time.",
 				NestedObject: resource_schema.NestedBlockObject{
 					Attributes: map[string]resource_schema.Attribute{
 						"job_id": resource_schema.Int64Attribute{
@@ -677,6 +724,27 @@ func (j *jobResource) Schema(
 					},
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (j *jobResource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "The ID of the job",
+			},
 		},
 	}
 }
@@ -0,0 +1,94 @@
+package job_validators
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestDbtCLIGrammar checks that the grammar only references flags and flag
+// sets it defines
+func TestDbtCLIGrammar(t *testing.T) {
+	t.Parallel()
+
+	if dbtCLI.Version < 1 {
+		t.Errorf("the grammar needs a version, got %d", dbtCLI.Version)
+	}
+
+	checkFlag := func(context, name string) {
+		if _, ok := dbtCLI.Flags[name]; !ok {
+			t.Errorf("%s references the undefined flag %s", context, name)
+		}
+	}
+	for _, name := range dbtCLI.GlobalFlags {
+		checkFlag("global_flags", name)
+	}
+	for set, names := range dbtCLI.FlagSets {
+		for _, name := range names {
+			checkFlag("the flag set "+set, name)
+		}
+	}
+	for _, conflict := range dbtCLI.Conflicts {
+		if len(conflict) != 2 {
+			t.Errorf("conflicts must be pairs of flags, got %v", conflict)
+			continue
+		}
+		checkFlag("conflicts", conflict[0])
+		checkFlag("conflicts", conflict[1])
+	}
+
+	validTypes := []string{"bool", "string", "int", "choice", "yaml", "list", "selection"}
+	for name, flag := range dbtCLI.Flags {
+		if !strings.HasPrefix(name, "--") {
+			t.Errorf("the flag %s must be defined with its long name", name)
+		}
+		if !slices.Contains(validTypes, flag.Type) {
+			t.Errorf("the flag %s has the unknown type %q", name, flag.Type)
+		}
+		if flag.Type == "choice" && len(flag.Choices) == 0 {
+			t.Errorf("the flag %s needs choices", name)
+		}
+	}
+
+	for commandName, command := range dbtCLI.Commands {
+		for _, name := range command.Flags {
+			if set, ok := strings.CutPrefix(name, "@"); ok {
+				if _, ok := dbtCLI.FlagSets[set]; !ok {
+					t.Errorf("the command %s references the undefined flag set %s", commandName, set)
+				}
+				continue
+			}
+			if _, ok := command.Overrides[name]; !ok {
+				checkFlag("the command "+commandName, name)
+			}
+		}
+		if command.FreeForm && len(command.Flags) > 0 {
+			t.Errorf("the free form command %s can't define flags", commandName)
+		}
+	}
+}
+
+func TestSupports(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dbtVersion string
+		since      string
+		want       bool
+	}{
+		{dbtVersion: "", since: "1.8", want: true},
+		{dbtVersion: "latest", since: "1.10", want: true},
+		{dbtVersion: "latest-fusion", since: "1.10", want: true},
+		{dbtVersion: "1.7.0-latest", since: "", want: true},
+		{dbtVersion: "1.7.0-latest", since: "1.8", want: false},
+		{dbtVersion: "1.8.0-pre", since: "1.8", want: true},
+		{dbtVersion: "1.10.0-latest", since: "1.9", want: true},
+		{dbtVersion: "1.9.0-latest", since: "1.10", want: false},
+		{dbtVersion: "2.0.0-latest", since: "1.10", want: true},
+	}
+	for _, tc := range tests {
+		if got := supports(tc.dbtVersion, tc.since); got != tc.want {
+			t.Errorf("supports(%q, %q) = %t, want %t", tc.dbtVersion, tc.since, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,486 @@
+package job_validators
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// dbtCLIData is the grammar of the dbt CLI, kept as data so that supporting a
+// new command or flag doesn't require changing the parser
+//
+//go:embed dbt_cli.json
+var dbtCLIData []byte
+
+type dbtCLIFlag struct {
+	// Type is one of bool, string, int, choice, yaml, list or selection, the
+	// last two accepting multiple values
+	Type      string   `json:"type"`
+	Aliases   []string `json:"aliases"`
+	Negatable bool     `json:"negatable"`
+	Choices   []string `json:"choices"`
+	Since     string   `json:"since"`
+}
+
+type dbtCLICommand struct {
+	Flags     []string              `json:"flags"`
+	Overrides map[string]dbtCLIFlag `json:"overrides"`
+	Arguments []string              `json:"arguments"`
+	FreeForm  bool                  `json:"free_form"`
+	Since     string                `json:"since"`
+	// Deprecated explains how to replace a command that is still accepted
+	Deprecated string `json:"deprecated"`
+}
+
+type dbtCLISelectionMethod struct {
+	Values      []string `json:"values"`
+	RequiresKey bool     `json:"requires_key"`
+	Since       string   `json:"since"`
+}
+
+type dbtCLIGrammar struct {
+	Version          int                              `json:"version"`
+	GlobalFlags      []string                         `json:"global_flags"`
+	FlagSets         map[string][]string              `json:"flag_sets"`
+	Flags            map[string]dbtCLIFlag            `json:"flags"`
+	Commands         map[string]dbtCLICommand         `json:"commands"`
+	Conflicts        [][]string                       `json:"conflicts"`
+	SelectionMethods map[string]dbtCLISelectionMethod `json:"selection_methods"`
+}
+
+var dbtCLI = mustLoadDbtCLIGrammar(dbtCLIData)
+
+func mustLoadDbtCLIGrammar(data []byte) *dbtCLIGrammar {
+	var grammar dbtCLIGrammar
+	if err := json.Unmarshal(data, &grammar); err != nil {
+		panic(fmt.Sprintf("invalid dbt CLI grammar: %s", err))
+	}
+	return &grammar
+}
+
+// commandFlags returns the flags a command accepts, expanding the @flag_sets,
+// global flags included
+func (g *dbtCLIGrammar) commandFlags(command dbtCLICommand) []string {
+	flags := slices.Clone(g.GlobalFlags)
+	for _, flag := range command.Flags {
+		if set, ok := strings.CutPrefix(flag, "@"); ok {
+			flags = append(flags, g.FlagSets[set]...)
+		} else {
+			flags = append(flags, flag)
+		}
+	}
+	return flags
+}
+
+// flag returns the definition of a flag for a command, taking its overrides
+// into account
+func (g *dbtCLIGrammar) flag(command *dbtCLICommand, name string) (dbtCLIFlag, bool) {
+	if command != nil {
+		if flag, ok := command.Overrides[name]; ok {
+			return flag, true
+		}
+	}
+	flag, ok := g.Flags[name]
+	return flag, ok
+}
+
+// resolveFlag returns the canonical name of a flag given as an alias or as its
+// negation, e.g. --no-partial-parse, within the flags that are accepted
+func (g *dbtCLIGrammar) resolveFlag(command *dbtCLICommand, accepted []string, name string) (string, bool) {
+	for _, canonical := range accepted {
+		flag, ok := g.flag(command, canonical)
+		if !ok {
+			continue
+		}
+		if name == canonical || slices.Contains(flag.Aliases, name) {
+			return canonical, true
+		}
+		if flag.Negatable && name == "--no-"+strings.TrimPrefix(canonical, "--") {
+			return canonical, true
+		}
+	}
+	return "", false
+}
+
+// allowedCommands returns the sorted names of the commands, the deprecated ones
+// excluded
+func (g *dbtCLIGrammar) allowedCommands() []string {
+	commands := []string{}
+	for _, name := range slices.Sorted(maps.Keys(g.Commands)) {
+		if g.Commands[name].Deprecated == "" {
+			commands = append(commands, name)
+		}
+	}
+	return commands
+}
+
+// isKnownFlag reports whether a flag exists for any of the commands
+func (g *dbtCLIGrammar) isKnownFlag(name string) bool {
+	isFlag := func(canonical string, flag dbtCLIFlag) bool {
+		return name == canonical ||
+			slices.Contains(flag.Aliases, name) ||
+			(flag.Negatable && name == "--no-"+strings.TrimPrefix(canonical, "--"))
+	}
+	for canonical, flag := range g.Flags {
+		if isFlag(canonical, flag) {
+			return true
+		}
+	}
+	for _, command := range g.Commands {
+		for canonical, flag := range command.Overrides {
+			if isFlag(canonical, flag) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var dbtMinorVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)\.0-(latest|pre)$`)
+
+// supports reports whether a feature available since a dbt minor version, e.g.
+// 1.8, can be used with the dbt_version of the job. Release tracks like
+// latest are always up to date.
+func supports(dbtVersion, since string) bool {
+	if since == "" {
+		return true
+	}
+	match := dbtMinorVersionPattern.FindStringSubmatch(dbtVersion)
+	if match == nil {
+		return true
+	}
+	sinceMajor, sinceMinor, _ := strings.Cut(since, ".")
+	return compareVersionPart(match[1], sinceMajor) > 0 ||
+		(compareVersionPart(match[1], sinceMajor) == 0 && compareVersionPart(match[2], sinceMinor) >= 0)
+}
+
+func compareVersionPart(a, b string) int {
+	aInt, _ := strconv.Atoi(a)
+	bInt, _ := strconv.Atoi(b)
+	return aInt - bInt
+}
+
+// criterionPattern splits a selection criterion like `2+config.materialized:table+`
+// into its graph operators, method and value
+var criterionPattern = regexp.MustCompile(`^(@)?((\d*)\+)?(([\w.]+):)?(.*?)(\+(\d*))?$`)
+
+// stepParser checks a single step
+type stepParser struct {
+	step        string
+	dbtVersion  string
+	commandName string
+	command     *dbtCLICommand
+	accepted    []string
+	seen        map[string]bool
+}
+
+// ValidateExecuteStep checks that a step is a valid dbt CLI invocation for the
+// dbt_version of the job, which can be empty, and returns an error naming the
+// invalid token otherwise
+func ValidateExecuteStep(step string, dbtVersion string) error {
+	_, err := validateExecuteStep(step, dbtVersion)
+	return err
+}
+
+// validateExecuteStep checks a step like ValidateExecuteStep, and returns how
+// to replace its command when it is deprecated
+func validateExecuteStep(step string, dbtVersion string) (string, error) {
+	args, err := helper.SplitCommandLine(step)
+	if err != nil {
+		return "", fmt.Errorf("invalid command %q: %w", step, err)
+	}
+	if len(args) == 0 || args[0] != "dbt" {
+		return "", fmt.Errorf("invalid command %q: steps must start with `dbt`", step)
+	}
+
+	p := &stepParser{
+		step:       step,
+		dbtVersion: dbtVersion,
+		accepted:   dbtCLI.GlobalFlags,
+		seen:       map[string]bool{},
+	}
+
+	// global flags can come before the command
+	i := 1
+	for i < len(args) && strings.HasPrefix(args[i], "-") {
+		if i, err = p.parseFlag(args, i); err != nil {
+			return "", err
+		}
+	}
+	if i == len(args) {
+		return "", fmt.Errorf("invalid command %q: the dbt command is missing", step)
+	}
+
+	// two words commands take precedence over the deprecated ones, e.g. source
+	commandName := args[i]
+	command, ok := dbtCLI.Commands[commandName]
+	if i+1 < len(args) {
+		if twoWordsCommand, twoWords := dbtCLI.Commands[commandName+" "+args[i+1]]; twoWords {
+			command, ok = twoWordsCommand, true
+			i++
+			commandName += " " + args[i]
+		}
+	}
+	if !ok {
+		return "", fmt.Errorf(
+			"invalid command %q in %q. Allowed commands are: %s",
+			commandName,
+			step,
+			strings.Join(dbtCLI.allowedCommands(), ", "),
+		)
+	}
+	if !supports(dbtVersion, command.Since) {
+		return "", fmt.Errorf(
+			"the command `dbt %s` in %q requires dbt %s or later, the job uses %s",
+			commandName,
+			step,
+			command.Since,
+			dbtVersion,
+		)
+	}
+	if command.FreeForm {
+		return command.Deprecated, nil
+	}
+
+	p.commandName = commandName
+	p.command = &command
+	p.accepted = dbtCLI.commandFlags(command)
+	arguments := 0
+	for i++; i < len(args); {
+		if strings.HasPrefix(args[i], "-") {
+			if i, err = p.parseFlag(args, i); err != nil {
+				return "", err
+			}
+			continue
+		}
+		if arguments == len(command.Arguments) {
+			return "", fmt.Errorf("unexpected argument %q for `dbt %s` in %q", args[i], commandName, step)
+		}
+		arguments++
+		i++
+	}
+	if arguments < len(command.Arguments) {
+		return "", fmt.Errorf("`dbt %s` needs a %s in %q", commandName, command.Arguments[arguments], step)
+	}
+
+	for _, conflict := range dbtCLI.Conflicts {
+		if p.seen[conflict[0]] && p.seen[conflict[1]] {
+			return "", fmt.Errorf("the flags %s and %s can't be used together in %q", conflict[0], conflict[1], step)
+		}
+	}
+	return command.Deprecated, nil
+}
+
+// parseFlag checks the flag at args[i] and its values, and returns the index
+// of the next argument
+func (p *stepParser) parseFlag(args []string, i int) (int, error) {
+	name, inlineValue, hasInlineValue := strings.Cut(args[i], "=")
+	canonical, ok := dbtCLI.resolveFlag(p.command, p.accepted, name)
+	if !ok {
+		if p.command != nil && dbtCLI.isKnownFlag(name) {
+			return 0, fmt.Errorf("the flag %s is not supported by `dbt %s` in %q", name, p.commandName, p.step)
+		}
+		return 0, fmt.Errorf("unknown flag %s in %q", name, p.step)
+	}
+	flag, _ := dbtCLI.flag(p.command, canonical)
+
+	if !supports(p.dbtVersion, flag.Since) {
+		return 0, fmt.Errorf(
+			"the flag %s in %q requires dbt %s or later, the job uses %s",
+			name,
+			p.step,
+			flag.Since,
+			p.dbtVersion,
+		)
+	}
+	multiple := flag.Type == "list" || flag.Type == "selection"
+	if p.seen[canonical] && !multiple {
+		return 0, fmt.Errorf("flag %s can only be used once per step in %q", canonical, p.step)
+	}
+	p.seen[canonical] = true
+	i++
+
+	values := []string{}
+	if hasInlineValue {
+		values = append(values, inlineValue)
+	}
+	switch {
+	case flag.Type == "bool":
+		if hasInlineValue {
+			return 0, fmt.Errorf("the flag %s doesn't take a value in %q", name, p.step)
+		}
+		return i, nil
+	case multiple:
+		for i < len(args) && !strings.HasPrefix(args[i], "-") {
+			values = append(values, args[i])
+			i++
+		}
+	case !hasInlineValue && i < len(args) && !strings.HasPrefix(args[i], "--"):
+		values = append(values, args[i])
+		i++
+	}
+	if len(values) == 0 {
+		return 0, fmt.Errorf("the flag %s needs a value in %q", name, p.step)
+	}
+
+	for _, value := range values {
+		if err := p.checkValue(name, flag, value); err != nil {
+			return 0, err
+		}
+	}
+	return i, nil
+}
+
+func (p *stepParser) checkValue(name string, flag dbtCLIFlag, value string) error {
+	switch flag.Type {
+	case "int":
+		if number, err := strconv.Atoi(value); err != nil || number < 1 {
+			return fmt.Errorf("the flag %s needs a positive number, got %q in %q", name, value, p.step)
+		}
+	case "yaml":
+		var parsed any
+		if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+			return fmt.Errorf("the flag %s needs a YAML dictionary, %q is not valid YAML in %q: %s", name, value, p.step, err)
+		}
+		if _, ok := parsed.(map[string]any); !ok {
+			return fmt.Errorf("the flag %s needs a YAML dictionary, got %q in %q", name, value, p.step)
+		}
+	case "selection":
+		for _, member := range strings.Fields(value) {
+			for _, criterion := range strings.Split(member, ",") {
+				if err := p.checkCriterion(criterion); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(flag.Choices) > 0 && !slices.Contains(flag.Choices, value) {
+		return fmt.Errorf(
+			"invalid value %q for the flag %s in %q, it must be one of %s",
+			value,
+			name,
+			p.step,
+			strings.Join(flag.Choices, ", "),
+		)
+	}
+	return nil
+}
+
+func (p *stepParser) checkCriterion(criterion string) error {
+	parts := criterionPattern.FindStringSubmatch(criterion)
+	if parts == nil || parts[6] == "" {
+		return fmt.Errorf("invalid selector %q in %q", criterion, p.step)
+	}
+	if parts[1] != "" && parts[2] != "" {
+		return fmt.Errorf(
+			"invalid selector %q in %q, the @ and + operators can't both be used before a selector",
+			criterion,
+			p.step,
+		)
+	}
+	if parts[5] == "" {
+		return nil
+	}
+
+	name, key, hasKey := strings.Cut(parts[5], ".")
+	method, ok := dbtCLI.SelectionMethods[name]
+	if !ok {
+		return fmt.Errorf("unknown selection method %q in the selector %q in %q", name, criterion, p.step)
+	}
+	if !supports(p.dbtVersion, method.Since) {
+		return fmt.Errorf(
+			"the selection method %q in %q requires dbt %s or later, the job uses %s",
+			name,
+			p.step,
+			method.Since,
+			p.dbtVersion,
+		)
+	}
+	if method.RequiresKey && (!hasKey || key == "") {
+		return fmt.Errorf("the selection method %q needs a key, e.g. %s.materialized, in %q", name, name, p.step)
+	}
+	if !method.RequiresKey && hasKey {
+		return fmt.Errorf("the selection method %q doesn't take a key, got %q in %q", name, parts[5], p.step)
+	}
+	if len(method.Values) > 0 && !slices.Contains(method.Values, parts[6]) {
+		return fmt.Errorf(
+			"invalid value %q for the selection method %q in %q, it must be one of %s",
+			parts[6],
+			name,
+			p.step,
+			strings.Join(method.Values, ", "),
+		)
+	}
+	return nil
+}
+
+var _ validator.List = executeStepsValidator{}
+
+type executeStepsValidator struct{}
+
+func (v executeStepsValidator) Description(ctx context.Context) string {
+	return "When validate_execute_steps is true, each step must be a valid dbt command for the dbt_version of the job"
+}
+
+func (v executeStepsValidator) MarkdownDescription(ctx context.Context) string {
+	return "When `validate_execute_steps` is `true`, each step must be a valid dbt command for the `dbt_version` of the job"
+}
+
+func (v executeStepsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var validateExecuteSteps types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validate_execute_steps"), &validateExecuteSteps)...)
+	if resp.Diagnostics.HasError() || !validateExecuteSteps.ValueBool() {
+		return
+	}
+
+	var dbtVersion types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("dbt_version"), &dbtVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		step, ok := element.(types.String)
+		if !ok || step.IsNull() || step.IsUnknown() {
+			continue
+		}
+		deprecation, err := validateExecuteStep(step.ValueString(), dbtVersion.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Error validating execute steps",
+				err.Error(),
+			)
+			continue
+		}
+		if deprecation != "" {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path.AtListIndex(i),
+				"Deprecated dbt command",
+				fmt.Sprintf("The step %q uses a deprecated command: %s", step.ValueString(), deprecation),
+			)
+		}
+	}
+}
+
+// ExecuteStepsValidator validates the execute_steps of a job against the
+// grammar of the dbt CLI, when validate_execute_steps is set
+func ExecuteStepsValidator() validator.List {
+	return executeStepsValidator{}
+}
@@ -0,0 +1,175 @@
+package job_validators_test
+
+import (
+	"strings"
+	"testing"
+
+	job_validators "github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job/validators"
+)
+
+func TestValidateExecuteStep(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		step       string
+		dbtVersion string
+		// expectError is a part of the expected error, empty when the step is valid
+		expectError string
+	}{
+		"simple command":           {step: "dbt run"},
+		"global flags":             {step: "dbt --warn-error --fail-fast run"},
+		"global flag after":        {step: "dbt build --fail-fast"},
+		"short aliases":            {step: "dbt -d run -s my_model -t prod"},
+		"negated flag":             {step: "dbt --no-partial-parse build"},
+		"two words command":        {step: "dbt docs generate --no-compile"},
+		"source freshness output":  {step: "dbt source freshness -o target/sources.json"},
+		"multi-line step":          {step: "dbt build\n  --select tag:nightly\r\n  --exclude config.materialized:view"},
+		"multiple selectors":       {step: `dbt build --select "state:modified+ @orders" 2+tag:finance,package:shop --exclude source:raw.*`},
+		"repeated select":          {step: "dbt run --select a --select b"},
+		"inline values":            {step: "dbt run --threads=8 --select=my_model"},
+		"vars":                     {step: `dbt run --vars '{"start_date": "2024-01-01", "days": 3}'`},
+		"vars in YAML":             {step: `dbt run --vars "start_date: 2024-01-01"`},
+		"run-operation":            {step: `dbt run-operation grant_select --args '{role: reporter}'`},
+		"free form command":        {step: "dbt sl list metrics --anything"},
+		"deprecated archive":       {step: "dbt archive"},
+		"deprecated source":        {step: "dbt source snapshot-freshness --select source:raw"},
+		"resource types":           {step: "dbt build --resource-type model seed --exclude-resource-type unit_test"},
+		"empty with recent dbt":    {step: "dbt run --empty", dbtVersion: "1.8.0-latest"},
+		"empty with release track": {step: "dbt run --empty", dbtVersion: "latest"},
+		"not dbt": {
+			step:        "invalid command",
+			expectError: "invalid command",
+		},
+		"unknown command": {
+			step:        "dbt runn",
+			expectError: `invalid command "runn"`,
+		},
+		"missing command": {
+			step:        "dbt --warn-error",
+			expectError: "the dbt command is missing",
+		},
+		"duplicate flag": {
+			step:        "dbt --warn-error --warn-error run",
+			expectError: "flag --warn-error can only be used once",
+		},
+		"flag and its negation": {
+			step:        "dbt run --defer --no-defer",
+			expectError: "flag --defer can only be used once",
+		},
+		"unknown flag": {
+			step:        "dbt run --ful-refresh",
+			expectError: "unknown flag --ful-refresh",
+		},
+		"full refresh on test": {
+			step:        "dbt test --full-refresh",
+			expectError: "the flag --full-refresh is not supported by `dbt test`",
+		},
+		"short flag of another command": {
+			step:        "dbt run -o out.json",
+			expectError: "the flag -o is not supported by `dbt run`",
+		},
+		"missing value": {
+			step:        "dbt run --target --full-refresh",
+			expectError: "the flag --target needs a value",
+		},
+		"empty select": {
+			step:        "dbt run --select --full-refresh",
+			expectError: "the flag --select needs a value",
+		},
+		"bool with a value": {
+			step:        "dbt run --full-refresh=true",
+			expectError: "the flag --full-refresh doesn't take a value",
+		},
+		"invalid threads": {
+			step:        "dbt run --threads many",
+			expectError: `the flag --threads needs a positive number, got "many"`,
+		},
+		"invalid choice": {
+			step:        "dbt build --indirect-selection lazy",
+			expectError: `invalid value "lazy" for the flag --indirect-selection`,
+		},
+		"invalid vars": {
+			step:        `dbt run --vars '{"start_date": '`,
+			expectError: "is not valid YAML",
+		},
+		"vars not a dictionary": {
+			step:        `dbt run --vars '[1, 2]'`,
+			expectError: "the flag --vars needs a YAML dictionary",
+		},
+		"unknown selection method": {
+			step:        "dbt run --select tags:daily",
+			expectError: `unknown selection method "tags"`,
+		},
+		"config without key": {
+			step:        "dbt run --select config:table",
+			expectError: `the selection method "config" needs a key`,
+		},
+		"invalid state": {
+			step:        "dbt build --select state:changed+",
+			expectError: `invalid value "changed" for the selection method "state"`,
+		},
+		"invalid graph operators": {
+			step:        "dbt run --select @+orders",
+			expectError: `invalid selector "@+orders"`,
+		},
+		"empty selector": {
+			step:        "dbt run --select tag:",
+			expectError: `invalid selector "tag:"`,
+		},
+		"select and selector": {
+			step:        "dbt build --select orders --selector nightly",
+			expectError: "the flags --select and --selector can't be used together",
+		},
+		"warn error options conflict": {
+			step:        `dbt --warn-error --warn-error-options '{"include": "all"}' run`,
+			expectError: "the flags --warn-error and --warn-error-options can't be used together",
+		},
+		"unexpected argument": {
+			step:        "dbt run my_model",
+			expectError: `unexpected argument "my_model" for ` + "`dbt run`",
+		},
+		"missing macro": {
+			step:        "dbt run-operation --args '{a: 1}'",
+			expectError: "`dbt run-operation` needs a macro",
+		},
+		"unclosed quote": {
+			step:        `dbt run --select "my_model`,
+			expectError: "unclosed",
+		},
+		"flag too recent": {
+			step:        "dbt run --empty",
+			dbtVersion:  "1.7.0-latest",
+			expectError: "the flag --empty in \"dbt run --empty\" requires dbt 1.8 or later, the job uses 1.7.0-latest",
+		},
+		"command too recent": {
+			step:        "dbt clone",
+			dbtVersion:  "1.5.0-latest",
+			expectError: "the command `dbt clone`",
+		},
+		"selection method too recent": {
+			step:        "dbt test --select unit_test:my_test",
+			dbtVersion:  "1.7.0-latest",
+			expectError: `the selection method "unit_test"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := job_validators.ValidateExecuteStep(tc.step, tc.dbtVersion)
+			if tc.expectError == "" {
+				if err != nil {
+					t.Fatalf("expected the step to be valid, got: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error containing %q, got none", tc.expectError)
+			}
+			if !strings.Contains(err.Error(), tc.expectError) {
+				t.Fatalf("expected an error containing %q, got: %s", tc.expectError, err)
+			}
+		})
+	}
+}
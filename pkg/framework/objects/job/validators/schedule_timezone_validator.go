@@ -0,0 +1,51 @@
+package job_validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &scheduleTimezoneValidator{}
+
+type scheduleTimezoneValidator struct{}
+
+func (v scheduleTimezoneValidator) Description(ctx context.Context) string {
+	return "value must be an IANA timezone name, e.g. Europe/Paris"
+}
+
+func (v scheduleTimezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be an IANA timezone name, e.g. `Europe/Paris`."
+}
+
+func (v scheduleTimezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	timezone := req.ConfigValue.ValueString()
+	// time.LoadLocation accepts "" and "Local", which depend on the machine running Terraform
+	if timezone == "" || timezone == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid schedule_timezone",
+			fmt.Sprintf("%q is not an IANA timezone name, e.g. Europe/Paris or America/New_York.", timezone),
+		)
+		return
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid schedule_timezone",
+			fmt.Sprintf("%q is not an IANA timezone name, e.g. Europe/Paris or America/New_York: %s", timezone, err),
+		)
+	}
+}
+
+// ScheduleTimezoneValidator returns a validator that ensures schedule_timezone
+// is a timezone name from the IANA database.
+func ScheduleTimezoneValidator() validator.String {
+	return scheduleTimezoneValidator{}
+}
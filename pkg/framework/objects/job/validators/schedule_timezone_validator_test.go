@@ -0,0 +1,50 @@
+package job_validators_test
+
+import (
+	"context"
+	"testing"
+
+	job_validators "github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScheduleTimezoneValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null is allowed":    {value: types.StringNull(), expectError: false},
+		"unknown is allowed": {value: types.StringUnknown(), expectError: false},
+		"UTC":                {value: types.StringValue("UTC"), expectError: false},
+		"IANA name":          {value: types.StringValue("America/New_York"), expectError: false},
+		"empty is rejected":  {value: types.StringValue(""), expectError: true},
+		"Local is rejected":  {value: types.StringValue("Local"), expectError: true},
+		"offset is rejected": {value: types.StringValue("+02:00"), expectError: true},
+		"unknown name":       {value: types.StringValue("Europe/Atlantis"), expectError: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("schedule_timezone"),
+				ConfigValue: tc.value,
+			}
+			resp := &validator.StringResponse{}
+
+			job_validators.ScheduleTimezoneValidator().ValidateString(context.Background(), req, resp)
+
+			if tc.expectError && !resp.Diagnostics.HasError() {
+				t.Fatalf("expected a validation error but got none")
+			}
+			if !tc.expectError && resp.Diagnostics.HasError() {
+				t.Fatalf("expected no validation error but got: %s", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
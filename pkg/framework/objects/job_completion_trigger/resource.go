@@ -18,6 +18,7 @@ var (
 	_ resource.Resource                = &jobCompletionTriggerResource{}
 	_ resource.ResourceWithConfigure   = &jobCompletionTriggerResource{}
 	_ resource.ResourceWithImportState = &jobCompletionTriggerResource{}
+	_ resource.ResourceWithModifyPlan  = &jobCompletionTriggerResource{}
 )
 
 func JobCompletionTriggerResource() resource.Resource {
@@ -67,7 +68,7 @@ func (r *jobCompletionTriggerResource) Create(
 	}
 
 	jobID := plan.JobID.ValueInt64()
-	job, err := r.client.GetJob(strconv.FormatInt(jobID, 10))
+	job, err := r.client.GetJob(ctx, strconv.FormatInt(jobID, 10))
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching job", err.Error())
 		return
@@ -87,7 +88,7 @@ func (r *jobCompletionTriggerResource) Create(
 		},
 	}
 
-	updatedJob, err := r.client.UpdateJob(strconv.FormatInt(jobID, 10), *job)
+	updatedJob, err := r.client.UpdateJob(ctx, strconv.FormatInt(jobID, 10), *job)
 	if err != nil {
 		resp.Diagnostics.AddError("Error setting job completion trigger", err.Error())
 		return
@@ -111,7 +112,7 @@ func (r *jobCompletionTriggerResource) Read(
 	}
 
 	jobID := state.JobID.ValueInt64()
-	job, err := r.client.GetJob(strconv.FormatInt(jobID, 10))
+	job, err := r.client.GetJob(ctx, strconv.FormatInt(jobID, 10))
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "job_completion_trigger") {
 			return
@@ -160,7 +161,7 @@ func (r *jobCompletionTriggerResource) Update(
 	}
 
 	jobID := plan.JobID.ValueInt64()
-	job, err := r.client.GetJob(strconv.FormatInt(jobID, 10))
+	job, err := r.client.GetJob(ctx, strconv.FormatInt(jobID, 10))
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching job", err.Error())
 		return
@@ -180,7 +181,7 @@ func (r *jobCompletionTriggerResource) Update(
 		},
 	}
 
-	_, err = r.client.UpdateJob(strconv.FormatInt(jobID, 10), *job)
+	_, err = r.client.UpdateJob(ctx, strconv.FormatInt(jobID, 10), *job)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating job completion trigger", err.Error())
 		return
@@ -201,7 +202,7 @@ func (r *jobCompletionTriggerResource) Delete(
 	}
 
 	jobID := state.JobID.ValueInt64()
-	job, err := r.client.GetJob(strconv.FormatInt(jobID, 10))
+	job, err := r.client.GetJob(ctx, strconv.FormatInt(jobID, 10))
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "job_completion_trigger") {
 			return
@@ -212,13 +213,67 @@ func (r *jobCompletionTriggerResource) Delete(
 
 	job.JobCompletionTrigger = nil
 
-	_, err = r.client.UpdateJob(strconv.FormatInt(jobID, 10), *job)
+	_, err = r.client.UpdateJob(ctx, strconv.FormatInt(jobID, 10), *job)
 	if err != nil {
 		resp.Diagnostics.AddError("Error removing job completion trigger", err.Error())
 		return
 	}
 }
 
+// ModifyPlan rejects a trigger making the downstream job trigger itself
+// through other jobs, only checked when the jobs change to avoid reading all
+// the jobs of the account on every plan
+func (r *jobCompletionTriggerResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// the client is not configured yet when the provider configuration is unknown
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan JobCompletionTriggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.JobID.IsUnknown() || plan.TriggerJobID.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state JobCompletionTriggerResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.JobID.Equal(plan.JobID) && state.TriggerJobID.Equal(plan.TriggerJobID) {
+			return
+		}
+	}
+
+	cycle, err := r.client.JobCompletionTriggerCycle(
+		ctx,
+		int(plan.JobID.ValueInt64()),
+		int(plan.TriggerJobID.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("trigger_job_id"),
+			"Unable to check the job completion trigger for cycles",
+			err.Error(),
+		)
+		return
+	}
+	if cycle != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("trigger_job_id"),
+			"Job completion trigger creating a cycle",
+			fmt.Sprintf("The job would trigger itself in a loop: %s.", cycle),
+		)
+	}
+}
+
 func (r *jobCompletionTriggerResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
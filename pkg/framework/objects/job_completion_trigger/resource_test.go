@@ -0,0 +1,40 @@
+package job_completion_trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestModifyPlanWithoutClient checks that the cycle check is skipped when the
+// provider configuration is unknown at plan time and the client is not set
+func TestModifyPlanWithoutClient(t *testing.T) {
+	ctx := context.Background()
+	schemaType := resourceSchema.Type().TerraformType(ctx)
+
+	plan := tfsdk.Plan{Schema: resourceSchema, Raw: tftypes.NewValue(schemaType, nil)}
+	diags := plan.Set(ctx, JobCompletionTriggerResourceModel{
+		ID:           types.Int64Unknown(),
+		JobID:        types.Int64Value(1),
+		TriggerJobID: types.Int64Value(2),
+		ProjectID:    types.Int64Value(3),
+		Statuses:     types.SetValueMust(types.StringType, nil),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error building the plan: %v", diags)
+	}
+
+	req := resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(schemaType, nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	(&jobCompletionTriggerResource{}).ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+}
@@ -26,7 +26,7 @@ var resourceSchema = resource_schema.Schema{
 		},
 		"trigger_job_id": resource_schema.Int64Attribute{
 			Required:    true,
-			Description: "The ID of the upstream job whose completion fires this trigger.",
+			Description: "The ID of the upstream job whose completion fires this trigger. A trigger making the downstream job trigger itself through other jobs is rejected at plan time.",
 		},
 		"project_id": resource_schema.Int64Attribute{
 			Required:    true,
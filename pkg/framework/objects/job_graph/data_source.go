@@ -0,0 +1,124 @@
+package job_graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &jobGraphDataSource{}
+	_ datasource.DataSourceWithConfigure = &jobGraphDataSource{}
+)
+
+func JobGraphDataSource() datasource.DataSource {
+	return &jobGraphDataSource{}
+}
+
+type jobGraphDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *jobGraphDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_job_graph"
+}
+
+func (d *jobGraphDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasourceSchema
+}
+
+func (d *jobGraphDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state JobGraphDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiJobs, err := d.client.GetAllAccountJobs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Issue when retrieving jobs", err.Error())
+		return
+	}
+	jobs := make([]dbt_cloud.Job, len(apiJobs))
+	for i, job := range apiJobs {
+		jobs[i] = job.Job
+	}
+	graph := dbt_cloud.NewJobGraph(jobs)
+
+	state.Edges = []EdgeDataSourceModel{}
+	for _, edge := range graph.Edges() {
+		statuses := make([]types.String, 0, len(edge.Statuses))
+		for _, status := range edge.Statuses {
+			if name, ok := utils.JobCompletionTriggerConditionsMappingCodeHuman[status]; ok {
+				statuses = append(statuses, types.StringValue(name.(string)))
+			} else {
+				statuses = append(statuses, types.StringValue(strconv.Itoa(status)))
+			}
+		}
+		state.Edges = append(state.Edges, EdgeDataSourceModel{
+			UpstreamJobID:     types.Int64Value(int64(edge.UpstreamJobID)),
+			UpstreamProjectID: types.Int64Value(int64(edge.UpstreamProjectID)),
+			DownstreamJobID:   types.Int64Value(int64(edge.DownstreamJobID)),
+			Statuses:          statuses,
+		})
+	}
+
+	state.TopologicalOrder = helper.SliceIntToSliceTypesInt64(graph.TopologicalOrder())
+
+	state.Cycles = []CycleDataSourceModel{}
+	for _, cycle := range graph.Cycles() {
+		description := graph.DescribeCycle(cycle)
+		state.Cycles = append(state.Cycles, CycleDataSourceModel{
+			JobIDs: helper.SliceIntToSliceTypesInt64(cycle),
+			Error:  types.StringValue("job completion triggers form a cycle: " + description),
+		})
+
+		summary := "Job completion triggers forming a cycle"
+		detail := fmt.Sprintf(
+			"The jobs trigger each other in a loop: %s. Remove the completion trigger of one of them.",
+			description,
+		)
+		if state.FailOnCycle.ValueBool() {
+			resp.Diagnostics.AddError(summary, detail)
+		} else {
+			resp.Diagnostics.AddWarning(summary, detail)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(strconv.FormatInt(d.client.AccountID, 10))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *jobGraphDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
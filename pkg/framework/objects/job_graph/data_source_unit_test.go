@@ -0,0 +1,133 @@
+package job_graph_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func completionTrigger(jobID, projectID int) map[string]interface{} {
+	return map[string]interface{}{
+		"condition": map[string]interface{}{
+			"job_id":     jobID,
+			"project_id": projectID,
+			"statuses":   []int{10, 20},
+		},
+	}
+}
+
+// testJobGraphMockServer returns a mock of the API with the project 1, where the
+// job 2 is triggered by the job 1, and the project 2, where its job 3 is
+// triggered by the job 2 and, when withCycle is set, the job 1 by the job 3
+func testJobGraphMockServer(t *testing.T, withCycle bool) *testhelpers.MockServer {
+	var job1Trigger interface{}
+	if withCycle {
+		job1Trigger = completionTrigger(3, 2)
+	}
+
+	jobsByProject := map[string][]map[string]interface{}{
+		"1": {
+			{"id": 1, "project_id": 1, "name": "Sources", "job_completion_trigger_condition": job1Trigger},
+			{"id": 2, "project_id": 1, "name": "Staging", "job_completion_trigger_condition": completionTrigger(1, 1)},
+		},
+		"2": {
+			{"id": 3, "project_id": 2, "name": "Marts", "job_completion_trigger_condition": completionTrigger(2, 1)},
+		},
+	}
+
+	return testhelpers.SetupMockServer(t, map[string]testhelpers.MockEndpointHandler{
+		"GET /v3/accounts/123/projects/": func(r *http.Request) (int, interface{}, error) {
+			return http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"id": 1, "name": "Ingestion"},
+					{"id": 2, "name": "Analytics"},
+				},
+				"extra": map[string]interface{}{"pagination": map[string]interface{}{"count": 2, "total_count": 2}},
+			}, nil
+		},
+		"GET /v2/accounts/123/jobs": func(r *http.Request) (int, interface{}, error) {
+			jobs := jobsByProject[r.URL.Query().Get("project_id")]
+			return http.StatusOK, map[string]interface{}{
+				"data":  jobs,
+				"extra": map[string]interface{}{"pagination": map[string]interface{}{"count": len(jobs), "total_count": len(jobs)}},
+			}, nil
+		},
+	})
+}
+
+func jobGraphConfig(url string, failOnCycle bool) string {
+	return fmt.Sprintf(`
+provider "dbtcloud" {
+  host_url   = "%s"
+  token      = "test-token"
+  account_id = 123
+}
+
+data "dbtcloud_job_graph" "test" {
+  fail_on_cycle = %t
+}
+`, url, failOnCycle)
+}
+
+func TestJobGraphDataSource(t *testing.T) {
+	mockServer := testJobGraphMockServer(t, false)
+	defer mockServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: jobGraphConfig(mockServer.URL, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "id", "123"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.#", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.0.upstream_job_id", "1"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.0.downstream_job_id", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.0.statuses.0", "success"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.0.statuses.1", "error"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.1.upstream_project_id", "1"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.1.downstream_job_id", "3"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "topological_order.#", "3"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "topological_order.0", "1"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "topological_order.2", "3"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "cycles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestJobGraphDataSourceCycle(t *testing.T) {
+	mockServer := testJobGraphMockServer(t, true)
+	defer mockServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: jobGraphConfig(mockServer.URL, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "edges.#", "3"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "topological_order.#", "0"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "cycles.#", "1"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "cycles.0.job_ids.#", "3"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_graph.test", "cycles.0.job_ids.1", "2"),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_job_graph.test",
+						"cycles.0.error",
+						"job completion triggers form a cycle: Sources (1) → Staging (2) → Marts (3) → Sources (1)",
+					),
+				),
+			},
+			{
+				Config:      jobGraphConfig(mockServer.URL, true),
+				ExpectError: regexp.MustCompile(`Sources \(1\) → Staging \(2\) → Marts \(3\) → Sources\s+\(1\)`),
+			},
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package job_graph
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type JobGraphDataSourceModel struct {
+	ID               types.String           `tfsdk:"id"`
+	FailOnCycle      types.Bool             `tfsdk:"fail_on_cycle"`
+	Edges            []EdgeDataSourceModel  `tfsdk:"edges"`
+	TopologicalOrder []types.Int64          `tfsdk:"topological_order"`
+	Cycles           []CycleDataSourceModel `tfsdk:"cycles"`
+}
+
+type EdgeDataSourceModel struct {
+	UpstreamJobID     types.Int64    `tfsdk:"upstream_job_id"`
+	UpstreamProjectID types.Int64    `tfsdk:"upstream_project_id"`
+	DownstreamJobID   types.Int64    `tfsdk:"downstream_job_id"`
+	Statuses          []types.String `tfsdk:"statuses"`
+}
+
+type CycleDataSourceModel struct {
+	JobIDs []types.Int64 `tfsdk:"job_ids"`
+	Error  types.String  `tfsdk:"error"`
+}
@@ -0,0 +1,72 @@
+package job_graph
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var datasourceSchema = schema.Schema{
+	Description: helper.DocString(
+		`Retrieves the graph of the jobs of the account, where the edges are the job completion triggers set with ~~~job_completion_trigger_condition~~~ in ~~~dbtcloud_job~~~ or with ~~~dbtcloud_job_completion_trigger~~~.
+
+		Jobs triggering each other in a loop are reported in ~~~cycles~~~ and as warnings, or as errors with ~~~fail_on_cycle~~~. The jobs of all the projects of the account are read, so the token needs read access to all of them.`,
+	),
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the data source, the ID of the account",
+		},
+		"fail_on_cycle": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether cycles are reported as errors instead of warnings - Defaults to `false`",
+		},
+		"edges": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The job completion triggers of the account, sorted by downstream job",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"upstream_job_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the job whose completion triggers the downstream job",
+					},
+					"upstream_project_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the project of the upstream job",
+					},
+					"downstream_job_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the job triggered",
+					},
+					"statuses": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "The statuses of the upstream job triggering the downstream job, among `success`, `error` and `canceled`",
+					},
+				},
+			},
+		},
+		"topological_order": schema.ListAttribute{
+			ElementType: types.Int64Type,
+			Computed:    true,
+			Description: "The IDs of the jobs, each job coming after the job triggering it, ties being broken by ID. The jobs part of a cycle or triggered by a job part of a cycle are left out",
+		},
+		"cycles": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The jobs triggering each other in a loop",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"job_ids": schema.ListAttribute{
+						ElementType: types.Int64Type,
+						Computed:    true,
+						Description: "The IDs of the jobs of the cycle, each job triggering the next one and the last one triggering the first one",
+					},
+					"error": schema.StringAttribute{
+						Computed:    true,
+						Description: "A description of the cycle, with the names of its jobs",
+					},
+				},
+			},
+		},
+	},
+}
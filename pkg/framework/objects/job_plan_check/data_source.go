@@ -0,0 +1,179 @@
+package job_plan_check
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &jobPlanCheckDataSource{}
+	_ datasource.DataSourceWithConfigure = &jobPlanCheckDataSource{}
+)
+
+func JobPlanCheckDataSource() datasource.DataSource {
+	return &jobPlanCheckDataSource{}
+}
+
+type jobPlanCheckDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *jobPlanCheckDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_job_plan_check"
+}
+
+func (d *jobPlanCheckDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasourceSchema
+}
+
+func (d *jobPlanCheckDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state JobPlanCheckDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentID := int(state.EnvironmentID.ValueInt64())
+	runID, content, err := d.client.GetEnvironmentLatestArtifact(ctx, environmentID, "manifest.json")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment_id"),
+			"Issue when retrieving the manifest of the environment",
+			fmt.Sprintf(
+				"The manifest of the environment %d is needed to resolve the selection of the steps: %s",
+				environmentID,
+				err.Error(),
+			),
+		)
+		return
+	}
+	jobManifest, err := parseManifest(content)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the manifest of the environment", err.Error())
+		return
+	}
+	planner := planner{manifest: jobManifest}
+	state.ManifestRunID = types.Int64Value(runID)
+	state.DeferringManifestRunID = types.Int64Null()
+
+	if !state.DeferringEnvironmentID.IsNull() {
+		deferringEnvironmentID := int(state.DeferringEnvironmentID.ValueInt64())
+		deferringRunID, deferringContent, err := d.client.GetEnvironmentLatestArtifact(
+			ctx,
+			deferringEnvironmentID,
+			"manifest.json",
+		)
+		switch {
+		case dbt_cloud.IsNotFound(err):
+			// a new deferring environment has no run yet, the state selectors
+			// are then reported as not evaluated
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("deferring_environment_id"),
+				"No manifest for the deferring environment",
+				fmt.Sprintf(
+					"The state: selectors can't be evaluated: %s",
+					err.Error(),
+				),
+			)
+		case err != nil:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deferring_environment_id"),
+				"Issue when retrieving the manifest of the deferring environment",
+				err.Error(),
+			)
+			return
+		default:
+			planner.deferred, err = parseManifest(deferringContent)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to read the manifest of the deferring environment", err.Error())
+				return
+			}
+			state.DeferringManifestRunID = types.Int64Value(deferringRunID)
+		}
+	}
+
+	state.Steps = []StepDataSourceModel{}
+	state.UnmatchedSelectors = []types.String{}
+	for i, step := range state.ExecuteSteps {
+		plan, err := planner.planStep(step.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("execute_steps").AtListIndex(i),
+				"Invalid step",
+				err.Error(),
+			)
+			continue
+		}
+
+		stepModel := StepDataSourceModel{
+			Step:                  step,
+			Command:               types.StringValue(plan.command),
+			UnmatchedSelectors:    helper.SliceStringToSliceTypesString(plan.unmatched),
+			NotEvaluatedSelectors: helper.SliceStringToSliceTypesString(plan.notEvaluated),
+		}
+		if plan.selected != nil {
+			stepModel.SelectedNodes = helper.SliceStringToSliceTypesString(plan.selected)
+		}
+		state.Steps = append(state.Steps, stepModel)
+
+		for _, selector := range plan.unmatched {
+			state.UnmatchedSelectors = append(
+				state.UnmatchedSelectors,
+				types.StringValue(step.ValueString()+": "+selector),
+			)
+		}
+		if len(plan.unmatched) > 0 {
+			summary := "Selectors matching no node"
+			detail := fmt.Sprintf(
+				"The step %q has selectors that don't match any node of the manifest of run %d: %s",
+				step.ValueString(),
+				runID,
+				strings.Join(plan.unmatched, ", "),
+			)
+			if state.FailOnUnmatched.ValueBool() {
+				resp.Diagnostics.AddAttributeError(path.Root("execute_steps").AtListIndex(i), summary, detail)
+			} else {
+				resp.Diagnostics.AddAttributeWarning(path.Root("execute_steps").AtListIndex(i), summary, detail)
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(strconv.Itoa(environmentID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *jobPlanCheckDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,107 @@
+package job_plan_check_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testJobPlanCheckMockServer returns a mock of the API where the latest run of
+// the environment 10 with artifacts is the run 2
+func testJobPlanCheckMockServer(t *testing.T) *testhelpers.MockServer {
+	manifest := json.RawMessage(`{
+  "nodes": {
+    "model.shop.stg_orders": {
+      "resource_type": "model", "name": "stg_orders", "package_name": "shop",
+      "original_file_path": "models/staging/stg_orders.sql", "fqn": ["shop", "staging", "stg_orders"],
+      "depends_on": {"nodes": []}
+    },
+    "model.shop.orders": {
+      "resource_type": "model", "name": "orders", "package_name": "shop",
+      "original_file_path": "models/marts/orders.sql", "fqn": ["shop", "marts", "orders"],
+      "depends_on": {"nodes": ["model.shop.stg_orders"]}
+    }
+  }
+}`)
+
+	return testhelpers.SetupMockServer(t, map[string]testhelpers.MockEndpointHandler{
+		"GET /v2/accounts/123/runs/": func(r *http.Request) (int, interface{}, error) {
+			if r.URL.Query().Get("environment_id") != "10" {
+				return http.StatusOK, map[string]interface{}{"data": []interface{}{}}, nil
+			}
+			return http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"id": 3, "environment_id": 10, "status": 10, "artifacts_saved": false},
+					{"id": 2, "environment_id": 10, "status": 10, "artifacts_saved": true},
+				},
+			}, nil
+		},
+		"GET /v2/accounts/123/runs/2/artifacts/manifest.json": func(r *http.Request) (int, interface{}, error) {
+			return http.StatusOK, manifest, nil
+		},
+	})
+}
+
+func TestJobPlanCheckDataSource(t *testing.T) {
+	mockServer := testJobPlanCheckMockServer(t)
+	defer mockServer.Close()
+
+	config := func(selector string, failOnUnmatched bool) string {
+		return fmt.Sprintf(`
+provider "dbtcloud" {
+  host_url   = "%s"
+  token      = "test-token"
+  account_id = 123
+}
+
+data "dbtcloud_job_plan_check" "test" {
+  environment_id    = 10
+  execute_steps     = ["dbt deps", "dbt run --select %s"]
+  fail_on_unmatched = %t
+}
+`, mockServer.URL, selector, failOnUnmatched)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("stg_orders+", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dbtcloud_job_plan_check.test", "manifest_run_id", "2"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_plan_check.test", "steps.#", "2"),
+					resource.TestCheckNoResourceAttr("data.dbtcloud_job_plan_check.test", "steps.0.selected_nodes"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_plan_check.test", "steps.1.command", "run"),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_plan_check.test", "steps.1.selected_nodes.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_job_plan_check.test",
+						"steps.1.selected_nodes.0",
+						"model.shop.orders",
+					),
+					resource.TestCheckResourceAttr("data.dbtcloud_job_plan_check.test", "unmatched_selectors.#", "0"),
+				),
+			},
+			{
+				Config: config("stg_ordres", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dbtcloud_job_plan_check.test", "steps.1.selected_nodes.#", "0"),
+					resource.TestCheckResourceAttr(
+						"data.dbtcloud_job_plan_check.test",
+						"unmatched_selectors.0",
+						"dbt run --select stg_ordres: stg_ordres",
+					),
+				),
+			},
+			{
+				Config:      config("stg_ordres", true),
+				ExpectError: regexp.MustCompile(`Selectors matching no node`),
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package job_plan_check
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// manifestNode holds the parts of a node of manifest.json used for selection,
+// whatever its section (nodes, sources, exposures...)
+type manifestNode struct {
+	UniqueID         string         `json:"unique_id"`
+	ResourceType     string         `json:"resource_type"`
+	Name             string         `json:"name"`
+	PackageName      string         `json:"package_name"`
+	SourceName       string         `json:"source_name"`
+	OriginalFilePath string         `json:"original_file_path"`
+	FQN              []string       `json:"fqn"`
+	Tags             []string       `json:"tags"`
+	Group            string         `json:"group"`
+	Access           string         `json:"access"`
+	Config           map[string]any `json:"config"`
+	DependsOn        struct {
+		Nodes []string `json:"nodes"`
+	} `json:"depends_on"`
+	Checksum struct {
+		Checksum string `json:"checksum"`
+	} `json:"checksum"`
+	TestMetadata *struct {
+		Name string `json:"name"`
+	} `json:"test_metadata"`
+}
+
+// manifest is the DAG of a dbt project, as generated by a run
+type manifest struct {
+	nodes    map[string]manifestNode
+	children map[string][]string
+}
+
+// manifestSections lists the sections of manifest.json that contain
+// selectable nodes
+var manifestSections = []string{
+	"nodes",
+	"sources",
+	"exposures",
+	"metrics",
+	"semantic_models",
+	"saved_queries",
+	"unit_tests",
+}
+
+func parseManifest(content []byte) (*manifest, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("the manifest is not valid JSON: %w", err)
+	}
+
+	m := &manifest{
+		nodes:    map[string]manifestNode{},
+		children: map[string][]string{},
+	}
+	for _, section := range manifestSections {
+		if len(raw[section]) == 0 {
+			continue
+		}
+		var nodes map[string]manifestNode
+		if err := json.Unmarshal(raw[section], &nodes); err != nil {
+			return nil, fmt.Errorf("the section %s of the manifest could not be read: %w", section, err)
+		}
+		for uniqueID, node := range nodes {
+			node.UniqueID = uniqueID
+			m.nodes[uniqueID] = node
+		}
+	}
+
+	for uniqueID, node := range m.nodes {
+		for _, parent := range node.DependsOn.Nodes {
+			m.children[parent] = append(m.children[parent], uniqueID)
+		}
+	}
+	for parent := range m.children {
+		slices.Sort(m.children[parent])
+	}
+	return m, nil
+}
+
+// parents returns the direct parents of a node that are part of the manifest,
+// macros are not
+func (m *manifest) parents(uniqueID string) []string {
+	parents := []string{}
+	for _, parent := range m.nodes[uniqueID].DependsOn.Nodes {
+		if _, ok := m.nodes[parent]; ok {
+			parents = append(parents, parent)
+		}
+	}
+	return parents
+}
+
+// walk returns the nodes reachable from the given ones through next, up to
+// depth edges away or without limit when depth is negative. The given nodes
+// are not part of the result unless they can reach themselves.
+func (m *manifest) walk(from nodeSet, depth int, next func(string) []string) nodeSet {
+	reached := nodeSet{}
+	frontier := slices.Collect(from.all())
+	for level := 0; len(frontier) > 0 && (depth < 0 || level < depth); level++ {
+		nextFrontier := []string{}
+		for _, uniqueID := range frontier {
+			for _, neighbour := range next(uniqueID) {
+				if !reached.has(neighbour) {
+					reached.add(neighbour)
+					nextFrontier = append(nextFrontier, neighbour)
+				}
+			}
+		}
+		frontier = nextFrontier
+	}
+	return reached
+}
+
+func (m *manifest) ancestors(from nodeSet, depth int) nodeSet {
+	return m.walk(from, depth, m.parents)
+}
+
+func (m *manifest) descendants(from nodeSet, depth int) nodeSet {
+	return m.walk(from, depth, func(uniqueID string) []string { return m.children[uniqueID] })
+}
@@ -0,0 +1,25 @@
+package job_plan_check
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type JobPlanCheckDataSourceModel struct {
+	ID                     types.String          `tfsdk:"id"`
+	EnvironmentID          types.Int64           `tfsdk:"environment_id"`
+	ExecuteSteps           []types.String        `tfsdk:"execute_steps"`
+	DeferringEnvironmentID types.Int64           `tfsdk:"deferring_environment_id"`
+	FailOnUnmatched        types.Bool            `tfsdk:"fail_on_unmatched"`
+	ManifestRunID          types.Int64           `tfsdk:"manifest_run_id"`
+	DeferringManifestRunID types.Int64           `tfsdk:"deferring_manifest_run_id"`
+	Steps                  []StepDataSourceModel `tfsdk:"steps"`
+	UnmatchedSelectors     []types.String        `tfsdk:"unmatched_selectors"`
+}
+
+type StepDataSourceModel struct {
+	Step                  types.String   `tfsdk:"step"`
+	Command               types.String   `tfsdk:"command"`
+	SelectedNodes         []types.String `tfsdk:"selected_nodes"`
+	UnmatchedSelectors    []types.String `tfsdk:"unmatched_selectors"`
+	NotEvaluatedSelectors []types.String `tfsdk:"not_evaluated_selectors"`
+}
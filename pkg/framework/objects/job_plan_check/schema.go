@@ -0,0 +1,88 @@
+package job_plan_check
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var datasourceSchema = schema.Schema{
+	Description: helper.DocString(
+		`Previews the nodes the steps of a job would select, using the ~~~manifest.json~~~ of the latest successful run of the environment that saved its artifacts.
+
+		Selectors matching no node, e.g. a typo in ~~~--select~~~, are reported as warnings, or as errors with ~~~fail_on_unmatched~~~, so that they are caught at plan time rather than when the job runs.
+		The selection methods depending on previous run results (~~~result~~~, ~~~source_status~~~), YAML selectors and the ~~~state~~~ selectors other than ~~~new~~~, ~~~old~~~, ~~~modified~~~ and ~~~unmodified~~~ can't be resolved from a manifest and are reported in ~~~not_evaluated_selectors~~~.`,
+	),
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the data source, the ID of the environment",
+		},
+		"environment_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "The ID of the environment the job runs in, whose latest manifest is used",
+		},
+		"execute_steps": schema.ListAttribute{
+			ElementType: types.StringType,
+			Required:    true,
+			Description: "The steps of the job, as set in `execute_steps` of `dbtcloud_job`",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"deferring_environment_id": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The environment the job defers to, as set in `deferring_environment_id` of `dbtcloud_job`. Its latest manifest is the state the `state:` selectors compare to, they are not evaluated when it is not set",
+		},
+		"fail_on_unmatched": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether selectors matching no node are reported as errors instead of warnings - Defaults to `false`",
+		},
+		"manifest_run_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the run the manifest of the environment was taken from",
+		},
+		"deferring_manifest_run_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the run the manifest of the deferring environment was taken from",
+		},
+		"steps": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The selection of each step, in the same order as `execute_steps`",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"step": schema.StringAttribute{
+						Computed:    true,
+						Description: "The step as given in `execute_steps`",
+					},
+					"command": schema.StringAttribute{
+						Computed:    true,
+						Description: "The dbt command of the step, e.g. `build` or `source freshness`",
+					},
+					"selected_nodes": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "The unique IDs of the nodes the step would run, sorted - Null for the commands that don't select nodes, like `deps` or `run-operation`",
+					},
+					"unmatched_selectors": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "The selectors of `--select` and `--exclude` matching no node",
+					},
+					"not_evaluated_selectors": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "The selectors that can't be resolved from the manifest, the nodes they would select are missing from `selected_nodes`",
+					},
+				},
+			},
+		},
+		"unmatched_selectors": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The selectors matching no node across all the steps, prefixed with the step they are part of",
+		},
+	},
+}
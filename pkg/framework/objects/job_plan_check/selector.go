@@ -0,0 +1,670 @@
+package job_plan_check
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+)
+
+// nodeSet is a set of unique IDs of nodes
+type nodeSet map[string]struct{}
+
+func (s nodeSet) add(uniqueIDs ...string) {
+	for _, uniqueID := range uniqueIDs {
+		s[uniqueID] = struct{}{}
+	}
+}
+
+func (s nodeSet) has(uniqueID string) bool {
+	_, ok := s[uniqueID]
+	return ok
+}
+
+func (s nodeSet) all() iter.Seq[string] {
+	return maps.Keys(s)
+}
+
+func (s nodeSet) union(other nodeSet) {
+	s.add(slices.Collect(other.all())...)
+}
+
+func (s nodeSet) intersect(other nodeSet) nodeSet {
+	result := nodeSet{}
+	for uniqueID := range s {
+		if other.has(uniqueID) {
+			result.add(uniqueID)
+		}
+	}
+	return result
+}
+
+func (s nodeSet) sorted() []string {
+	sorted := slices.AppendSeq(make([]string, 0, len(s)), s.all())
+	slices.Sort(sorted)
+	return sorted
+}
+
+// commandResourceTypes lists the commands that select nodes with the resource
+// types they run, nil meaning all of them
+var commandResourceTypes = map[string][]string{
+	"build":            {"model", "seed", "snapshot", "test", "unit_test", "saved_query"},
+	"clone":            {"model", "seed", "snapshot"},
+	"compile":          {"model", "test", "unit_test", "snapshot", "analysis"},
+	"docs generate":    {"model", "seed", "snapshot", "source"},
+	"list":             nil,
+	"ls":               nil,
+	"run":              {"model"},
+	"seed":             {"seed"},
+	"snapshot":         {"snapshot"},
+	"source freshness": {"source"},
+	"test":             {"test", "unit_test"},
+}
+
+// valueGlobalFlags are the global flags followed by a value when given before
+// the command, e.g. dbt --log-format json run
+var valueGlobalFlags = []string{
+	"--log-format",
+	"--log-format-file",
+	"--log-level",
+	"--log-level-file",
+	"--record-timing-info",
+	"-r",
+	"--warn-error-options",
+}
+
+// notEvaluatedMethods are the selection methods that depend on the results of
+// previous runs, that a manifest is not enough to resolve
+var notEvaluatedMethods = []string{"result", "source_status", "version"}
+
+// dbtStep is a step of a job, with the selection flags it was given
+type dbtStep struct {
+	command              string
+	selects              []string
+	excludes             []string
+	selector             string
+	resourceTypes        []string
+	excludeResourceTypes []string
+	indirectSelection    string
+}
+
+// parseStep reads the command and the selection flags of a step, other flags
+// are ignored
+func parseStep(step string) (dbtStep, error) {
+	args, err := helper.SplitCommandLine(step)
+	if err != nil {
+		return dbtStep{}, err
+	}
+	if len(args) == 0 || args[0] != "dbt" {
+		return dbtStep{}, fmt.Errorf("the step %q does not start with `dbt`", step)
+	}
+
+	// global flags can come before the command, with their value if they take
+	// one
+	i := 1
+	for i < len(args) && strings.HasPrefix(args[i], "-") {
+		flag, _, hasInlineValue := strings.Cut(args[i], "=")
+		i++
+		if !hasInlineValue && slices.Contains(valueGlobalFlags, flag) &&
+			i < len(args) && !strings.HasPrefix(args[i], "--") {
+			i++
+		}
+	}
+	if i == len(args) {
+		return dbtStep{}, fmt.Errorf("the step %q has no command", step)
+	}
+
+	parsed := dbtStep{command: args[i], indirectSelection: "eager"}
+	if (args[i] == "docs" || args[i] == "source") && i+1 < len(args) {
+		i++
+		parsed.command += " " + args[i]
+	}
+
+	for i++; i < len(args); i++ {
+		flag, inlineValue, hasInlineValue := strings.Cut(args[i], "=")
+		if !strings.HasPrefix(flag, "-") {
+			continue
+		}
+
+		// flags can be given multiple values, until the next flag
+		values := []string{}
+		if hasInlineValue {
+			values = strings.Fields(inlineValue)
+		}
+		for i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			i++
+			values = append(values, strings.Fields(args[i])...)
+		}
+
+		switch flag {
+		case "--select", "-s", "--models", "--model", "-m":
+			parsed.selects = append(parsed.selects, values...)
+		case "--exclude":
+			parsed.excludes = append(parsed.excludes, values...)
+		case "--selector":
+			parsed.selector = strings.Join(values, " ")
+		case "--resource-type", "--resource-types":
+			parsed.resourceTypes = append(parsed.resourceTypes, values...)
+		case "--exclude-resource-type", "--exclude-resource-types":
+			parsed.excludeResourceTypes = append(parsed.excludeResourceTypes, values...)
+		case "--indirect-selection":
+			if len(values) > 0 {
+				parsed.indirectSelection = values[0]
+			}
+		}
+	}
+	return parsed, nil
+}
+
+// stepPlan is what a step would run against the manifest
+type stepPlan struct {
+	command string
+	// selected is nil for the commands that don't select nodes
+	selected     []string
+	unmatched    []string
+	notEvaluated []string
+}
+
+// planner resolves the selection of steps, deferred is the manifest of the
+// deferring environment used for the state method, if any
+type planner struct {
+	manifest *manifest
+	deferred *manifest
+}
+
+func (p *planner) planStep(step string) (stepPlan, error) {
+	parsed, err := parseStep(step)
+	if err != nil {
+		return stepPlan{}, err
+	}
+	plan := stepPlan{command: parsed.command, unmatched: []string{}, notEvaluated: []string{}}
+
+	commandTypes, selectsNodes := commandResourceTypes[parsed.command]
+	if !selectsNodes {
+		return plan, nil
+	}
+	plan.selected = []string{}
+
+	if parsed.selector != "" {
+		// YAML selectors are defined in the project, not in the manifest
+		plan.notEvaluated = append(plan.notEvaluated, "--selector "+parsed.selector)
+		return plan, nil
+	}
+
+	selected := nodeSet{}
+	if len(parsed.selects) == 0 {
+		selected.add(slices.Collect(maps.Keys(p.manifest.nodes))...)
+	}
+	for _, member := range parsed.selects {
+		nodes, evaluated, err := p.selectMember(member)
+		if err != nil {
+			return stepPlan{}, err
+		}
+		switch {
+		case !evaluated:
+			plan.notEvaluated = append(plan.notEvaluated, member)
+		case len(nodes) == 0:
+			plan.unmatched = append(plan.unmatched, member)
+		}
+		selected.union(nodes)
+	}
+
+	if commandTypes == nil || slices.Contains(commandTypes, "test") {
+		selected.union(p.indirectTests(selected, parsed.indirectSelection))
+	}
+
+	for _, member := range parsed.excludes {
+		nodes, evaluated, err := p.selectMember(member)
+		if err != nil {
+			return stepPlan{}, err
+		}
+		switch {
+		case !evaluated:
+			plan.notEvaluated = append(plan.notEvaluated, "--exclude "+member)
+		case len(nodes) == 0:
+			plan.unmatched = append(plan.unmatched, "--exclude "+member)
+		}
+		for uniqueID := range nodes {
+			delete(selected, uniqueID)
+		}
+	}
+
+	for uniqueID := range selected {
+		resourceType := p.manifest.nodes[uniqueID].ResourceType
+		if (commandTypes != nil && !slices.Contains(commandTypes, resourceType)) ||
+			(len(parsed.resourceTypes) > 0 && !slices.Contains(parsed.resourceTypes, resourceType)) ||
+			slices.Contains(parsed.excludeResourceTypes, resourceType) {
+			delete(selected, uniqueID)
+		}
+	}
+
+	plan.selected = selected.sorted()
+	return plan, nil
+}
+
+// indirectTests returns the tests selected through the nodes they test, based
+// on the --indirect-selection mode
+func (p *planner) indirectTests(selected nodeSet, mode string) nodeSet {
+	tests := nodeSet{}
+	if mode == "empty" {
+		return tests
+	}
+	for uniqueID, node := range p.manifest.nodes {
+		if node.ResourceType != "test" && node.ResourceType != "unit_test" {
+			continue
+		}
+		parents := p.manifest.parents(uniqueID)
+		if len(parents) == 0 {
+			continue
+		}
+		selectedParents := 0
+		for _, parent := range parents {
+			if selected.has(parent) {
+				selectedParents++
+			}
+		}
+		// buildable is approximated as cautious, all the parents must be selected
+		if (mode == "eager" && selectedParents > 0) || selectedParents == len(parents) {
+			tests.add(uniqueID)
+		}
+	}
+	return tests
+}
+
+// selectMember resolves a member of --select or --exclude, where a comma
+// separated list of criteria is an intersection. It returns false when the
+// selection can't be resolved with the manifest.
+func (p *planner) selectMember(member string) (nodeSet, bool, error) {
+	var result nodeSet
+	for _, criterion := range strings.Split(member, ",") {
+		nodes, evaluated, err := p.selectCriterion(criterion)
+		if err != nil || !evaluated {
+			return nil, evaluated, err
+		}
+		if result == nil {
+			result = nodes
+		} else {
+			result = result.intersect(nodes)
+		}
+	}
+	return result, true, nil
+}
+
+// criterionPattern splits a criterion like `2+config.materialized:table+`
+// into its graph operators, method and value, the same way dbt does
+var criterionPattern = regexp.MustCompile(`^(@)?((\d*)\+)?(([\w.]+):)?(.*?)(\+(\d*))?$`)
+
+func (p *planner) selectCriterion(criterion string) (nodeSet, bool, error) {
+	parts := criterionPattern.FindStringSubmatch(criterion)
+	if parts == nil || parts[6] == "" {
+		return nil, false, fmt.Errorf("the selection criterion %q is not valid", criterion)
+	}
+	childrensParents, hasParents, hasChildren := parts[1] != "", parts[2] != "", parts[7] != ""
+	if childrensParents && hasParents {
+		return nil, false, fmt.Errorf(
+			"the selection criterion %q can't use both the @ and + operators before the selector",
+			criterion,
+		)
+	}
+
+	method, value := parts[5], parts[6]
+	if method == "" {
+		method = defaultMethod(value)
+	}
+	direct, evaluated, err := p.selectMethod(method, value)
+	if err != nil || !evaluated {
+		return nil, evaluated, err
+	}
+
+	result := nodeSet{}
+	result.union(direct)
+	if childrensParents {
+		result.union(p.manifest.descendants(direct, -1))
+		result.union(p.manifest.ancestors(result, -1))
+		return result, true, nil
+	}
+	if hasParents {
+		result.union(p.manifest.ancestors(direct, depth(parts[3])))
+	}
+	if hasChildren {
+		result.union(p.manifest.descendants(direct, depth(parts[8])))
+	}
+	return result, true, nil
+}
+
+// depth returns the depth of a graph operator, -1 meaning unlimited
+func depth(value string) int {
+	if value == "" {
+		return -1
+	}
+	d, _ := strconv.Atoi(value)
+	return d
+}
+
+// defaultMethod returns the method dbt uses for a selector without one, path
+// for file paths and fqn otherwise
+func defaultMethod(value string) string {
+	if strings.ContainsAny(value, `/\`) || value == "." || value == ".." {
+		return "path"
+	}
+	switch path.Ext(value) {
+	case ".sql", ".py", ".csv":
+		return "path"
+	}
+	return "fqn"
+}
+
+func (p *planner) selectMethod(method, value string) (nodeSet, bool, error) {
+	name, arguments, _ := strings.Cut(method, ".")
+
+	var match func(manifestNode) bool
+	switch name {
+	case "fqn":
+		match = func(node manifestNode) bool { return isSelectedNode(node.FQN, value) }
+	case "tag":
+		match = func(node manifestNode) bool {
+			return slices.ContainsFunc(node.Tags, func(tag string) bool { return fnmatch(value, tag) })
+		}
+	case "path":
+		match = func(node manifestNode) bool { return pathMatches(node.OriginalFilePath, value) }
+	case "file":
+		match = func(node manifestNode) bool {
+			base := path.Base(node.OriginalFilePath)
+			return fnmatch(value, base) || fnmatch(value, strings.TrimSuffix(base, path.Ext(base)))
+		}
+	case "source":
+		match = func(node manifestNode) bool { return node.ResourceType == "source" && sourceMatches(node, value) }
+	case "resource_type":
+		match = func(node manifestNode) bool { return node.ResourceType == value }
+	case "package":
+		match = func(node manifestNode) bool { return node.PackageName == value }
+	case "group":
+		match = func(node manifestNode) bool { return node.Group == value }
+	case "access":
+		match = func(node manifestNode) bool { return node.Access == value }
+	case "config":
+		if arguments == "" {
+			return nil, false, fmt.Errorf("the selection method config needs a key, e.g. config.materialized:%s", value)
+		}
+		keys := strings.Split(arguments, ".")
+		match = func(node manifestNode) bool { return configMatches(node.Config, keys, value) }
+	case "test_type":
+		match = func(node manifestNode) bool { return testTypeMatches(node, value) }
+	case "test_name":
+		match = func(node manifestNode) bool {
+			return node.ResourceType == "test" && node.TestMetadata != nil && fnmatch(value, node.TestMetadata.Name)
+		}
+	case "exposure", "metric", "semantic_model", "saved_query", "unit_test":
+		match = func(node manifestNode) bool {
+			return node.ResourceType == name &&
+				(fnmatch(value, node.Name) || fnmatch(value, node.PackageName+"."+node.Name))
+		}
+	case "state":
+		return p.selectState(value)
+	default:
+		if slices.Contains(notEvaluatedMethods, name) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("%q is not a valid selection method", name)
+	}
+
+	nodes := nodeSet{}
+	for uniqueID, node := range p.manifest.nodes {
+		if match(node) {
+			nodes.add(uniqueID)
+		}
+	}
+	return nodes, true, nil
+}
+
+// selectState compares the manifest with the one of the deferring
+// environment, nodes are modified when their checksum changed
+func (p *planner) selectState(value string) (nodeSet, bool, error) {
+	if p.deferred == nil {
+		return nil, false, nil
+	}
+
+	var match func(node manifestNode, deferred manifestNode, existed bool) bool
+	switch value {
+	case "new":
+		match = func(_, _ manifestNode, existed bool) bool { return !existed }
+	case "old":
+		match = func(_, _ manifestNode, existed bool) bool { return existed }
+	case "modified", "modified.body":
+		match = func(node, deferred manifestNode, existed bool) bool {
+			return !existed || node.Checksum != deferred.Checksum
+		}
+	case "unmodified":
+		match = func(node, deferred manifestNode, existed bool) bool {
+			return existed && node.Checksum == deferred.Checksum
+		}
+	default:
+		// the other state selectors compare configs, relations and macros
+		return nil, false, nil
+	}
+
+	nodes := nodeSet{}
+	for uniqueID, node := range p.manifest.nodes {
+		deferred, existed := p.deferred.nodes[uniqueID]
+		if match(node, deferred, existed) {
+			nodes.add(uniqueID)
+		}
+	}
+	return nodes, true, nil
+}
+
+// fnmatchPatterns caches the regular expressions of the fnmatch patterns, as
+// they are matched against every node of the manifest
+var fnmatchPatterns sync.Map
+
+// fnmatch matches a value against a Unix shell-style pattern the way Python's
+// fnmatch does for dbt: a `*` matches any characters, dots and slashes
+// included, and a set is negated with `[!...]`
+func fnmatch(pattern, value string) bool {
+	cached, ok := fnmatchPatterns.Load(pattern)
+	if !ok {
+		// an invalid set, e.g. with a reversed range, matches nothing
+		compiled, _ := regexp.Compile(fnmatchRegexp(pattern))
+		cached, _ = fnmatchPatterns.LoadOrStore(pattern, compiled)
+	}
+	compiled := cached.(*regexp.Regexp)
+	return compiled != nil && compiled.MatchString(value)
+}
+
+// fnmatchRegexp translates an fnmatch pattern into an anchored regular
+// expression, following Python's fnmatch.translate
+func fnmatchRegexp(pattern string) string {
+	var result strings.Builder
+	result.WriteString(`^(?s:`)
+	for i := 0; i < len(pattern); {
+		switch pattern[i] {
+		case '*':
+			for i < len(pattern) && pattern[i] == '*' {
+				i++
+			}
+			result.WriteString(`.*`)
+		case '?':
+			i++
+			result.WriteString(`.`)
+		case '[':
+			// a `]` right after the opening bracket is part of the set
+			end := i + 1
+			if end < len(pattern) && pattern[end] == '!' {
+				end++
+			}
+			if end < len(pattern) && pattern[end] == ']' {
+				end++
+			}
+			for end < len(pattern) && pattern[end] != ']' {
+				end++
+			}
+			if end >= len(pattern) {
+				// an unclosed bracket is a literal one
+				i++
+				result.WriteString(`\[`)
+				continue
+			}
+			set := pattern[i+1 : end]
+			i = end + 1
+			result.WriteString(`[`)
+			if negated, ok := strings.CutPrefix(set, "!"); ok {
+				result.WriteString(`^`)
+				set = negated
+			}
+			for _, r := range set {
+				if r != '-' && (unicode.IsPunct(r) || unicode.IsSymbol(r)) {
+					result.WriteRune('\\')
+				}
+				result.WriteRune(r)
+			}
+			result.WriteString(`]`)
+		default:
+			literalEnd := i + 1
+			for literalEnd < len(pattern) && !strings.ContainsRune("*?[", rune(pattern[literalEnd])) {
+				literalEnd++
+			}
+			result.WriteString(regexp.QuoteMeta(pattern[i:literalEnd]))
+			i = literalEnd
+		}
+	}
+	result.WriteString(`)$`)
+	return result.String()
+}
+
+// isSelectedNode is dbt's fqn matching: the name of the node, or the start of
+// its dotted fqn, with wildcards matching the rest of it
+func isSelectedNode(fqn []string, selector string) bool {
+	if len(fqn) == 0 {
+		return false
+	}
+	if fqn[len(fqn)-1] == selector {
+		return true
+	}
+
+	// dots in the names of nodes act as namespace separators
+	flatFQN := []string{}
+	for _, segment := range fqn {
+		flatFQN = append(flatFQN, strings.Split(segment, ".")...)
+	}
+	selectorParts := strings.Split(selector, ".")
+	if len(flatFQN) < len(selectorParts) {
+		return false
+	}
+
+	for i, part := range selectorParts {
+		if strings.ContainsAny(part, "*?[]") {
+			return fnmatch(strings.Join(selectorParts[i:], "."), strings.Join(flatFQN[i:], "."))
+		}
+		if flatFQN[i] != part {
+			return false
+		}
+	}
+	return true
+}
+
+// pathMatches matches the path of a file relative to its project with a file,
+// a directory or a glob. Unlike fnmatch, globs follow the semantics of
+// Python's pathlib that dbt uses for paths: a `*` doesn't match slashes, `**`
+// matches any number of directories, and a matched directory selects all the
+// files in it.
+func pathMatches(filePath, selector string) bool {
+	selector = strings.TrimSuffix(strings.TrimPrefix(selector, "./"), "/")
+	if selector == "." || selector == "" {
+		return true
+	}
+	if strings.ContainsAny(selector, "*?[]") {
+		selectorSegments := strings.Split(selector, "/")
+		fileSegments := strings.Split(filePath, "/")
+		for end := len(fileSegments); end > 0; end-- {
+			if globMatches(selectorSegments, fileSegments[:end]) {
+				return true
+			}
+		}
+		return false
+	}
+	return filePath == selector || strings.HasPrefix(filePath, selector+"/")
+}
+
+// globMatches matches the segments of a path with the ones of a pathlib glob
+func globMatches(selectorSegments, pathSegments []string) bool {
+	if len(selectorSegments) == 0 {
+		return len(pathSegments) == 0
+	}
+	if selectorSegments[0] == "**" {
+		for skipped := 0; skipped <= len(pathSegments); skipped++ {
+			if globMatches(selectorSegments[1:], pathSegments[skipped:]) {
+				return true
+			}
+		}
+		return false
+	}
+	return len(pathSegments) > 0 &&
+		fnmatch(selectorSegments[0], pathSegments[0]) &&
+		globMatches(selectorSegments[1:], pathSegments[1:])
+}
+
+// sourceMatches matches a source with `source_name`, `source_name.table` or
+// `package.source_name.table`
+func sourceMatches(node manifestNode, selector string) bool {
+	parts := strings.Split(selector, ".")
+	switch len(parts) {
+	case 1:
+		return fnmatch(parts[0], node.SourceName)
+	case 2:
+		return fnmatch(parts[0], node.SourceName) && fnmatch(parts[1], node.Name)
+	case 3:
+		return fnmatch(parts[0], node.PackageName) &&
+			fnmatch(parts[1], node.SourceName) &&
+			fnmatch(parts[2], node.Name)
+	}
+	return false
+}
+
+// configMatches compares the value of a possibly nested config with the
+// selector, any element matches for lists
+func configMatches(config map[string]any, keys []string, selector string) bool {
+	var value any = config
+	for _, key := range keys {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		value = object[key]
+	}
+
+	switch typed := value.(type) {
+	case string:
+		return typed == selector
+	case bool:
+		return strconv.FormatBool(typed) == selector
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64) == selector
+	case []any:
+		return slices.ContainsFunc(typed, func(element any) bool {
+			return configMatches(map[string]any{"element": element}, []string{"element"}, selector)
+		})
+	}
+	return false
+}
+
+func testTypeMatches(node manifestNode, testType string) bool {
+	switch testType {
+	case "generic":
+		return node.ResourceType == "test" && node.TestMetadata != nil
+	case "singular":
+		return node.ResourceType == "test" && node.TestMetadata == nil
+	case "data":
+		return node.ResourceType == "test"
+	case "unit":
+		return node.ResourceType == "unit_test"
+	}
+	return false
+}
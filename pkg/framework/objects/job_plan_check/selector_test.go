@@ -0,0 +1,379 @@
+package job_plan_check
+
+import (
+	"slices"
+	"testing"
+)
+
+const testManifest = `{
+  "metadata": {"dbt_version": "1.9.0"},
+  "nodes": {
+    "model.shop.stg_orders": {
+      "resource_type": "model", "name": "stg_orders", "package_name": "shop",
+      "original_file_path": "models/staging/stg_orders.sql", "fqn": ["shop", "staging", "stg_orders"],
+      "tags": ["daily"], "config": {"materialized": "view"},
+      "depends_on": {"nodes": ["source.shop.raw.orders", "macro.shop.cents_to_dollars"]},
+      "checksum": {"checksum": "a1"}
+    },
+    "model.shop.orders": {
+      "resource_type": "model", "name": "orders", "package_name": "shop",
+      "original_file_path": "models/marts/orders.sql", "fqn": ["shop", "marts", "orders"],
+      "tags": ["daily", "finance"], "config": {"materialized": "table", "contract": {"enforced": true}},
+      "depends_on": {"nodes": ["model.shop.stg_orders"]},
+      "checksum": {"checksum": "b2"}
+    },
+    "model.shop.revenue": {
+      "resource_type": "model", "name": "revenue", "package_name": "shop",
+      "original_file_path": "models/marts/finance/revenue.sql", "fqn": ["shop", "marts", "finance", "revenue"],
+      "tags": [], "config": {"materialized": "incremental"},
+      "depends_on": {"nodes": ["model.shop.orders"]},
+      "checksum": {"checksum": "c3"}
+    },
+    "test.shop.not_null_orders_id.1a2b": {
+      "resource_type": "test", "name": "not_null_orders_id", "package_name": "shop",
+      "original_file_path": "models/marts/schema.yml", "fqn": ["shop", "marts", "not_null_orders_id"],
+      "test_metadata": {"name": "not_null"},
+      "depends_on": {"nodes": ["model.shop.orders"]}
+    },
+    "test.shop.assert_revenue_matches_orders": {
+      "resource_type": "test", "name": "assert_revenue_matches_orders", "package_name": "shop",
+      "original_file_path": "tests/assert_revenue_matches_orders.sql", "fqn": ["shop", "assert_revenue_matches_orders"],
+      "depends_on": {"nodes": ["model.shop.orders", "model.shop.revenue"]}
+    },
+    "seed.shop.countries": {
+      "resource_type": "seed", "name": "countries", "package_name": "shop",
+      "original_file_path": "seeds/countries.csv", "fqn": ["shop", "countries"],
+      "depends_on": {"nodes": []}
+    }
+  },
+  "sources": {
+    "source.shop.raw.orders": {
+      "resource_type": "source", "name": "orders", "source_name": "raw", "package_name": "shop",
+      "original_file_path": "models/staging/sources.yml", "fqn": ["shop", "staging", "raw", "orders"]
+    }
+  },
+  "exposures": {
+    "exposure.shop.finance_dashboard": {
+      "resource_type": "exposure", "name": "finance_dashboard", "package_name": "shop",
+      "original_file_path": "models/exposures.yml", "fqn": ["shop", "finance_dashboard"],
+      "depends_on": {"nodes": ["model.shop.revenue"]}
+    }
+  },
+  "macros": {
+    "macro.shop.cents_to_dollars": {"resource_type": "macro", "name": "cents_to_dollars"}
+  }
+}`
+
+const testDeferredManifest = `{
+  "nodes": {
+    "model.shop.stg_orders": {"resource_type": "model", "checksum": {"checksum": "a1"}},
+    "model.shop.orders": {"resource_type": "model", "checksum": {"checksum": "b1"}}
+  }
+}`
+
+func testPlanner(t *testing.T, withDeferred bool) *planner {
+	t.Helper()
+
+	jobManifest, err := parseManifest([]byte(testManifest))
+	if err != nil {
+		t.Fatalf("parseManifest() error = %v", err)
+	}
+	p := &planner{manifest: jobManifest}
+	if withDeferred {
+		p.deferred, err = parseManifest([]byte(testDeferredManifest))
+		if err != nil {
+			t.Fatalf("parseManifest() error = %v", err)
+		}
+	}
+	return p
+}
+
+func TestPlanStep(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		step             string
+		withDeferred     bool
+		wantCommand      string
+		wantSelected     []string
+		wantUnmatched    []string
+		wantNotEvaluated []string
+		wantErr          bool
+	}{
+		{
+			name:         "all the models",
+			step:         "dbt run",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.revenue", "model.shop.stg_orders"},
+		},
+		{
+			name:         "children",
+			step:         "dbt run --select stg_orders+",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.revenue", "model.shop.stg_orders"},
+		},
+		{
+			name:         "parents are filtered by resource type",
+			step:         "dbt --warn-error run -s +orders",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.stg_orders"},
+		},
+		{
+			name:         "global flag with a value",
+			step:         "dbt --log-format json run -s +orders",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.stg_orders"},
+		},
+		{
+			name:         "global flag with an inline value and alias",
+			step:         "dbt --log-level=debug -x --record-timing-info timing.prof run -s +orders",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.stg_orders"},
+		},
+		{
+			name:         "parents with a depth",
+			step:         "dbt run --select 1+revenue",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.revenue"},
+		},
+		{
+			name:         "childrens parents",
+			step:         "dbt ls --select @stg_orders --resource-type model",
+			wantCommand:  "ls",
+			wantSelected: []string{"model.shop.orders", "model.shop.revenue", "model.shop.stg_orders"},
+		},
+		{
+			name:        "eager indirect selection",
+			step:        "dbt build --select orders",
+			wantCommand: "build",
+			wantSelected: []string{
+				"model.shop.orders",
+				"test.shop.assert_revenue_matches_orders",
+				"test.shop.not_null_orders_id.1a2b",
+			},
+		},
+		{
+			name:         "cautious indirect selection",
+			step:         "dbt build --select orders --indirect-selection=cautious",
+			wantCommand:  "build",
+			wantSelected: []string{"model.shop.orders", "test.shop.not_null_orders_id.1a2b"},
+		},
+		{
+			name:         "intersection",
+			step:         "dbt run --select tag:daily,config.materialized:table",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders"},
+		},
+		{
+			name:         "nested config",
+			step:         "dbt run --select config.contract.enforced:true",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders"},
+		},
+		{
+			name:         "union in a quoted value",
+			step:         `dbt run --select "stg_orders revenue"`,
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.revenue", "model.shop.stg_orders"},
+		},
+		{
+			name:         "fqn with a wildcard",
+			step:         "dbt run --select shop.marts.*",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.revenue"},
+		},
+		{
+			name:         "directory path",
+			step:         "dbt run --select models/staging",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.stg_orders"},
+		},
+		{
+			name:         "exclude",
+			step:         "dbt run --exclude revenue",
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.stg_orders"},
+		},
+		{
+			name:         "source and excluded resource type",
+			step:         "dbt build --select source:raw+ --exclude-resource-type test",
+			wantCommand:  "build",
+			wantSelected: []string{"model.shop.orders", "model.shop.revenue", "model.shop.stg_orders"},
+		},
+		{
+			name:         "singular tests",
+			step:         "dbt test --select test_type:singular",
+			wantCommand:  "test",
+			wantSelected: []string{"test.shop.assert_revenue_matches_orders"},
+		},
+		{
+			name:         "two words command",
+			step:         "dbt source freshness",
+			wantCommand:  "source freshness",
+			wantSelected: []string{"source.shop.raw.orders"},
+		},
+		{
+			name:          "typo",
+			step:          "dbt run --select stg_ordres orders --exclude tag:dialy",
+			wantCommand:   "run",
+			wantSelected:  []string{"model.shop.orders"},
+			wantUnmatched: []string{"stg_ordres", "--exclude tag:dialy"},
+		},
+		{
+			name:             "result method",
+			step:             "dbt build --select result:error+",
+			wantCommand:      "build",
+			wantSelected:     []string{},
+			wantNotEvaluated: []string{"result:error+"},
+		},
+		{
+			name:             "state without deferring environment",
+			step:             "dbt run --select state:modified",
+			wantCommand:      "run",
+			wantSelected:     []string{},
+			wantNotEvaluated: []string{"state:modified"},
+		},
+		{
+			name:         "state modified",
+			step:         "dbt run --select state:modified",
+			withDeferred: true,
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.orders", "model.shop.revenue"},
+		},
+		{
+			name:         "state new",
+			step:         "dbt run --select state:new",
+			withDeferred: true,
+			wantCommand:  "run",
+			wantSelected: []string{"model.shop.revenue"},
+		},
+		{
+			name:             "YAML selector",
+			step:             "dbt build --selector nightly",
+			wantCommand:      "build",
+			wantSelected:     []string{},
+			wantNotEvaluated: []string{"--selector nightly"},
+		},
+		{
+			name:        "command without selection",
+			step:        "dbt deps",
+			wantCommand: "deps",
+		},
+		{
+			name:    "invalid method",
+			step:    "dbt run --select tags:daily",
+			wantErr: true,
+		},
+		{
+			name:    "not a dbt command",
+			step:    "python run.py",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := testPlanner(t, tt.withDeferred).planStep(tt.step)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("planStep() = %v, want an error", plan)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("planStep() error = %v", err)
+			}
+
+			if plan.command != tt.wantCommand {
+				t.Errorf("command = %q, want %q", plan.command, tt.wantCommand)
+			}
+			if !slices.Equal(plan.selected, tt.wantSelected) || (plan.selected == nil) != (tt.wantSelected == nil) {
+				t.Errorf("selected = %q, want %q", plan.selected, tt.wantSelected)
+			}
+			if !slices.Equal(plan.unmatched, tt.wantUnmatched) {
+				t.Errorf("unmatched = %q, want %q", plan.unmatched, tt.wantUnmatched)
+			}
+			if !slices.Equal(plan.notEvaluated, tt.wantNotEvaluated) {
+				t.Errorf("notEvaluated = %q, want %q", plan.notEvaluated, tt.wantNotEvaluated)
+			}
+		})
+	}
+}
+
+func TestIsSelectedNode(t *testing.T) {
+	t.Parallel()
+
+	fqn := []string{"shop", "marts", "finance", "revenue"}
+	tests := map[string]bool{
+		"revenue":                          true,
+		"shop":                             true,
+		"shop.marts":                       true,
+		"shop.marts.finance":               true,
+		"shop.*":                           true,
+		"shop.marts.*.revenue":             true,
+		"marts":                            false,
+		"shop.staging":                     false,
+		"rev*":                             false,
+		"shop.marts.finance.revenue.extra": false,
+	}
+	for selector, want := range tests {
+		if got := isSelectedNode(fqn, selector); got != want {
+			t.Errorf("isSelectedNode(%q) = %t, want %t", selector, got, want)
+		}
+	}
+}
+
+func TestFnmatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{pattern: "fin*", value: "finance", want: true},
+		{pattern: "fin*", value: "fin/ance", want: true},
+		{pattern: "*.orders", value: "shop.marts.orders", want: true},
+		{pattern: "stg_?rders", value: "stg_orders", want: true},
+		{pattern: "[a-c]*", value: "billing", want: true},
+		{pattern: "[!a-c]*", value: "billing", want: false},
+		{pattern: "[!a-c]*", value: "finance", want: true},
+		{pattern: "[]]", value: "]", want: true},
+		{pattern: "a[b", value: "a[b", want: true},
+		{pattern: "a.b", value: "axb", want: false},
+		{pattern: `a\*`, value: `a\b`, want: true},
+		{pattern: "fin", value: "finance", want: false},
+	}
+	for _, tt := range tests {
+		if got := fnmatch(tt.pattern, tt.value); got != tt.want {
+			t.Errorf("fnmatch(%q, %q) = %t, want %t", tt.pattern, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestPathMatches(t *testing.T) {
+	t.Parallel()
+
+	filePath := "models/marts/finance/revenue.sql"
+	tests := map[string]bool{
+		"models":                           true,
+		"./models/marts/":                  true,
+		"models/marts/finance/revenue.sql": true,
+		"models/mart":                      false,
+		"models/*":                         true,
+		"models/*/revenue.sql":             false,
+		"models/**/revenue.sql":            true,
+		"models/marts/*/*.sql":             true,
+		"**/finance":                       true,
+		"*.sql":                            false,
+		"models/staging/*":                 false,
+	}
+	for selector, want := range tests {
+		if got := pathMatches(filePath, selector); got != want {
+			t.Errorf("pathMatches(%q) = %t, want %t", selector, got, want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package job_run
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &jobRunAction{}
+	_ action.ActionWithConfigure = &jobRunAction{}
+)
+
+func JobRunAction() action.Action {
+	return &jobRunAction{}
+}
+
+type jobRunAction struct {
+	client *dbt_cloud.Client
+}
+
+func (a *jobRunAction) Metadata(
+	_ context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_job_run"
+}
+
+func (a *jobRunAction) Configure(
+	_ context.Context,
+	req action.ConfigureRequest,
+	resp *action.ConfigureResponse,
+) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		a.client = c
+	default:
+		resp.Diagnostics.AddError("Missing client", "A client is required to configure the job run action")
+	}
+}
+
+func (a *jobRunAction) Invoke(
+	ctx context.Context,
+	req action.InvokeRequest,
+	resp *action.InvokeResponse,
+) {
+	var config JobRunActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cause := defaultCause
+	if !config.Cause.IsNull() {
+		cause = config.Cause.ValueString()
+	}
+
+	jobID := int(config.JobID.ValueInt64())
+	run, err := a.client.TriggerRun(
+		ctx,
+		jobID,
+		config.GitSHA.ValueString(),
+		config.GitBranch.ValueString(),
+		"",
+		config.SchemaOverride.ValueString(),
+		cause,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to trigger the job run", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Triggered job run", map[string]any{"job_id": jobID, "run_id": run.ID})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Triggered run %d of job %d", run.ID, jobID),
+	})
+
+	if !config.WaitForCompletion.IsNull() && !config.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := time.Duration(defaultTimeoutSeconds) * time.Second
+	if !config.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(config.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	pollInterval := time.Duration(defaultPollIntervalSeconds) * time.Second
+	if !config.PollIntervalSeconds.IsNull() {
+		pollInterval = time.Duration(config.PollIntervalSeconds.ValueInt64()) * time.Second
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	runID := run.ID
+	run, err = a.client.WaitForRun(waitCtx, runID, pollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for the job run to complete",
+			fmt.Sprintf("The status of run %d of job %d could not be retrieved or the run did not complete within %s: %s", runID, jobID, timeout, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Run %d of job %d completed with status %s", run.ID, jobID, run.StatusHumanized),
+	})
+
+	if run.Status != dbt_cloud.RunStatusSuccess {
+		statusMessage := ""
+		if run.StatusMessage != nil {
+			statusMessage = ": " + *run.StatusMessage
+		}
+		resp.Diagnostics.AddError(
+			"The job run did not succeed",
+			fmt.Sprintf("Run %d of job %d completed with status %s%s\n%s", run.ID, jobID, run.StatusHumanized, statusMessage, run.Href),
+		)
+	}
+}
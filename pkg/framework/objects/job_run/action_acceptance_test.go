@@ -0,0 +1,81 @@
+package job_run_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDbtCloudJobRunAction(t *testing.T) {
+
+	jobName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	projectName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest_helper.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// the job is triggered right after being created, we don't wait for
+				// the run as the project has no repository to run against
+				Config: testAccDbtCloudJobRunActionConfig(jobName, projectName, environmentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_job.test_job", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudJobRunActionConfig(jobName, projectName, environmentName string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_job_project" {
+    name = "%s"
+}
+
+resource "dbtcloud_environment" "test_job_environment" {
+    project_id = dbtcloud_project.test_job_project.id
+    name = "%s"
+    dbt_version = "%s"
+    type = "deployment"
+}
+
+resource "dbtcloud_job" "test_job" {
+  name        = "%s"
+  project_id = dbtcloud_project.test_job_project.id
+  environment_id = dbtcloud_environment.test_job_environment.environment_id
+  execute_steps = [
+    "dbt seed"
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": false,
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.dbtcloud_job_run.seed]
+    }
+  }
+}
+
+action "dbtcloud_job_run" "seed" {
+  config {
+    job_id              = dbtcloud_job.test_job.id
+    cause               = "Terraform acceptance test"
+    wait_for_completion = false
+  }
+}
+`, projectName, environmentName, acctest_config.DBT_CLOUD_VERSION, jobName)
+}
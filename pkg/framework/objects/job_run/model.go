@@ -0,0 +1,16 @@
+package job_run
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type JobRunActionModel struct {
+	JobID               types.Int64  `tfsdk:"job_id"`
+	Cause               types.String `tfsdk:"cause"`
+	GitSHA              types.String `tfsdk:"git_sha"`
+	GitBranch           types.String `tfsdk:"git_branch"`
+	SchemaOverride      types.String `tfsdk:"schema_override"`
+	WaitForCompletion   types.Bool   `tfsdk:"wait_for_completion"`
+	TimeoutSeconds      types.Int64  `tfsdk:"timeout_seconds"`
+	PollIntervalSeconds types.Int64  `tfsdk:"poll_interval_seconds"`
+}
@@ -0,0 +1,79 @@
+package job_run
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	defaultCause               = "Triggered by Terraform"
+	defaultTimeoutSeconds      = 3600
+	defaultPollIntervalSeconds = 10
+)
+
+func (a *jobRunAction) Schema(
+	_ context.Context,
+	_ action.SchemaRequest,
+	resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Triggers a run of a dbt Cloud job and, by default, waits for the run to complete.
+			The action fails if the run ends in error or gets cancelled, or if it doesn't complete within ~~~timeout_seconds~~~.
+			Use it with an ~~~action_trigger~~~ in the ~~~lifecycle~~~ of a resource to run a job right after the resource is created or updated, e.g. to seed an environment.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the job to run",
+			},
+			"cause": schema.StringAttribute{
+				Optional:    true,
+				Description: "Reason for the run, shown in dbt Cloud - Defaults to `" + defaultCause + "`",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"git_sha": schema.StringAttribute{
+				Optional:    true,
+				Description: "Git commit SHA to run the job against, instead of the head of the environment branch",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("git_branch")),
+				},
+			},
+			"git_branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "Git branch to run the job against, instead of the environment branch",
+			},
+			"schema_override": schema.StringAttribute{
+				Optional:    true,
+				Description: "Override the destination schema configured in the connection for this run",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the run to complete - Defaults to `true`",
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of seconds to wait for the run to complete - Defaults to `3600`",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"poll_interval_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of seconds between two checks of the status of the run - Defaults to `10`",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
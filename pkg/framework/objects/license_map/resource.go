@@ -47,7 +47,7 @@ func (r *licenseMapResource) Read(
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	licenseMapID := state.ID.ValueInt64()
-	licenseMap, err := r.client.GetLicenseMap(int(licenseMapID))
+	licenseMap, err := r.client.GetLicenseMap(ctx, int(licenseMapID))
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "license map") {
 			return
@@ -91,6 +91,7 @@ func (r *licenseMapResource) Create(
 	}
 
 	licenseMap, err := r.client.CreateLicenseMap(
+		ctx,
 		plan.LicenseType.ValueString(),
 		configSsoMapping,
 	)
@@ -121,7 +122,7 @@ func (r *licenseMapResource) Delete(
 
 	licenseMapID := int(state.ID.ValueInt64())
 
-	err := r.client.DestroyLicenseMap(licenseMapID)
+	err := r.client.DestroyLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting the license map", err.Error())
 		return
@@ -142,7 +143,7 @@ func (r *licenseMapResource) Update(
 	}
 
 	licenseMapID := int(state.ID.ValueInt64())
-	licenseMap, err := r.client.GetLicenseMap(licenseMapID)
+	licenseMap, err := r.client.GetLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the license map",
@@ -185,7 +186,7 @@ func (r *licenseMapResource) Update(
 			licenseMap.SSOLicenseMappingGroups = planSsoMapping
 		}
 
-		_, err = r.client.UpdateLicenseMap(licenseMapID, *licenseMap)
+		_, err = r.client.UpdateLicenseMap(ctx, licenseMapID, *licenseMap)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update the existing license map",
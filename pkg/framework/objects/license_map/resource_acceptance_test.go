@@ -1,6 +1,7 @@
 package license_map_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -111,7 +112,7 @@ func testAccCheckDbtCloudLicenseMapExists(resource string) resource.TestCheckFun
 		if err != nil {
 			return fmt.Errorf("Can't get groupID")
 		}
-		_, err = apiClient.GetLicenseMap(licenseMapID)
+		_, err = apiClient.GetLicenseMap(context.Background(), licenseMapID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -133,7 +134,7 @@ func testAccCheckDbtCloudLicenseMapDestroy(s *terraform.State) error {
 		if err != nil {
 			return fmt.Errorf("Can't get licenseMapID")
 		}
-		_, err = apiClient.GetLicenseMap(licenseMapID)
+		_, err = apiClient.GetLicenseMap(context.Background(), licenseMapID)
 		if err == nil {
 			return fmt.Errorf("License Map still exists")
 		}
@@ -44,7 +44,7 @@ func (r *lineageIntegrationResource) Read(
 
 	projectID := data.ProjectID.ValueInt64()
 	lineageIntegrationID := data.LineageIntegrationID.ValueInt64()
-	lineageIntegration, err := r.client.GetLineageIntegration(projectID, lineageIntegrationID)
+	lineageIntegration, err := r.client.GetLineageIntegration(ctx, projectID, lineageIntegrationID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "lineage integration") {
 			return
@@ -101,6 +101,7 @@ func (r *lineageIntegrationResource) Create(
 	token := helper.ResolveWriteOnlyString(config.TokenWo, data.Token)
 
 	lineageIntegration, err := r.client.CreateLineageIntegration(
+		ctx,
 		data.ProjectID.ValueInt64(),
 		data.Name.ValueString(),
 		data.Host.ValueString(),
@@ -145,7 +146,7 @@ func (r *lineageIntegrationResource) Delete(
 	lineageID := data.LineageIntegrationID.ValueInt64()
 	projectID := data.ProjectID.ValueInt64()
 
-	err := r.client.DeleteLineageIntegration(projectID, lineageID)
+	err := r.client.DeleteLineageIntegration(ctx, projectID, lineageID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting lineage", err.Error())
 		return
@@ -195,7 +196,7 @@ func (r *lineageIntegrationResource) Update(
 	lineageID := state.LineageIntegrationID.ValueInt64()
 
 	// Update the lineage
-	_, err := r.client.UpdateLineageIntegration(projectID, lineageID, patchPayload)
+	_, err := r.client.UpdateLineageIntegration(ctx, projectID, lineageID, patchPayload)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating lineage", err.Error())
 		return
@@ -1,6 +1,7 @@
 package lineage_integration_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -255,7 +256,7 @@ func testAccCheckDbtCloudLineageIntegrationDestroy(s *terraform.State) error {
 			return fmt.Errorf("Error splitting ID: %s", err)
 		}
 
-		_, err = apiClient.GetLineageIntegration(int64(projectID), int64(lineageID))
+		_, err = apiClient.GetLineageIntegration(context.Background(), int64(projectID), int64(lineageID))
 		if err == nil {
 			return fmt.Errorf("Lineage integration still exists")
 		}
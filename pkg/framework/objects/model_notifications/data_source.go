@@ -84,7 +84,7 @@ func (d *modelNotificationsDataSource) Read(
 	}
 
 	environmentID := data.EnvironmentID.ValueString()
-	modelNotifications, err := d.client.GetModelNotifications(environmentID)
+	modelNotifications, err := d.client.GetModelNotifications(ctx, environmentID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting model notifications", err.Error())
 		return
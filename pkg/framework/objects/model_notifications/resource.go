@@ -43,7 +43,7 @@ func (r *modelNotificationsResource) Read(
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	environmentID := data.EnvironmentID.ValueString()
-	modelNotifications, err := r.client.GetModelNotifications(environmentID)
+	modelNotifications, err := r.client.GetModelNotifications(ctx, environmentID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "model notifications") {
 			return
@@ -77,6 +77,7 @@ func (r *modelNotificationsResource) Create(
 
 	environmentID := data.EnvironmentID.ValueString()
 	modelNotifications, err := r.client.CreateModelNotifications(
+		ctx,
 		environmentID,
 		data.Enabled.ValueBool(),
 		data.OnSuccess.ValueBool(),
@@ -117,7 +118,7 @@ func (r *modelNotificationsResource) Update(
 	modelNotifications := ConvertModelNotificationsModelToData(plan)
 
 	environmentID := plan.EnvironmentID.ValueString()
-	updatedModelNotifications, err := r.client.UpdateModelNotifications(environmentID, modelNotifications)
+	updatedModelNotifications, err := r.client.UpdateModelNotifications(ctx, environmentID, modelNotifications)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update model notifications",
@@ -154,7 +155,7 @@ func (r *modelNotificationsResource) Delete(
 	modelNotifications := ConvertModelNotificationsModelToData(data)
 	modelNotifications.Enabled = false
 
-	_, err := r.client.UpdateModelNotifications(environmentID, modelNotifications)
+	_, err := r.client.UpdateModelNotifications(ctx, environmentID, modelNotifications)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to disable model notifications",
@@ -103,7 +103,7 @@ func (d *notificationDataSource) Read(
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	notificationID := data.NotificationID.ValueInt64()
-	notification, err := d.client.GetNotification(fmt.Sprintf("%d", notificationID))
+	notification, err := d.client.GetNotification(ctx, fmt.Sprintf("%d", notificationID))
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "notification") {
 			return
@@ -85,7 +85,7 @@ func (r *notificationResource) Read(
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	notificationID := data.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "notification") {
 			return
@@ -161,6 +161,7 @@ func (r *notificationResource) Create(
 	}
 
 	notif, err := r.client.CreateNotification(
+		ctx,
 		int(data.UserID.ValueInt64()),
 		intOnCancel,
 		intOnFailure,
@@ -200,14 +201,14 @@ func (r *notificationResource) Delete(
 	}
 
 	notificationID := data.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the notification", err.Error())
 		return
 	}
 
 	notification.State = dbt_cloud.STATE_DELETED
-	_, err = r.client.UpdateNotification(notificationID, *notification)
+	_, err = r.client.UpdateNotification(ctx, notificationID, *notification)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting the notification", err.Error())
 		return
@@ -273,7 +274,7 @@ func (r *notificationResource) Update(
 	notification.AccountId = r.client.AccountID
 
 	// Update the notification
-	_, err := r.client.UpdateNotification(state.ID.ValueString(), notification)
+	_, err := r.client.UpdateNotification(ctx, state.ID.ValueString(), notification)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating notification", err.Error())
 		return
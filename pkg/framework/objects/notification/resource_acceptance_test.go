@@ -1,6 +1,7 @@
 package notification_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -23,7 +24,7 @@ func cleanupExistingNotifications(userID int) error {
 		return fmt.Errorf("Issue getting the client: %v", err)
 	}
 
-	notifications, err := apiClient.GetAllNotifications()
+	notifications, err := apiClient.GetAllNotifications(context.Background())
 	if err != nil {
 		return fmt.Errorf("Failed to list notifications: %v", err)
 	}
@@ -32,7 +33,7 @@ func cleanupExistingNotifications(userID int) error {
 		if notification.UserId == userID {
 
 			notification.State = dbt_cloud.STATE_DELETED
-			_, err := apiClient.UpdateNotification(strconv.Itoa(*notification.Id), notification)
+			_, err := apiClient.UpdateNotification(context.Background(), strconv.Itoa(*notification.Id), notification)
 			if err != nil {
 				return fmt.Errorf("Failed to delete notification %d: %v", *notification.Id, err)
 			}
@@ -278,7 +279,7 @@ func testAccCheckDbtCloudNotificationExists(resource string) resource.TestCheckF
 			return fmt.Errorf("Issue getting the client")
 		}
 
-		_, err = apiClient.GetNotification(rs.Primary.ID)
+		_, err = apiClient.GetNotification(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -297,7 +298,7 @@ func testAccCheckDbtCloudNotificationDestroy(s *terraform.State) error {
 		if rs.Type != "dbtcloud_notification" {
 			continue
 		}
-		_, err := apiClient.GetNotification(rs.Primary.ID)
+		_, err := apiClient.GetNotification(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Notification still exists")
 		}
@@ -0,0 +1,155 @@
+package notification_rule
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+)
+
+const (
+	eventCancel  = "cancel"
+	eventFailure = "failure"
+	eventWarning = "warning"
+	eventSuccess = "success"
+)
+
+// jobSelector holds the criteria of a rule, a job has to match all of them
+type jobSelector struct {
+	projectIDs     []int
+	environmentIDs []int
+	jobTypes       []string
+	nameRegex      *regexp.Regexp
+}
+
+// selectorFromModel returns the selector of the rule, and false when some of
+// the criteria are not known yet
+func selectorFromModel(model NotificationRuleResourceModel) (jobSelector, bool, error) {
+	if model.ProjectIDs.IsUnknown() ||
+		model.EnvironmentIDs.IsUnknown() ||
+		model.JobTypes.IsUnknown() ||
+		model.JobNameRegex.IsUnknown() {
+		return jobSelector{}, false, nil
+	}
+
+	selector := jobSelector{
+		projectIDs:     helper.Int64SetToIntSlice(model.ProjectIDs),
+		environmentIDs: helper.Int64SetToIntSlice(model.EnvironmentIDs),
+		jobTypes:       helper.StringSetToStringSlice(model.JobTypes),
+	}
+
+	if !model.JobNameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(model.JobNameRegex.ValueString())
+		if err != nil {
+			return jobSelector{}, false, err
+		}
+		selector.nameRegex = nameRegex
+	}
+	return selector, true, nil
+}
+
+// matches reports whether the job matches all the criteria of the selector
+func (s jobSelector) matches(job dbt_cloud.Job) bool {
+	if job.ID == nil || job.State == dbt_cloud.STATE_DELETED {
+		return false
+	}
+	if len(s.projectIDs) > 0 && !slices.Contains(s.projectIDs, job.ProjectId) {
+		return false
+	}
+	if len(s.environmentIDs) > 0 && !slices.Contains(s.environmentIDs, job.EnvironmentId) {
+		return false
+	}
+	if len(s.jobTypes) > 0 && !slices.Contains(s.jobTypes, job.JobType) {
+		return false
+	}
+	return s.nameRegex == nil || s.nameRegex.MatchString(job.Name)
+}
+
+// matchingJobIDs lists the jobs of the environments of the selector, or of its
+// projects when it has no environment, or of the whole account when it has
+// neither, and returns the sorted IDs of the ones it matches
+func matchingJobIDs(ctx context.Context, client *dbt_cloud.Client, selector jobSelector) ([]int, error) {
+	var jobs []dbt_cloud.JobWithEnvironment
+	switch {
+	case len(selector.environmentIDs) > 0:
+		for _, environmentID := range selector.environmentIDs {
+			environmentJobs, err := client.GetAllJobs(ctx, 0, environmentID)
+			if err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, environmentJobs...)
+		}
+	case len(selector.projectIDs) > 0:
+		for _, projectID := range selector.projectIDs {
+			projectJobs, err := client.GetAllJobs(ctx, projectID, 0)
+			if err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, projectJobs...)
+		}
+	default:
+		accountJobs, err := client.GetAllAccountJobs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		jobs = accountJobs
+	}
+
+	jobIDs := []int{}
+	for _, job := range jobs {
+		if selector.matches(job.Job) {
+			jobIDs = append(jobIDs, *job.ID)
+		}
+	}
+	slices.Sort(jobIDs)
+	return slices.Compact(jobIDs), nil
+}
+
+// notificationJobIDs returns the job IDs the notification is sent for on each
+// event
+func notificationJobIDs(notification dbt_cloud.Notification) map[string][]int {
+	return map[string][]int{
+		eventCancel:  notification.OnCancel,
+		eventFailure: notification.OnFailure,
+		eventWarning: notification.OnWarning,
+		eventSuccess: notification.OnSuccess,
+	}
+}
+
+// ruleJobIDs returns the jobs the notification is sent for if it follows the
+// rule, i.e. the same jobs for all the events of the rule and none for the
+// other events, and false when it was changed outside of the rule
+func ruleJobIDs(notification dbt_cloud.Notification, events []string) ([]int, bool) {
+	jobIDs := []int{}
+	first := true
+	for event, eventJobIDs := range notificationJobIDs(notification) {
+		eventJobIDs = slices.Sorted(slices.Values(eventJobIDs))
+		switch {
+		case !slices.Contains(events, event):
+			if len(eventJobIDs) > 0 {
+				return nil, false
+			}
+		case first:
+			jobIDs = append(jobIDs, eventJobIDs...)
+			first = false
+		case !slices.Equal(jobIDs, eventJobIDs):
+			return nil, false
+		}
+	}
+	return jobIDs, true
+}
+
+// eventsFromNotification returns the events the notification is sent on, used
+// when it is imported
+func eventsFromNotification(notification dbt_cloud.Notification) []string {
+	events := []string{}
+	for event, eventJobIDs := range notificationJobIDs(notification) {
+		if len(eventJobIDs) > 0 {
+			events = append(events, event)
+		}
+	}
+	slices.Sort(events)
+	return events
+}
@@ -0,0 +1,148 @@
+package notification_rule
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+)
+
+func testJob(id, projectID, environmentID int, name, jobType string) dbt_cloud.Job {
+	return dbt_cloud.Job{
+		ID:            &id,
+		ProjectId:     projectID,
+		EnvironmentId: environmentID,
+		Name:          name,
+		JobType:       jobType,
+		State:         dbt_cloud.STATE_ACTIVE,
+	}
+}
+
+func TestJobSelectorMatches(t *testing.T) {
+	t.Parallel()
+
+	job := testJob(1, 10, 100, "Nightly build", "scheduled")
+
+	tests := []struct {
+		name     string
+		selector jobSelector
+		job      dbt_cloud.Job
+		want     bool
+	}{
+		{
+			name:     "project",
+			selector: jobSelector{projectIDs: []int{10, 11}},
+			job:      job,
+			want:     true,
+		},
+		{
+			name:     "other project",
+			selector: jobSelector{projectIDs: []int{11}},
+			job:      job,
+		},
+		{
+			name:     "project and other environment",
+			selector: jobSelector{projectIDs: []int{10}, environmentIDs: []int{101}},
+			job:      job,
+		},
+		{
+			name:     "job type",
+			selector: jobSelector{environmentIDs: []int{100}, jobTypes: []string{"ci", "scheduled"}},
+			job:      job,
+			want:     true,
+		},
+		{
+			name:     "other job type",
+			selector: jobSelector{environmentIDs: []int{100}, jobTypes: []string{"ci"}},
+			job:      job,
+		},
+		{
+			name:     "name regex",
+			selector: jobSelector{projectIDs: []int{10}, nameRegex: regexp.MustCompile(`(?i)^nightly`)},
+			job:      job,
+			want:     true,
+		},
+		{
+			name:     "other name",
+			selector: jobSelector{projectIDs: []int{10}, nameRegex: regexp.MustCompile(`^Hourly`)},
+			job:      job,
+		},
+		{
+			name:     "deleted job",
+			selector: jobSelector{projectIDs: []int{10}},
+			job: func() dbt_cloud.Job {
+				deleted := job
+				deleted.State = dbt_cloud.STATE_DELETED
+				return deleted
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.selector.matches(tt.job); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleJobIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		notification dbt_cloud.Notification
+		events       []string
+		want         []int
+		wantOK       bool
+	}{
+		{
+			name: "same jobs for the events",
+			notification: dbt_cloud.Notification{
+				OnFailure: []int{2, 1},
+				OnWarning: []int{1, 2},
+			},
+			events: []string{eventFailure, eventWarning},
+			want:   []int{1, 2},
+			wantOK: true,
+		},
+		{
+			name:         "no jobs",
+			notification: dbt_cloud.Notification{OnFailure: []int{}},
+			events:       []string{eventFailure, eventCancel},
+			want:         []int{},
+			wantOK:       true,
+		},
+		{
+			name: "jobs added to one event",
+			notification: dbt_cloud.Notification{
+				OnFailure: []int{1},
+				OnWarning: []int{1, 2},
+			},
+			events: []string{eventFailure, eventWarning},
+		},
+		{
+			name: "jobs added to another event",
+			notification: dbt_cloud.Notification{
+				OnFailure: []int{1},
+				OnSuccess: []int{1},
+			},
+			events: []string{eventFailure},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := ruleJobIDs(tt.notification, tt.events)
+			if ok != tt.wantOK || !slices.Equal(got, tt.want) {
+				t.Errorf("ruleJobIDs() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package notification_rule
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NotificationRuleResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UserID           types.Int64  `tfsdk:"user_id"`
+	NotificationType types.Int64  `tfsdk:"notification_type"`
+	ExternalEmail    types.String `tfsdk:"external_email"`
+	SlackChannelID   types.String `tfsdk:"slack_channel_id"`
+	SlackChannelName types.String `tfsdk:"slack_channel_name"`
+	Events           types.Set    `tfsdk:"events"`
+	ProjectIDs       types.Set    `tfsdk:"project_ids"`
+	EnvironmentIDs   types.Set    `tfsdk:"environment_ids"`
+	JobTypes         types.Set    `tfsdk:"job_types"`
+	JobNameRegex     types.String `tfsdk:"job_name_regex"`
+	JobIDs           types.Set    `tfsdk:"job_ids"`
+}
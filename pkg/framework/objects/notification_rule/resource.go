@@ -0,0 +1,369 @@
+package notification_rule
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &notificationRuleResource{}
+	_ resource.ResourceWithConfigure      = &notificationRuleResource{}
+	_ resource.ResourceWithImportState    = &notificationRuleResource{}
+	_ resource.ResourceWithValidateConfig = &notificationRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &notificationRuleResource{}
+)
+
+func NotificationRuleResource() resource.Resource {
+	return &notificationRuleResource{}
+}
+
+type notificationRuleResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *notificationRuleResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_notification_rule"
+}
+
+func (r *notificationRuleResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+func (r *notificationRuleResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *notificationRuleResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.JobNameRegex.IsNull() && !data.JobNameRegex.IsUnknown() {
+		if _, err := regexp.Compile(data.JobNameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("job_name_regex"),
+				"Invalid regular expression",
+				err.Error(),
+			)
+		}
+	}
+
+	switch {
+	case data.NotificationType.ValueInt64() == 2 &&
+		(data.SlackChannelID.IsNull() || data.SlackChannelName.IsNull()):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_type"),
+			"Notification type is not compatible with the other attributes",
+			"Notification type 2 requires a Slack channel ID and Slack channel name.",
+		)
+	case data.NotificationType.ValueInt64() == 4 && data.ExternalEmail.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_type"),
+			"Notification type is not compatible with the other attributes",
+			"Notification type 4 requires an external email.",
+		)
+	case (data.NotificationType.IsNull() || data.NotificationType.ValueInt64() == 1) &&
+		!(data.ExternalEmail.IsNull() && data.SlackChannelID.IsNull() && data.SlackChannelName.IsNull()):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("notification_type"),
+			"Notification type is not compatible with the other attributes",
+			"Notification type 1 is for internal notifications only. Please remove the external email, slack channel ID, and slack channel name attributes.",
+		)
+	}
+}
+
+// ModifyPlan resolves the jobs matching the rule, so that the plan shows when
+// jobs start or stop matching it
+func (r *notificationRuleResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobIDs, diags := r.resolveJobIDs(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("job_ids"), jobIDs)...)
+}
+
+func (r *notificationRuleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(ctx, state.ID.ValueString())
+	if err != nil {
+		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "notification rule") {
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the notification rule", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	if state.Events.IsNull() {
+		state.Events, diags = types.SetValueFrom(ctx, types.StringType, eventsFromNotification(*notification))
+		resp.Diagnostics.Append(diags...)
+	}
+
+	state.UserID = types.Int64Value(int64(notification.UserId))
+	state.NotificationType = types.Int64Value(int64(notification.NotificationType))
+	state.ExternalEmail = types.StringPointerValue(notification.ExternalEmail)
+	state.SlackChannelID = types.StringPointerValue(notification.SlackChannelID)
+	state.SlackChannelName = types.StringPointerValue(notification.SlackChannelName)
+
+	// when the job IDs of the notification were changed outside of the rule,
+	// they are set to null so that the next plan updates them
+	state.JobIDs = types.SetNull(types.Int64Type)
+	if jobIDs, ok := ruleJobIDs(*notification, helper.StringSetToStringSlice(state.Events)); ok {
+		state.JobIDs, diags = types.SetValueFrom(ctx, types.Int64Type, jobIDs)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *notificationRuleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the jobs are resolved at plan time, unless the rule depended on values
+	// not known yet
+	if plan.JobIDs.IsUnknown() {
+		jobIDs, diags := r.resolveJobIDs(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.JobIDs = jobIDs
+	}
+
+	lists := notificationLists(plan)
+	notification, err := r.client.CreateNotification(
+		ctx,
+		int(plan.UserID.ValueInt64()),
+		lists[eventCancel],
+		lists[eventFailure],
+		lists[eventWarning],
+		lists[eventSuccess],
+		dbt_cloud.STATE_ACTIVE,
+		int(plan.NotificationType.ValueInt64()),
+		plan.ExternalEmail.ValueStringPointer(),
+		plan.SlackChannelID.ValueStringPointer(),
+		plan.SlackChannelName.ValueStringPointer(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create the notification rule",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(*notification.Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *notificationRuleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.JobIDs.IsUnknown() {
+		jobIDs, diags := r.resolveJobIDs(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.JobIDs = jobIDs
+	}
+
+	notificationID, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid notification ID", err.Error())
+		return
+	}
+
+	lists := notificationLists(plan)
+	notification := dbt_cloud.Notification{
+		Id:               &notificationID,
+		AccountId:        r.client.AccountID,
+		UserId:           int(plan.UserID.ValueInt64()),
+		OnCancel:         lists[eventCancel],
+		OnFailure:        lists[eventFailure],
+		OnWarning:        lists[eventWarning],
+		OnSuccess:        lists[eventSuccess],
+		State:            dbt_cloud.STATE_ACTIVE,
+		NotificationType: int(plan.NotificationType.ValueInt64()),
+		ExternalEmail:    plan.ExternalEmail.ValueStringPointer(),
+		SlackChannelID:   plan.SlackChannelID.ValueStringPointer(),
+		SlackChannelName: plan.SlackChannelName.ValueStringPointer(),
+	}
+	if _, err := r.client.UpdateNotification(ctx, plan.ID.ValueString(), notification); err != nil {
+		resp.Diagnostics.AddError("Error updating the notification rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *notificationRuleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationID := state.ID.ValueString()
+	notification, err := r.client.GetNotification(ctx, notificationID)
+	if err != nil {
+		if dbt_cloud.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error getting the notification rule", err.Error())
+		return
+	}
+
+	notification.State = dbt_cloud.STATE_DELETED
+	if _, err := r.client.UpdateNotification(ctx, notificationID, *notification); err != nil {
+		resp.Diagnostics.AddError("Error deleting the notification rule", err.Error())
+		return
+	}
+}
+
+func (r *notificationRuleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// resolveJobIDs returns the IDs of the jobs matching the rule, unknown when
+// the rule depends on values not known yet
+func (r *notificationRuleResource) resolveJobIDs(
+	ctx context.Context,
+	plan NotificationRuleResourceModel,
+) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	selector, known, err := selectorFromModel(plan)
+	if err != nil {
+		diags.AddAttributeError(path.Root("job_name_regex"), "Invalid regular expression", err.Error())
+		return types.SetUnknown(types.Int64Type), diags
+	}
+	if !known {
+		return types.SetUnknown(types.Int64Type), diags
+	}
+
+	jobIDs, err := matchingJobIDs(ctx, r.client, selector)
+	if err != nil {
+		diags.AddError(
+			"Error resolving the jobs of the notification rule",
+			"Could not list the jobs matching the rule: "+err.Error(),
+		)
+		return types.SetUnknown(types.Int64Type), diags
+	}
+
+	jobIDsSet, setDiags := types.SetValueFrom(ctx, types.Int64Type, jobIDs)
+	diags.Append(setDiags...)
+	return jobIDsSet, diags
+}
+
+// notificationLists returns the job IDs to send the notification for on each
+// event, the ones of the rule for its events and none for the others
+func notificationLists(plan NotificationRuleResourceModel) map[string][]int {
+	jobIDs := helper.Int64SetToIntSlice(plan.JobIDs)
+	events := helper.StringSetToStringSlice(plan.Events)
+
+	lists := map[string][]int{}
+	for _, event := range []string{eventCancel, eventFailure, eventWarning, eventSuccess} {
+		lists[event] = []int{}
+		if slices.Contains(events, event) {
+			lists[event] = jobIDs
+		}
+	}
+	return lists
+}
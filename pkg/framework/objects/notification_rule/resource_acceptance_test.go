@@ -0,0 +1,131 @@
+package notification_rule_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudNotificationRuleResource(t *testing.T) {
+	userID := acctest_config.AcceptanceTestConfig.DbtCloudUserId
+	notificationEmail := fmt.Sprintf("%d-rule@dbtlabs.com", time.Now().Unix())
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudNotificationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudNotificationRuleResourceConfig(projectName, userID, notificationEmail, "^Nightly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_notification_rule.test", "id"),
+					resource.TestCheckResourceAttr("dbtcloud_notification_rule.test", "job_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"dbtcloud_notification_rule.test", "job_ids.*",
+						"dbtcloud_job.nightly", "id",
+					),
+				),
+			},
+			{
+				Config: testAccDbtCloudNotificationRuleResourceConfig(projectName, userID, notificationEmail, "TF$"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_notification_rule.test", "job_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "dbtcloud_notification_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"project_ids", "job_name_regex"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudNotificationRuleResourceConfig(
+	projectName string,
+	userID int,
+	notificationEmail string,
+	jobNameRegex string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_environment" "test_environment" {
+  project_id  = dbtcloud_project.test_project.id
+  name        = "Prod"
+  dbt_version = "%s"
+  type        = "deployment"
+}
+
+resource "dbtcloud_job" "nightly" {
+  name           = "Nightly TF"
+  project_id     = dbtcloud_project.test_project.id
+  environment_id = dbtcloud_environment.test_environment.environment_id
+  execute_steps  = ["dbt build"]
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false,
+  }
+}
+
+resource "dbtcloud_job" "hourly" {
+  name           = "Hourly TF"
+  project_id     = dbtcloud_project.test_project.id
+  environment_id = dbtcloud_environment.test_environment.environment_id
+  execute_steps  = ["dbt build"]
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false,
+  }
+}
+
+resource "dbtcloud_notification_rule" "test" {
+  user_id           = %d
+  notification_type = 4
+  external_email    = "%s"
+  events            = ["failure", "cancel"]
+  project_ids       = [dbtcloud_project.test_project.id]
+  job_name_regex    = "%s"
+
+  # the jobs are created before the rule resolves them
+  depends_on = [dbtcloud_job.nightly, dbtcloud_job.hourly]
+}
+`, projectName, acctest_config.DBT_CLOUD_VERSION, userID, notificationEmail, jobNameRegex)
+}
+
+func testAccCheckDbtCloudNotificationRuleDestroy(s *terraform.State) error {
+	apiClient, err := acctest_helper.SharedClient()
+	if err != nil {
+		return fmt.Errorf("Issue getting the client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbtcloud_notification_rule" {
+			continue
+		}
+		_, err := apiClient.GetNotification(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Notification rule still exists")
+		}
+		if !dbt_cloud.IsNotFound(err) {
+			return fmt.Errorf("expected a not found error, got %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package notification_rule_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestNotificationRuleResourceFakeAPI checks that the jobs created after the
+// rule are added to the notification, against the in-memory fake of the dbt
+// Cloud API.
+func TestNotificationRuleResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	project := server.Seed(fakeapi.Projects, fakeapi.Object{"name": "analytics"})
+	prod := server.Seed(fakeapi.Environments, fakeapi.Object{"project_id": project["id"], "name": "Prod"})
+	ci := server.Seed(fakeapi.Environments, fakeapi.Object{"project_id": project["id"], "name": "CI"})
+	server.Seed(fakeapi.Jobs, fakeapi.Object{
+		"project_id":     project["id"],
+		"environment_id": prod["id"],
+		"name":           "Nightly",
+		"job_type":       "scheduled",
+	})
+	server.Seed(fakeapi.Jobs, fakeapi.Object{
+		"project_id":     project["id"],
+		"environment_id": ci["id"],
+		"name":           "CI",
+		"job_type":       "ci",
+	})
+	projectID := fmt.Sprint(project["id"])
+
+	checkNotifiedJobs := func(count int) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			notifications := server.List(fakeapi.Notifications)
+			if len(notifications) != 1 {
+				return fmt.Errorf("expected 1 notification, got %v", notifications)
+			}
+			onFailure, _ := notifications[0]["on_failure"].([]any)
+			onSuccess, _ := notifications[0]["on_success"].([]any)
+			if len(onFailure) != count || len(onSuccess) != 0 {
+				return fmt.Errorf("expected %d jobs notified on failure only, got %v", count, notifications[0])
+			}
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testNotificationRuleConfig(projectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_notification_rule.test", "job_ids.#", "1"),
+					checkNotifiedJobs(1),
+				),
+			},
+			{
+				// a job matching the rule is created outside of Terraform
+				PreConfig: func() {
+					server.Seed(fakeapi.Jobs, fakeapi.Object{
+						"project_id":     project["id"],
+						"environment_id": prod["id"],
+						"name":           "Hourly",
+						"job_type":       "scheduled",
+					})
+				},
+				Config: server.ProviderConfig() + testNotificationRuleConfig(projectID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dbtcloud_notification_rule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_notification_rule.test", "job_ids.#", "2"),
+					checkNotifiedJobs(2),
+				),
+			},
+			{
+				ResourceName:            "dbtcloud_notification_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"project_ids", "job_types"},
+			},
+		},
+	})
+}
+
+func testNotificationRuleConfig(projectID string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_notification_rule" "test" {
+  user_id           = 1
+  notification_type = 4
+  external_email    = "alerts@example.com"
+  events            = ["failure"]
+  project_ids       = [%s]
+  job_types         = ["scheduled"]
+}
+`, projectID)
+}
+
+// TestNotificationRuleResourceAccountJobsFakeAPI checks that a rule without
+// projects or environments matches the jobs of all the projects of the account.
+func TestNotificationRuleResourceAccountJobsFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	for _, name := range []string{"analytics", "marketing"} {
+		project := server.Seed(fakeapi.Projects, fakeapi.Object{"name": name})
+		environment := server.Seed(fakeapi.Environments, fakeapi.Object{"project_id": project["id"], "name": "Prod"})
+		server.Seed(fakeapi.Jobs, fakeapi.Object{
+			"project_id":     project["id"],
+			"environment_id": environment["id"],
+			"name":           "Nightly",
+			"job_type":       "scheduled",
+		})
+		server.Seed(fakeapi.Jobs, fakeapi.Object{
+			"project_id":     project["id"],
+			"environment_id": environment["id"],
+			"name":           "CI",
+			"job_type":       "ci",
+		})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+resource "dbtcloud_notification_rule" "test" {
+  user_id           = 1
+  notification_type = 4
+  external_email    = "alerts@example.com"
+  events            = ["failure"]
+  job_types         = ["scheduled"]
+}
+`,
+				Check: resource.TestCheckResourceAttr("dbtcloud_notification_rule.test", "job_ids.#", "2"),
+			},
+		},
+	})
+}
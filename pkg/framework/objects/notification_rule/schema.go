@@ -0,0 +1,114 @@
+package notification_rule
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var resourceSchema = schema.Schema{
+	Description: helper.DocString(
+		`Setup a notification to internal users, external email addresses or Slack channels for all the jobs matching a rule, instead of listing the job IDs like ~~~dbtcloud_notification~~~ does.
+
+		The jobs are resolved at plan time from the projects or environments of the rule, or from all the projects of the account when it has neither, so a plan shows an update of ~~~job_ids~~~ as soon as jobs start or stop matching.`,
+	),
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the notification",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"user_id": schema.Int64Attribute{
+			Required:    true,
+			Description: "Internal dbt Cloud User ID. Must be the user_id for an existing user even if the notification is an external one. In the case of a Slack notification, it must be the user_id of the user that set up the Slack Integration.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"notification_type": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(1),
+			Description: "Type of notification (1 = dbt Cloud user email (default): does not require an external_email ; 2 = Slack channel: requires `slack_channel_id` and `slack_channel_name` ; 4 = external email: requires setting an `external_email`)",
+			Validators: []validator.Int64{
+				int64validator.OneOf(1, 2, 4),
+			},
+		},
+		"external_email": schema.StringAttribute{
+			Optional:    true,
+			Description: "The external email to receive the notification",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(
+					path.MatchRoot("slack_channel_id"),
+					path.MatchRoot("slack_channel_name"),
+				),
+			},
+		},
+		"slack_channel_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The ID of the Slack channel to receive the notification. It can be found at the bottom of the Slack channel settings",
+		},
+		"slack_channel_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The name of the slack channel",
+		},
+		"events": schema.SetAttribute{
+			ElementType: types.StringType,
+			Required:    true,
+			Description: "The run results to send the notification on, any of `cancel`, `failure`, `warning` and `success`",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(
+					stringvalidator.OneOf(eventCancel, eventFailure, eventWarning, eventSuccess),
+				),
+			},
+		},
+		"project_ids": schema.SetAttribute{
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Description: "Match the jobs of these projects, when both `project_ids` and `environment_ids` are set the jobs must match both. When neither is set, the jobs of all the projects of the account are matched",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"environment_ids": schema.SetAttribute{
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Description: "Match the jobs of these environments",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"job_types": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Only match the jobs of these types, any of `ci`, `merge`, `scheduled`, `other` and `adaptive`",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(
+					stringvalidator.OneOf("ci", "merge", "scheduled", "other", "adaptive"),
+				),
+			},
+		},
+		"job_name_regex": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only match the jobs with a name matching this regular expression (RE2 syntax, unanchored)",
+		},
+		"job_ids": schema.SetAttribute{
+			ElementType: types.Int64Type,
+			Computed:    true,
+			Description: "The IDs of the jobs matching the rule, that the notification is sent for",
+		},
+	},
+}
@@ -56,7 +56,7 @@ func (r *notificationSettingResource) Read(
 		return
 	}
 
-	setting, err := r.client.GetNotificationSetting(state.ID.ValueInt64())
+	setting, err := r.client.GetNotificationSetting(ctx, state.ID.ValueInt64())
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "notification setting") {
 			return
@@ -81,7 +81,7 @@ func (r *notificationSettingResource) Create(
 		return
 	}
 
-	created, err := r.client.CreateNotificationSetting(modelToAPI(plan))
+	created, err := r.client.CreateNotificationSetting(ctx, modelToAPI(plan))
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating notification setting", err.Error())
 		return
@@ -103,7 +103,7 @@ func (r *notificationSettingResource) Update(
 		return
 	}
 
-	updated, err := r.client.UpdateNotificationSetting(plan.ID.ValueInt64(), modelToAPI(plan))
+	updated, err := r.client.UpdateNotificationSetting(ctx, plan.ID.ValueInt64(), modelToAPI(plan))
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating notification setting", err.Error())
 		return
@@ -125,7 +125,7 @@ func (r *notificationSettingResource) Delete(
 		return
 	}
 
-	if err := r.client.DeleteNotificationSetting(state.ID.ValueInt64()); err != nil {
+	if err := r.client.DeleteNotificationSetting(ctx, state.ID.ValueInt64()); err != nil {
 		resp.Diagnostics.AddError("Error deleting notification setting", err.Error())
 		return
 	}
@@ -1,6 +1,7 @@
 package notification_setting_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -178,7 +179,7 @@ func testAccCheckDbtCloudNotificationSettingExists(resourceName string) resource
 			return fmt.Errorf("Issue getting the client: %v", err)
 		}
 
-		if _, err := apiClient.GetNotificationSetting(id); err != nil {
+		if _, err := apiClient.GetNotificationSetting(context.Background(), id); err != nil {
 			return fmt.Errorf("error fetching notification setting %s: %v", rs.Primary.ID, err)
 		}
 		return nil
@@ -201,7 +202,7 @@ func testAccCheckDbtCloudNotificationSettingDestroy(s *terraform.State) error {
 			return fmt.Errorf("invalid notification setting ID %q: %v", rs.Primary.ID, err)
 		}
 
-		_, err = apiClient.GetNotificationSetting(id)
+		_, err = apiClient.GetNotificationSetting(context.Background(), id)
 		if err == nil {
 			return fmt.Errorf("Notification setting %s still exists", rs.Primary.ID)
 		}
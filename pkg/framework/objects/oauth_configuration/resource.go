@@ -82,7 +82,7 @@ func (r *oAuthConfigurationResource) Read(
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	oAuthConfigurationID := state.ID.ValueInt64()
-	retrievedOAuthConfiguration, err := r.client.GetOAuthConfiguration(oAuthConfigurationID)
+	retrievedOAuthConfiguration, err := r.client.GetOAuthConfiguration(ctx, oAuthConfigurationID)
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "OAuth configuration") {
@@ -146,6 +146,7 @@ func (r *oAuthConfigurationResource) Create(
 	applicationURI := plan.ApplicationIdUri.ValueString()
 
 	createdOAuthConfiguration, err := r.client.CreateOAuthConfiguration(
+		ctx,
 		oAuthType,
 		name,
 		clientID,
@@ -181,7 +182,7 @@ func (r *oAuthConfigurationResource) Delete(
 
 	oAuthConfigurationID := state.ID.ValueInt64()
 
-	err := r.client.DeleteOAuthConfiguration(oAuthConfigurationID)
+	err := r.client.DeleteOAuthConfiguration(ctx, oAuthConfigurationID)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -220,7 +221,7 @@ func (r *oAuthConfigurationResource) Update(
 
 	oAuthConfigurationID := state.ID.ValueInt64()
 
-	retrievedOAuthConfiguration, err := r.client.GetOAuthConfiguration(oAuthConfigurationID)
+	retrievedOAuthConfiguration, err := r.client.GetOAuthConfiguration(ctx, oAuthConfigurationID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting OAuth configuration",
@@ -260,6 +261,7 @@ func (r *oAuthConfigurationResource) Update(
 	}
 
 	_, err = r.client.UpdateOAuthConfiguration(
+		ctx,
 		oAuthConfigurationID,
 		*retrievedOAuthConfiguration,
 	)
@@ -185,7 +185,7 @@ func (r *openAIIntegrationResource) Create(
 		return
 	}
 
-	created, err := r.client.CreateOpenAIIntegration(buildIntegration(plan, config))
+	created, err := r.client.CreateOpenAIIntegration(ctx, buildIntegration(plan, config))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create OpenAI integration", err.Error())
 		return
@@ -208,7 +208,7 @@ func (r *openAIIntegrationResource) Read(
 		return
 	}
 
-	integration, err := r.client.GetOpenAIIntegration(state.ID.ValueInt64())
+	integration, err := r.client.GetOpenAIIntegration(ctx, state.ID.ValueInt64())
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "OpenAI integration") {
 			return
@@ -240,7 +240,7 @@ func (r *openAIIntegrationResource) Update(
 		return
 	}
 
-	updated, err := r.client.UpdateOpenAIIntegration(state.ID.ValueInt64(), buildIntegration(plan, config))
+	updated, err := r.client.UpdateOpenAIIntegration(ctx, state.ID.ValueInt64(), buildIntegration(plan, config))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update OpenAI integration", err.Error())
 		return
@@ -263,7 +263,7 @@ func (r *openAIIntegrationResource) Delete(
 		return
 	}
 
-	if err := r.client.DeleteOpenAIIntegration(state.ID.ValueInt64()); err != nil {
+	if err := r.client.DeleteOpenAIIntegration(ctx, state.ID.ValueInt64()); err != nil {
 		resp.Diagnostics.AddError("Unable to delete OpenAI integration", err.Error())
 	}
 }
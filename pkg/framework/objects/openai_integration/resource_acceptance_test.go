@@ -1,6 +1,7 @@
 package openai_integration_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -280,7 +281,7 @@ func testAccCheckDbtCloudOpenAIIntegrationDestroy(s *terraform.State) error {
 			return fmt.Errorf("could not parse OpenAI integration ID %q: %w", rs.Primary.ID, err)
 		}
 
-		_, err = apiClient.GetOpenAIIntegration(id)
+		_, err = apiClient.GetOpenAIIntegration(context.Background(), id)
 		if err == nil {
 			return fmt.Errorf("OpenAI integration %d still exists after destroy", id)
 		}
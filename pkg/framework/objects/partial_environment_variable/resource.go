@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/environment_variable"
@@ -49,9 +48,9 @@ func (r *partialEnvironmentVariableResource) Read(
 	name := state.Name.ValueString()
 
 	// Get environment variable from API
-	envVar, err := r.client.GetEnvironmentVariable(projectID, name)
+	envVar, err := r.client.GetEnvironmentVariable(ctx, projectID, name)
 	if err != nil {
-		if strings.Contains(err.Error(), "resource-not-found") {
+		if dbt_cloud.IsNotFound(err) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The environment variable resource was not found and has been removed from the state.",
@@ -122,8 +121,8 @@ func (r *partialEnvironmentVariableResource) Create(
 	}
 
 	// Check if environment variable already exists and fetch it
-	existingEnvVar, err := r.client.GetEnvironmentVariable(projectID, name)
-	if err != nil && !strings.Contains(err.Error(), "resource-not-found") {
+	existingEnvVar, err := r.client.GetEnvironmentVariable(ctx, projectID, name)
+	if err != nil && !dbt_cloud.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error checking for existing environment variable",
 			"Error: "+err.Error(),
@@ -167,7 +166,7 @@ func (r *partialEnvironmentVariableResource) Create(
 			ProjectID:         projectID,
 			EnvironmentValues: mergedEnvVars,
 		}
-		_, err := r.client.UpdateEnvironmentVariable(projectID, envVar)
+		_, err := r.client.UpdateEnvironmentVariable(ctx, projectID, envVar)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update existing environment variable",
@@ -178,7 +177,7 @@ func (r *partialEnvironmentVariableResource) Create(
 		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	} else {
 		// It doesn't exist, so we create it with our values
-		_, err := r.client.CreateEnvironmentVariable(projectID, name, configEnvValues)
+		_, err := r.client.CreateEnvironmentVariable(ctx, projectID, name, configEnvValues)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to create environment variable",
@@ -209,9 +208,9 @@ func (r *partialEnvironmentVariableResource) Delete(
 	name := state.Name.ValueString()
 
 	// Get the current environment variable
-	envVar, err := r.client.GetEnvironmentVariable(projectID, name)
+	envVar, err := r.client.GetEnvironmentVariable(ctx, projectID, name)
 	if err != nil {
-		if strings.Contains(err.Error(), "resource-not-found") {
+		if dbt_cloud.IsNotFound(err) {
 			// Already gone, nothing to do
 			return
 		}
@@ -252,7 +251,7 @@ func (r *partialEnvironmentVariableResource) Delete(
 			EnvironmentValues: removableValues,
 		}
 
-		_, err = r.client.UpdateEnvironmentVariable(projectID, updatedEnvVar)
+		_, err = r.client.UpdateEnvironmentVariable(ctx, projectID, updatedEnvVar)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update the environment variable",
@@ -262,7 +261,7 @@ func (r *partialEnvironmentVariableResource) Delete(
 		}
 	} else {
 		// If no environment values remain, delete the entire environment variable
-		_, err = r.client.DeleteEnvironmentVariable(name, projectID)
+		_, err = r.client.DeleteEnvironmentVariable(ctx, name, projectID)
 		if err != nil {
 			resp.Diagnostics.AddError("Error deleting the environment variable", err.Error())
 			return
@@ -287,7 +286,7 @@ func (r *partialEnvironmentVariableResource) Update(
 	name := state.Name.ValueString()
 
 	// Get current environment variable from API
-	envVar, err := r.client.GetEnvironmentVariable(projectID, name)
+	envVar, err := r.client.GetEnvironmentVariable(ctx, projectID, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the environment variable",
@@ -340,7 +339,7 @@ func (r *partialEnvironmentVariableResource) Update(
 			EnvironmentValues: updateEnvValues,
 		}
 
-		_, err = r.client.UpdateEnvironmentVariable(projectID, updatedEnvVar)
+		_, err = r.client.UpdateEnvironmentVariable(ctx, projectID, updatedEnvVar)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update the environment variable",
@@ -1,6 +1,7 @@
 package partial_environment_variable_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -264,7 +265,7 @@ func testAccCheckDbtCloudEnvironmentVariableExists(resource string) resource.Tes
 		}
 
 		name := idParts[1]
-		_, err = apiClient.GetEnvironmentVariable(projectID, name)
+		_, err = apiClient.GetEnvironmentVariable(context.Background(), projectID, name)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -294,7 +295,7 @@ func testAccCheckDbtCloudEnvironmentVariableDestroy(s *terraform.State) error {
 		}
 
 		name := idParts[1]
-		_, err = apiClient.GetEnvironmentVariable(projectID, name)
+		_, err = apiClient.GetEnvironmentVariable(context.Background(), projectID, name)
 		if err == nil {
 			return fmt.Errorf("Environment variable still exists")
 		}
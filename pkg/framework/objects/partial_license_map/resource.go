@@ -43,7 +43,7 @@ func (r *partialLicenseMapResource) Read(
 
 	// check if the ID exists
 	licenseMapID := int(state.ID.ValueInt64())
-	licenseMap, err := r.client.GetLicenseMap(licenseMapID)
+	licenseMap, err := r.client.GetLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "license map") {
 			return
@@ -55,7 +55,7 @@ func (r *partialLicenseMapResource) Read(
 	// if the ID exists, make sure that it is the one we are looking for
 	if !matchPartial(state, *licenseMap) {
 		// read all the objects and check if one exists
-		allLicenseMaps, err := r.client.GetAllLicenseMaps()
+		allLicenseMaps, err := r.client.GetAllLicenseMaps(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to get all license maps",
@@ -140,7 +140,7 @@ func (r *partialLicenseMapResource) Create(
 
 	// check if it exists
 	// we don't need to check uniqueness and can just return the first as the API only allows one license type
-	allLicenseMaps, err := r.client.GetAllLicenseMaps()
+	allLicenseMaps, err := r.client.GetAllLicenseMaps(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get all license maps",
@@ -179,7 +179,7 @@ func (r *partialLicenseMapResource) Create(
 			allSsoMapping := append(remoteSsoMapping, missingSsoMapping...)
 			fullLicenseMap.SSOLicenseMappingGroups = allSsoMapping
 
-			_, err := r.client.UpdateLicenseMap(*licenseMapID, *fullLicenseMap)
+			_, err := r.client.UpdateLicenseMap(ctx, *licenseMapID, *fullLicenseMap)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Unable to update the existing license map",
@@ -193,6 +193,7 @@ func (r *partialLicenseMapResource) Create(
 	} else {
 		// it doesn't exist so we create it
 		licenseMap, err := r.client.CreateLicenseMap(
+			ctx,
 			plan.LicenseType.ValueString(),
 			configSsoMapping,
 		)
@@ -223,7 +224,7 @@ func (r *partialLicenseMapResource) Delete(
 	}
 
 	licenseMapID := int(state.ID.ValueInt64())
-	licenseMap, err := r.client.GetLicenseMap(licenseMapID)
+	licenseMap, err := r.client.GetLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the license map", err.Error())
 		return
@@ -248,7 +249,7 @@ func (r *partialLicenseMapResource) Delete(
 		// we update the object if there are some partial values left
 		// but we leave the object existing, without deleting it entirely
 		licenseMap.SSOLicenseMappingGroups = requiredSsoMapping
-		_, err = r.client.UpdateLicenseMap(licenseMapID, *licenseMap)
+		_, err = r.client.UpdateLicenseMap(ctx, licenseMapID, *licenseMap)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update the existing license map",
@@ -258,7 +259,7 @@ func (r *partialLicenseMapResource) Delete(
 		}
 	} else {
 		// we delete the object if there is no config left at all
-		err = r.client.DestroyLicenseMap(licenseMapID)
+		err = r.client.DestroyLicenseMap(ctx, licenseMapID)
 		if err != nil {
 			resp.Diagnostics.AddError("Error deleting the license map", err.Error())
 			return
@@ -280,7 +281,7 @@ func (r *partialLicenseMapResource) Update(
 	}
 
 	licenseMapID := int(state.ID.ValueInt64())
-	licenseMap, err := r.client.GetLicenseMap(licenseMapID)
+	licenseMap, err := r.client.GetLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the license map",
@@ -325,7 +326,7 @@ func (r *partialLicenseMapResource) Update(
 		// we update the values to be the plan ones for global
 		// and the calculated ones for the local ones
 		licenseMap.SSOLicenseMappingGroups = requiredSsoMapping
-		_, err = r.client.UpdateLicenseMap(licenseMapID, *licenseMap)
+		_, err = r.client.UpdateLicenseMap(ctx, licenseMapID, *licenseMap)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update the existing license map",
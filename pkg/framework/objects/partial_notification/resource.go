@@ -86,7 +86,7 @@ func (r *partialNotificationResource) Read(
 
 	// check if the ID exists
 	notificationID := state.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "notification") {
 			return
@@ -98,7 +98,7 @@ func (r *partialNotificationResource) Read(
 	// if the ID exists, make sure that it is the one we are looking for
 	if !matchPartial(state, *notification) {
 		// read all the notifications and check if one exists
-		allNotifications, err := r.client.GetAllNotifications()
+		allNotifications, err := r.client.GetAllNotifications(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to get all notifications",
@@ -196,7 +196,7 @@ func (r *partialNotificationResource) Create(
 
 	// check if it exists
 	// we don't need to check uniqueness and can just return the first as the API only allows one notification per user
-	allNotifications, err := r.client.GetAllNotifications()
+	allNotifications, err := r.client.GetAllNotifications(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get all notifications",
@@ -256,6 +256,7 @@ func (r *partialNotificationResource) Create(
 			allOnSuccess := append(remoteOnSuccess, missingOnSuccess...)
 
 			_, err := r.client.UpdateNotification(
+				ctx,
 				notificationID,
 				dbt_cloud.Notification{
 					AccountId:        r.client.AccountID,
@@ -285,6 +286,7 @@ func (r *partialNotificationResource) Create(
 	} else {
 		// it doesn't exist so we create it
 		notif, err := r.client.CreateNotification(
+			ctx,
 			int(plan.UserID.ValueInt64()),
 			intOnCancel,
 			intOnFailure,
@@ -323,7 +325,7 @@ func (r *partialNotificationResource) Delete(
 	}
 
 	notificationID := state.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the notification", err.Error())
 		return
@@ -356,6 +358,7 @@ func (r *partialNotificationResource) Delete(
 		// we update the notification if there are some jobs left
 		// but we leave the notification existing, without deleting it entirely
 		_, err = r.client.UpdateNotification(
+			ctx,
 			notificationID,
 			dbt_cloud.Notification{
 				AccountId:        r.client.AccountID,
@@ -382,7 +385,7 @@ func (r *partialNotificationResource) Delete(
 	} else {
 		// we delete the notification if there are no jobs left
 		notification.State = dbt_cloud.STATE_DELETED
-		_, err = r.client.UpdateNotification(notificationID, *notification)
+		_, err = r.client.UpdateNotification(ctx, notificationID, *notification)
 		if err != nil {
 			resp.Diagnostics.AddError("Error deleting the notification", err.Error())
 			return
@@ -404,7 +407,7 @@ func (r *partialNotificationResource) Update(
 	}
 
 	notificationID := state.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the notification",
@@ -458,6 +461,7 @@ func (r *partialNotificationResource) Update(
 		// we update the values to be the plan ones for global
 		// and the calculated ones for the local ones
 		_, err = r.client.UpdateNotification(
+			ctx,
 			notificationID,
 			dbt_cloud.Notification{
 				AccountId:        r.client.AccountID,
@@ -0,0 +1,75 @@
+package permission_sets
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &permissionSetsDataSource{}
+)
+
+func PermissionSetsDataSource() datasource.DataSource {
+	return &permissionSetsDataSource{}
+}
+
+// permissionSetsDataSource exposes the embedded catalog of permission sets,
+// it doesn't need a client
+type permissionSetsDataSource struct{}
+
+func (d *permissionSetsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_permission_sets"
+}
+
+func (d *permissionSetsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasourceSchema
+}
+
+func (d *permissionSetsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state PermissionSetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Names = []types.String{}
+	state.PermissionSets = []PermissionSetModel{}
+	for _, permissionSet := range dbt_cloud.PermissionSetCatalog {
+		if !matches(state.ProjectScoped, permissionSet.ProjectScoped) ||
+			!matches(state.Enterprise, permissionSet.Enterprise) {
+			continue
+		}
+		state.Names = append(state.Names, types.StringValue(permissionSet.Name))
+		state.PermissionSets = append(state.PermissionSets, PermissionSetModel{
+			Name:                                  types.StringValue(permissionSet.Name),
+			DisplayName:                           types.StringValue(permissionSet.DisplayName),
+			ProjectScoped:                         types.BoolValue(permissionSet.ProjectScoped),
+			SupportsWritableEnvironmentCategories: types.BoolValue(permissionSet.WritableEnvironmentCategories),
+			Enterprise:                            types.BoolValue(permissionSet.Enterprise),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// matches returns whether the value matches the filter, null filters matching
+// all values
+func matches(filter types.Bool, value bool) bool {
+	return filter.IsNull() || filter.ValueBool() == value
+}
@@ -0,0 +1,53 @@
+package permission_sets_test
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestPermissionSetsDataSource reads the catalog of permission sets, with and
+// without filters
+func TestPermissionSetsDataSource(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	config := `
+data "dbtcloud_permission_sets" "all" {
+}
+
+data "dbtcloud_permission_sets" "writable" {
+  project_scoped = true
+  enterprise     = true
+}
+
+data "dbtcloud_permission_sets" "team_account" {
+  project_scoped = false
+  enterprise     = false
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dbtcloud_permission_sets.all", "names.#", "26"),
+					resource.TestCheckResourceAttr("data.dbtcloud_permission_sets.all", "permission_sets.0.name", "owner"),
+					resource.TestCheckResourceAttr("data.dbtcloud_permission_sets.all", "permission_sets.0.display_name", "Owner"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.dbtcloud_permission_sets.all", "permission_sets.*", map[string]string{
+						"name":           "developer",
+						"project_scoped": "true",
+						"supports_writable_environment_categories": "true",
+						"enterprise": "true",
+					}),
+					resource.TestCheckTypeSetElemAttr("data.dbtcloud_permission_sets.writable", "names.*", "developer"),
+					resource.TestCheckResourceAttr("data.dbtcloud_permission_sets.team_account", "names.#", "4"),
+					resource.TestCheckTypeSetElemAttr("data.dbtcloud_permission_sets.team_account", "names.*", "webhooks_only"),
+				),
+			},
+		},
+	})
+}
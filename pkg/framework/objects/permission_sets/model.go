@@ -0,0 +1,20 @@
+package permission_sets
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PermissionSetsDataSourceModel struct {
+	ProjectScoped  types.Bool           `tfsdk:"project_scoped"`
+	Enterprise     types.Bool           `tfsdk:"enterprise"`
+	Names          []types.String       `tfsdk:"names"`
+	PermissionSets []PermissionSetModel `tfsdk:"permission_sets"`
+}
+
+type PermissionSetModel struct {
+	Name                                  types.String `tfsdk:"name"`
+	DisplayName                           types.String `tfsdk:"display_name"`
+	ProjectScoped                         types.Bool   `tfsdk:"project_scoped"`
+	SupportsWritableEnvironmentCategories types.Bool   `tfsdk:"supports_writable_environment_categories"`
+	Enterprise                            types.Bool   `tfsdk:"enterprise"`
+}
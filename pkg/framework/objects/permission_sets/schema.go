@@ -0,0 +1,58 @@
+package permission_sets
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var datasourceSchema = schema.Schema{
+	Description: helper.DocString(
+		`Lists the permission sets that can be granted to groups and service tokens, optionally filtered by their scope or plan.
+
+		This is the catalog the provider uses to validate ~~~permission_set~~~, ~~~project_id~~~ and ~~~writable_environment_categories~~~ at plan time. It doesn't call the dbt Cloud API.`,
+	),
+	Attributes: map[string]schema.Attribute{
+		"project_scoped": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Only return the permission sets that can be set on a project when `true`, or the account level ones when `false`",
+		},
+		"enterprise": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Only return the permission sets requiring an Enterprise plan when `true`, or the other ones when `false`",
+		},
+		"names": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The names of the permission sets, as set in `permission_set`",
+		},
+		"permission_sets": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The permission sets with their details",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the permission set, as set in `permission_set`",
+					},
+					"display_name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the permission set in the dbt Cloud documentation and UI",
+					},
+					"project_scoped": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the permission set can be set on a project with `project_id`. The other permission sets apply to the whole account",
+					},
+					"supports_writable_environment_categories": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether Write access can be restricted to some environment categories with `writable_environment_categories`",
+					},
+					"enterprise": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the permission set is only available on Enterprise plans",
+					},
+				},
+			},
+		},
+	},
+}
@@ -103,7 +103,7 @@ func (r *databricksPlatformMetadataCredentialResource) Create(
 	}
 
 	// Create the credential
-	created, err := r.client.CreatePlatformMetadataCredential(credential)
+	created, err := r.client.CreatePlatformMetadataCredential(ctx, credential)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Databricks platform metadata credential",
@@ -143,7 +143,7 @@ func (r *databricksPlatformMetadataCredentialResource) Read(
 
 	credentialID := state.CredentialID.ValueInt64()
 
-	credential, err := r.client.GetPlatformMetadataCredential(credentialID)
+	credential, err := r.client.GetPlatformMetadataCredential(ctx, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "databricks platform metadata credential") {
 			return
@@ -216,7 +216,7 @@ func (r *databricksPlatformMetadataCredentialResource) Update(
 	}
 
 	// Update the credential
-	updated, err := r.client.UpdatePlatformMetadataCredential(credentialID, credential)
+	updated, err := r.client.UpdatePlatformMetadataCredential(ctx, credentialID, credential)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Databricks platform metadata credential",
@@ -246,7 +246,7 @@ func (r *databricksPlatformMetadataCredentialResource) Delete(
 
 	credentialID := state.CredentialID.ValueInt64()
 
-	err := r.client.DeletePlatformMetadataCredential(credentialID)
+	err := r.client.DeletePlatformMetadataCredential(ctx, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Databricks platform metadata credential",
@@ -1,6 +1,7 @@
 package platform_metadata_credentials_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -172,7 +173,7 @@ func testAccCheckDbtCloudSnowflakePlatformMetadataCredentialDestroy(s *terraform
 			return fmt.Errorf("failed to convert credential_id to int64: %w", err)
 		}
 
-		_, err = apiClient.GetPlatformMetadataCredential(credentialID)
+		_, err = apiClient.GetPlatformMetadataCredential(context.Background(), credentialID)
 		if err == nil {
 			return fmt.Errorf("Snowflake platform metadata credential still exists")
 		}
@@ -507,7 +508,7 @@ func testAccCheckDbtCloudDatabricksPlatformMetadataCredentialDestroy(s *terrafor
 			return fmt.Errorf("failed to convert credential_id to int64: %w", err)
 		}
 
-		_, err = apiClient.GetPlatformMetadataCredential(credentialID)
+		_, err = apiClient.GetPlatformMetadataCredential(context.Background(), credentialID)
 		if err == nil {
 			return fmt.Errorf("Databricks platform metadata credential still exists")
 		}
@@ -110,7 +110,7 @@ func (r *snowflakePlatformMetadataCredentialResource) Create(
 	}
 
 	// Create the credential
-	created, err := r.client.CreatePlatformMetadataCredential(credential)
+	created, err := r.client.CreatePlatformMetadataCredential(ctx, credential)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Snowflake platform metadata credential",
@@ -150,7 +150,7 @@ func (r *snowflakePlatformMetadataCredentialResource) Read(
 
 	credentialID := state.CredentialID.ValueInt64()
 
-	credential, err := r.client.GetPlatformMetadataCredential(credentialID)
+	credential, err := r.client.GetPlatformMetadataCredential(ctx, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "snowflake platform metadata credential") {
 			return
@@ -240,7 +240,7 @@ func (r *snowflakePlatformMetadataCredentialResource) Update(
 	}
 
 	// Update the credential
-	updated, err := r.client.UpdatePlatformMetadataCredential(credentialID, credential)
+	updated, err := r.client.UpdatePlatformMetadataCredential(ctx, credentialID, credential)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Snowflake platform metadata credential",
@@ -270,7 +270,7 @@ func (r *snowflakePlatformMetadataCredentialResource) Delete(
 
 	credentialID := state.CredentialID.ValueInt64()
 
-	err := r.client.DeletePlatformMetadataCredential(credentialID)
+	err := r.client.DeletePlatformMetadataCredential(ctx, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Snowflake platform metadata credential",
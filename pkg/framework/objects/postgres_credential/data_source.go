@@ -54,7 +54,7 @@ func (p *postgresCredentialDataSource) Read(ctx context.Context, req datasource.
 	projectId := int(state.ProjectID.ValueInt64())
 	credentialId := int(state.CredentialID.ValueInt64())
 
-	credential, err := p.client.GetPostgresCredential(projectId, credentialId)
+	credential, err := p.client.GetPostgresCredential(ctx, projectId, credentialId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Postgres credential",
@@ -72,7 +72,7 @@ func (p *postgresCredentialResource) ImportState(ctx context.Context, req resour
 		credentialID,
 	)...)
 
-	credential, err := p.client.GetPostgresCredential(projectID, credentialID)
+	credential, err := p.client.GetPostgresCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Postgres credential",
@@ -165,6 +165,7 @@ func (p *postgresCredentialResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	credential, err := p.client.CreatePostgresCredential(
+		ctx,
 		projectID,
 		isActive,
 		type_value,
@@ -203,7 +204,7 @@ func (p *postgresCredentialResource) Delete(ctx context.Context, req resource.De
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	_, err := p.client.DeletePostgresCredential(strconv.Itoa(credentialID), strconv.Itoa(projectID))
+	_, err := p.client.DeletePostgresCredential(ctx, strconv.Itoa(credentialID), strconv.Itoa(projectID))
 	if err != nil {
 		// If the resource is already deleted (404), treat as success
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "postgres credential") {
@@ -232,7 +233,7 @@ func (p *postgresCredentialResource) Read(ctx context.Context, req resource.Read
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := p.client.GetPostgresCredential(projectID, credentialID)
+	credential, err := p.client.GetPostgresCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "postgres credential") {
 			return
@@ -302,7 +303,7 @@ func (p *postgresCredentialResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	credential, err := p.client.GetPostgresCredential(projectID, credentialID)
+	credential, err := p.client.GetPostgresCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting Postgres credential",
@@ -326,7 +327,7 @@ func (p *postgresCredentialResource) Update(ctx context.Context, req resource.Up
 		credential.State = dbt_cloud.STATE_DELETED
 	}
 
-	_, err = p.client.UpdatePostgresCredential(projectID, credentialID, *credential)
+	_, err = p.client.UpdatePostgresCredential(ctx, projectID, credentialID, *credential)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Postgres credential",
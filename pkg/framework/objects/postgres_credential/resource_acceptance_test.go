@@ -1,6 +1,7 @@
 package postgres_credential_test
 
 import (
+	"context"
 	"bytes"
 	"encoding/json"
 	"fmt"
@@ -122,7 +123,7 @@ func testAccCheckDbtCloudPostgresCredentialExists(resource string) resource.Test
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetPostgresCredential(projectId, credentialId)
+		_, err = apiClient.GetPostgresCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -148,7 +149,7 @@ func testAccCheckDbtCloudPostgresCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetPostgresCredential(projectId, credentialId)
+		_, err = apiClient.GetPostgresCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Postgres credential still exists")
 		}
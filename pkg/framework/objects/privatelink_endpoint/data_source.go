@@ -54,7 +54,7 @@ func (p *privatelinkEndpointDataSource) Read(ctx context.Context, req datasource
 	endpointName := state.Name.ValueString()
 	endpointURL := state.PrivatelinkEndpointURL.ValueString()
 
-	privatelinkEndpoint, err := p.client.GetPrivatelinkEndpoint(endpointName, endpointURL)
+	privatelinkEndpoint, err := p.client.GetPrivatelinkEndpoint(ctx, endpointName, endpointURL)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Privatelink Endpoint",
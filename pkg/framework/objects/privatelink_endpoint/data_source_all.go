@@ -51,7 +51,7 @@ func (p *privatelinkEndpointDataSourceAll) Read(ctx context.Context, req datasou
 		return
 	}
 
-	privatelinkEndpoints, err := p.client.GetAllPrivatelinkEndpoints()
+	privatelinkEndpoints, err := p.client.GetAllPrivatelinkEndpoints(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Privatelink Endpoints",
@@ -1,6 +1,7 @@
 package privatelink_endpoint_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -83,7 +84,7 @@ func TestAccDbtCloudPrivatelinkEndpointPagination(t *testing.T) {
 		AccountID:  1,
 	}
 
-	privatelink_endpoints, err := client.GetAllPrivatelinkEndpoints()
+	privatelink_endpoints, err := client.GetAllPrivatelinkEndpoints(context.Background())
 
 	assert.NoError(t, err)
 	assert.Equal(t, 101, len(privatelink_endpoints))
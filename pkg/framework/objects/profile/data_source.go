@@ -77,7 +77,7 @@ func (d *profileDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	profileID := int(state.ProfileID.ValueInt64())
 
-	profile, err := d.client.GetProfile(projectID, profileID)
+	profile, err := d.client.GetProfile(ctx, projectID, profileID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading profile",
@@ -63,7 +63,7 @@ func (d *profilesDataSource) Read(
 
 	projectID := int(config.ProjectID.ValueInt64())
 
-	profiles, err := d.client.GetAllProfiles(projectID)
+	profiles, err := d.client.GetAllProfiles(ctx, projectID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving profiles",
@@ -132,6 +132,7 @@ func (r *profileResource) Create(
 	}
 
 	profile, err := r.client.CreateProfile(
+		ctx,
 		projectID,
 		key,
 		connectionID,
@@ -188,7 +189,7 @@ func (r *profileResource) Read(
 		return
 	}
 
-	profile, err := r.client.GetProfile(projectID, profileID)
+	profile, err := r.client.GetProfile(ctx, projectID, profileID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "profile") {
 			return
@@ -263,7 +264,7 @@ func (r *profileResource) Update(
 		ExtendedAttributesID: extendedAttributesID,
 	}
 
-	_, err = r.client.UpdateProfile(projectID, profileID, updateProfile)
+	_, err = r.client.UpdateProfile(ctx, projectID, profileID, updateProfile)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating profile",
@@ -300,7 +301,7 @@ func (r *profileResource) Delete(
 		return
 	}
 
-	_, err = r.client.DeleteProfile(projectID, profileID)
+	_, err = r.client.DeleteProfile(ctx, projectID, profileID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting profile",
@@ -1,6 +1,7 @@
 package profile_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -137,7 +138,7 @@ func testAccCheckDbtCloudProfileExists(resourceName string) resource.TestCheckFu
 			return err
 		}
 
-		_, err = apiClient.GetProfile(projectID, profileID)
+		_, err = apiClient.GetProfile(context.Background(), projectID, profileID)
 		if err != nil {
 			return fmt.Errorf(
 				"error fetching item with resource %s. %s",
@@ -168,7 +169,7 @@ func testAccCheckDbtCloudProfileDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetProfile(projectID, profileID)
+		_, err = apiClient.GetProfile(context.Background(), projectID, profileID)
 		if err == nil {
 			return fmt.Errorf("Profile still exists")
 		}
@@ -87,7 +87,7 @@ func (d *projectDataSource) Read(
 		}
 
 		var err error
-		project, err = d.client.GetProject(state.ID.String())
+		project, err = d.client.GetProject(ctx, state.ID.String())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read DBT Cloud Project",
@@ -97,7 +97,7 @@ func (d *projectDataSource) Read(
 		}
 	} else if !state.Name.IsNull() {
 		var err error
-		project, err = d.client.GetProjectByName(state.Name.ValueString())
+		project, err = d.client.GetProjectByName(ctx, state.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read DBT Cloud Project",
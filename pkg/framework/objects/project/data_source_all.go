@@ -41,7 +41,7 @@ func (d *projectsDataSource) Read(
 
 	projectNameContains := config.NameContains.ValueString()
 
-	apiProjects, err := d.client.GetAllProjects(projectNameContains)
+	apiProjects, err := d.client.GetAllProjects(ctx, projectNameContains)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,62 @@
+package project
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResource = &projectListResource{}
+var _ list.ListResourceWithConfigure = &projectListResource{}
+
+// projectListResource reuses the project resource for Metadata, Configure,
+// ImportState and Read so that listed projects are identical to imported ones.
+type projectListResource struct {
+	projectResource
+}
+
+// ProjectListResource creates a new list resource
+func ProjectListResource() list.ListResource {
+	return &projectListResource{}
+}
+
+// ListResourceConfigSchema defines the filters of the list resource.
+func (r *projectListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceSchema
+}
+
+// List streams the projects matching the filters.
+func (r *projectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ProjectListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	projects, err := r.client.GetAllProjects(ctx, config.NameContains.ValueString())
+	if err != nil {
+		diags.AddError("Unable to list the projects", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = helper.ListResults(
+		ctx,
+		req,
+		&r.projectResource,
+		projects,
+		func(project dbt_cloud.ProjectConnectionRepository) helper.ListResultItem {
+			return helper.ListResultItem{
+				DisplayName: project.Name,
+				ImportID:    strconv.FormatInt(project.ID, 10),
+				Identity:    ProjectResourceIdentityModel{ID: types.Int64Value(project.ID)},
+			}
+		},
+	)
+}
@@ -55,3 +55,11 @@ type ProjectResourceModel struct {
 	DbtProjectSubdirectory types.String `tfsdk:"dbt_project_subdirectory"`
 	DbtProjectType         types.Int64  `tfsdk:"type"`
 }
+
+type ProjectResourceIdentityModel struct {
+	ID types.Int64 `tfsdk:"id"`
+}
+
+type ProjectListResourceModel struct {
+	NameContains types.String `tfsdk:"name_contains"`
+}
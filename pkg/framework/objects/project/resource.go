@@ -15,6 +15,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &projectResource{}
 var _ resource.ResourceWithImportState = &projectResource{}
+var _ resource.ResourceWithIdentity = &projectResource{}
 
 // Resource defines the resource implementation.
 type projectResource struct {
@@ -82,7 +83,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Call CreateProject with the correct signature (string, string, string, int)
-	project, err := r.client.CreateProject(name, description, dbtProjectSubdirectory, dbtProjectType)
+	project, err := r.client.CreateProject(ctx, name, description, dbtProjectSubdirectory, dbtProjectType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project",
@@ -107,6 +108,9 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Set the identity
+	identity := ProjectResourceIdentityModel{ID: plan.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -123,7 +127,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	projectID := int(state.ID.ValueInt64())
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := r.client.GetProject(projectIDString)
+	project, err := r.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "project") {
 			return
@@ -152,6 +156,9 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Set the identity
+	identity := ProjectResourceIdentityModel{ID: state.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -176,7 +183,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	projectID := int(state.ID.ValueInt64())
 	projectIDString := strconv.Itoa(projectID)
 
-	updateProject, err := r.client.GetProject(projectIDString)
+	updateProject, err := r.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving dbt Cloud Project",
@@ -214,7 +221,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	updateProject.ConnectionID = nil
 
 	// Perform the update
-	project, err := r.client.UpdateProject(projectIDString, *updateProject)
+	project, err := r.client.UpdateProject(ctx, projectIDString, *updateProject)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating project",
@@ -238,6 +245,9 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Set the identity
+	identity := ProjectResourceIdentityModel{ID: plan.ID}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -255,7 +265,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	projectIDString := strconv.Itoa(projectID)
 
 	// Get the project first
-	project, err := r.client.GetProject(projectIDString)
+	project, err := r.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving dbt Cloud Project for deletion",
@@ -268,7 +278,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	project.State = dbt_cloud.STATE_DELETED
 
 	// Update the project with the deleted state
-	_, err = r.client.UpdateProject(projectIDString, *project)
+	_, err = r.client.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting dbt Cloud Project",
@@ -278,8 +288,24 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *projectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Importing with an identity instead of an ID
+	if req.ID == "" {
+		var identity ProjectResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
 	// Convert the import ID (project ID) to an int64
 	projectID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
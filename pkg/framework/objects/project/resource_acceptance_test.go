@@ -1,6 +1,7 @@
 package project_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -152,7 +153,7 @@ func testAccCheckDbtCloudProjectExists(resource string) resource.TestCheckFunc {
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetProject(rs.Primary.ID)
+		_, err = apiClient.GetProject(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -170,7 +171,7 @@ func testAccCheckDbtCloudProjectDestroy(s *terraform.State) error {
 		if rs.Type != "dbtcloud_project" {
 			continue
 		}
-		_, err := apiClient.GetProject(rs.Primary.ID)
+		_, err := apiClient.GetProject(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Project still exists")
 		}
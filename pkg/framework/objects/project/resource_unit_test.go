@@ -0,0 +1,59 @@
+package project_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestProjectResourceFakeAPI runs the create, update, import and delete cycle
+// of the project resource against the in-memory fake of the dbt Cloud API.
+func TestProjectResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if projects := server.List(fakeapi.Projects); len(projects) != 0 {
+				return fmt.Errorf("expected the project to be deleted, got %v", projects)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testProjectResourceFakeAPIConfig("analytics", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_project.test", "name", "analytics"),
+					resource.TestCheckResourceAttr("dbtcloud_project.test", "description", "The analytics project"),
+					resource.TestCheckResourceAttrSet("dbtcloud_project.test", "id"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testProjectResourceFakeAPIConfig("analytics v2", "dbt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_project.test", "name", "analytics v2"),
+					resource.TestCheckResourceAttr("dbtcloud_project.test", "dbt_project_subdirectory", "dbt"),
+				),
+			},
+			{
+				ResourceName:      "dbtcloud_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testProjectResourceFakeAPIConfig(name, subdirectory string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name                     = %q
+  description              = "The analytics project"
+  dbt_project_subdirectory = %q
+}
+`, name, subdirectory)
+}
@@ -2,6 +2,8 @@ package project
 
 import (
 	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	list_schema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -224,3 +226,22 @@ var resourceSchema = resource_schema.Schema{
 		},
 	},
 }
+
+var resourceIdentitySchema = identityschema.Schema{
+	Attributes: map[string]identityschema.Attribute{
+		"id": identityschema.Int64Attribute{
+			RequiredForImport: true,
+			Description:       "The ID of the project",
+		},
+	},
+}
+
+var listResourceSchema = list_schema.Schema{
+	Description: "Lists the projects of the account.",
+	Attributes: map[string]list_schema.Attribute{
+		"name_contains": list_schema.StringAttribute{
+			Optional:    true,
+			Description: "Only list the projects with a name containing this value",
+		},
+	},
+}
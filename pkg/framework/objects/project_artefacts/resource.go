@@ -55,7 +55,7 @@ func (p *projectArtefactsResource) Create(ctx context.Context, req resource.Crea
 
 	projectIDString := strconv.FormatInt(plan.ProjectID.ValueInt64(), 10)
 
-	project, err := p.client.GetProject(projectIDString)
+	project, err := p.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get project",
@@ -79,7 +79,7 @@ func (p *projectArtefactsResource) Create(ctx context.Context, req resource.Crea
 		project.FreshnessJobId = nil
 	}
 
-	if _, err := p.client.UpdateProject(projectIDString, *project); err != nil {
+	if _, err := p.client.UpdateProject(ctx, projectIDString, *project); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update project",
 			"Error: "+err.Error(),
@@ -104,7 +104,7 @@ func (p *projectArtefactsResource) Delete(ctx context.Context, req resource.Dele
 
 	projectIDString := strconv.FormatInt(state.ProjectID.ValueInt64(), 10)
 
-	project, err := p.client.GetProject(projectIDString)
+	project, err := p.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get project",
@@ -117,7 +117,7 @@ func (p *projectArtefactsResource) Delete(ctx context.Context, req resource.Dele
 	project.FreshnessJobId = nil
 	project.DocsJobId = nil
 
-	_, err = p.client.UpdateProject(projectIDString, *project)
+	_, err = p.client.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update project",
@@ -144,7 +144,7 @@ func (p *projectArtefactsResource) Read(ctx context.Context, req resource.ReadRe
 
 	projectIDString := strconv.FormatInt(state.ProjectID.ValueInt64(), 10)
 
-	project, err := p.client.GetProject(projectIDString)
+	project, err := p.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "project artefacts") {
 			return
@@ -182,7 +182,7 @@ func (p *projectArtefactsResource) Update(ctx context.Context, req resource.Upda
 
 	projectIDString := strconv.FormatInt(plan.ProjectID.ValueInt64(), 10)
 
-	project, err := p.client.GetProject(projectIDString)
+	project, err := p.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get project",
@@ -210,7 +210,7 @@ func (p *projectArtefactsResource) Update(ctx context.Context, req resource.Upda
 		}
 	}
 
-	project, err = p.client.UpdateProject(projectIDString, *project)
+	project, err = p.client.UpdateProject(ctx, projectIDString, *project)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1,6 +1,7 @@
 package project_artefacts_test
 
 import (
+	"context"
 	"fmt"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_config"
 	"regexp"
@@ -121,7 +122,7 @@ func testAccCheckDbtCloudProjectArtefactsExists(resource string) resource.TestCh
 			return fmt.Errorf("Issue getting the client")
 		}
 		projectId := rs.Primary.ID
-		project, err := apiClient.GetProject(projectId)
+		project, err := apiClient.GetProject(context.Background(), projectId)
 		if err != nil {
 			return fmt.Errorf("Can't get project")
 		}
@@ -148,7 +149,7 @@ func testAccCheckDbtCloudProjectArtefactsEmpty(resource string) resource.TestChe
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		project, err := apiClient.GetProject(rs.Primary.ID)
+		project, err := apiClient.GetProject(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Can't get project")
 		}
@@ -173,7 +174,7 @@ func testAccCheckDbtCloudProjectArtefactsDestroy(s *terraform.State) error {
 			continue
 		}
 		projectId := rs.Primary.ID
-		project, err := apiClient.GetProject(projectId)
+		project, err := apiClient.GetProject(context.Background(), projectId)
 		if project != nil {
 			return fmt.Errorf("Project still exists")
 		}
@@ -69,7 +69,7 @@ func (r *projectRepositoryResource) Create(ctx context.Context, req resource.Cre
 	repositoryID := int(plan.RepositoryID.ValueInt64())
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := r.client.GetProject(projectIDString)
+	project, err := r.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving project",
@@ -84,7 +84,7 @@ func (r *projectRepositoryResource) Create(ctx context.Context, req resource.Cre
 
 	project.RepositoryID = &repositoryID
 
-	_, err = r.client.UpdateProject(projectIDString, *project)
+	_, err = r.client.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project repository",
@@ -117,7 +117,7 @@ func (r *projectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 	projectID := int(state.ProjectID.ValueInt64())
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := r.client.GetProject(projectIDString)
+	project, err := r.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "project repository") {
 			return
@@ -173,7 +173,7 @@ func (r *projectRepositoryResource) Delete(ctx context.Context, req resource.Del
 	projectID := int(state.ProjectID.ValueInt64())
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := r.client.GetProject(projectIDString)
+	project, err := r.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving project",
@@ -188,7 +188,7 @@ func (r *projectRepositoryResource) Delete(ctx context.Context, req resource.Del
 
 	project.RepositoryID = nil
 
-	_, err = r.client.UpdateProject(projectIDString, *project)
+	_, err = r.client.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting project repository",
@@ -1,6 +1,7 @@
 package project_repository_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -114,7 +115,7 @@ func testAccCheckDbtCloudProjectRepositoryExists(resource string) resource.TestC
 		if err != nil {
 			return err
 		}
-		project, err := apiClient.GetProject(projectId)
+		project, err := apiClient.GetProject(context.Background(), projectId)
 		if err != nil {
 			return fmt.Errorf("Can't get project")
 		}
@@ -138,7 +139,7 @@ func testAccCheckDbtCloudProjectRepositoryEmpty(resource string) resource.TestCh
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		project, err := apiClient.GetProject(rs.Primary.ID)
+		project, err := apiClient.GetProject(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Can't get project")
 		}
@@ -166,7 +167,7 @@ func testAccCheckDbtCloudProjectRepositoryDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		project, err := apiClient.GetProject(projectId)
+		project, err := apiClient.GetProject(context.Background(), projectId)
 		if project != nil {
 			return fmt.Errorf("Project still exists")
 		}
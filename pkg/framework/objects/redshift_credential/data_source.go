@@ -84,7 +84,7 @@ func (d *redshiftCredentialDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetRedshiftCredential(projectID, credentialID)
+	credential, err := d.client.GetRedshiftCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Redshift credential",
@@ -104,6 +104,7 @@ func (r *redshiftCredentialResource) Create(
 
 	// Create new credential
 	credential, err := r.client.CreateRedshiftCredential(
+		ctx,
 		projectID,
 		"redshift",
 		isActive,
@@ -151,7 +152,7 @@ func (r *redshiftCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetRedshiftCredential(projectID, credentialID)
+	credential, err := r.client.GetRedshiftCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "redshift credential") {
 			return
@@ -219,7 +220,7 @@ func (r *redshiftCredentialResource) Update(
 		(state.NumThreads.ValueInt64() != int64(numThreads)) ||
 		(state.Password != plan.Password) ||
 		(state.PasswordWoVersion != plan.PasswordWoVersion) {
-		credential, err := r.client.GetRedshiftCredential(projectID, credentialID)
+		credential, err := r.client.GetRedshiftCredential(ctx, projectID, credentialID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading Redshift credential",
@@ -239,6 +240,7 @@ func (r *redshiftCredentialResource) Update(
 		credential.Password = password
 
 		_, err = r.client.UpdateRedshiftCredential(
+			ctx,
 			projectID,
 			credentialID,
 			*credential,
@@ -278,6 +280,7 @@ func (r *redshiftCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
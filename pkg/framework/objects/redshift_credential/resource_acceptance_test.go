@@ -1,6 +1,7 @@
 package redshift_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -94,7 +95,7 @@ func testAccCheckDbtCloudRedshiftCredentialExists(resource string) resource.Test
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetRedshiftCredential(projectId, credentialId)
+		_, err = apiClient.GetRedshiftCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -212,7 +213,7 @@ func testAccCheckDbtCloudRedshiftCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetRedshiftCredential(projectId, credentialId)
+		_, err = apiClient.GetRedshiftCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Redshift credential still exists")
 		}
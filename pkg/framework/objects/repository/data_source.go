@@ -55,6 +55,7 @@ func (d *repositoryDataSource) Read(
 	projectID := int(data.ProjectID.ValueInt64())
 
 	repository, err := d.client.GetRepository(
+		ctx,
 		strconv.Itoa(repositoryID),
 		strconv.Itoa(projectID),
 	)
@@ -91,6 +91,7 @@ func (r *repositoryResource) Create(
 	}
 
 	repository, err := r.client.CreateRepository(
+		ctx,
 		projectID,
 		remoteURL,
 		isActive,
@@ -115,7 +116,7 @@ func (r *repositoryResource) Create(
 	if repository.RepositoryCredentialsID == nil && gitlabProjectID != 0 {
 		repositoryIDString := fmt.Sprintf("%d", *repository.ID)
 		projectIDString := fmt.Sprintf("%d", repository.ProjectID)
-		_, err := r.client.DeleteRepository(repositoryIDString, projectIDString)
+		_, err := r.client.DeleteRepository(ctx, repositoryIDString, projectIDString)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error deleting invalid repository",
@@ -236,7 +237,7 @@ func (r *repositoryResource) Read(
 	projectID := parts[0]
 	repositoryID := parts[1]
 
-	repository, err := r.client.GetRepository(repositoryID, projectID)
+	repository, err := r.client.GetRepository(ctx, repositoryID, projectID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "repository") {
 			return
@@ -343,7 +344,7 @@ func (r *repositoryResource) Update(
 	projectID := parts[0]
 	repositoryID := parts[1]
 
-	repository, err := r.client.GetRepository(repositoryID, projectID)
+	repository, err := r.client.GetRepository(ctx, repositoryID, projectID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading repository",
@@ -364,7 +365,7 @@ func (r *repositoryResource) Update(
 		repository.PullRequestURLTemplate = plan.PullRequestURLTemplate.ValueString()
 	}
 
-	updatedRepository, err := r.client.UpdateRepository(repositoryID, projectID, *repository)
+	updatedRepository, err := r.client.UpdateRepository(ctx, repositoryID, projectID, *repository)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating repository",
@@ -460,7 +461,7 @@ func (r *repositoryResource) Delete(
 	projectID := parts[0]
 	repositoryID := parts[1]
 
-	_, err := r.client.DeleteRepository(repositoryID, projectID)
+	_, err := r.client.DeleteRepository(ctx, repositoryID, projectID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting repository",
@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -164,7 +165,7 @@ func testAccCheckDbtCloudRepositoryExists(resource string) resource.TestCheckFun
 		}
 		projectId := parts[0]
 		repositoryId := parts[1]
-		_, err = apiClient.GetRepository(repositoryId, projectId)
+		_, err = apiClient.GetRepository(context.Background(), repositoryId, projectId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -188,7 +189,7 @@ func testAccCheckDbtCloudRepositoryDestroy(s *terraform.State) error {
 		}
 		projectId := parts[0]
 		repositoryId := parts[1]
-		_, err = apiClient.GetRepository(repositoryId, projectId)
+		_, err = apiClient.GetRepository(context.Background(), repositoryId, projectId)
 		if err == nil {
 			return fmt.Errorf("Repository still exists")
 		}
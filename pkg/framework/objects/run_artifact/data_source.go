@@ -0,0 +1,112 @@
+package run_artifact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &runArtifactDataSource{}
+	_ datasource.DataSourceWithConfigure = &runArtifactDataSource{}
+)
+
+func RunArtifactDataSource() datasource.DataSource {
+	return &runArtifactDataSource{}
+}
+
+type runArtifactDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *runArtifactDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_run_artifact"
+}
+
+func (d *runArtifactDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasourceSchema
+}
+
+func (d *runArtifactDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state RunArtifactDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactPath := state.Path.ValueString()
+
+	var content []byte
+	var err error
+	if !state.RunID.IsNull() {
+		runID := state.RunID.ValueInt64()
+		content, err = d.client.GetRunArtifact(ctx, runID, artifactPath, int(state.Step.ValueInt64()))
+		state.ID = types.StringValue(fmt.Sprintf("run:%d:%s", runID, artifactPath))
+	} else {
+		jobID := state.JobID.ValueInt64()
+		content, err = d.client.GetJobLatestArtifact(ctx, jobID, artifactPath)
+		state.ID = types.StringValue(fmt.Sprintf("job:%d:%s", jobID, artifactPath))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving the run artifact",
+			fmt.Sprintf("The artifact %s could not be downloaded: %s", artifactPath, err.Error()),
+		)
+		return
+	}
+
+	if state.JSONPath.IsNull() {
+		state.Content = types.StringValue(string(content))
+		state.Result = types.StringNull()
+	} else {
+		value, err := helper.ExtractJSONPath(content, state.JSONPath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("json_path"),
+				"Unable to extract the value from the artifact",
+				err.Error(),
+			)
+			return
+		}
+		result, err := json.Marshal(value)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to encode the extracted value", err.Error())
+			return
+		}
+		state.Content = types.StringNull()
+		state.Result = types.StringValue(string(result))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *runArtifactDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,48 @@
+package run_artifact_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudRunArtifactDataSourceValidation(t *testing.T) {
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "dbtcloud_run_artifact" "test" {
+  run_id = 1
+  job_id = 1
+  path   = "manifest.json"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+data "dbtcloud_run_artifact" "test" {
+  job_id = 1
+  step   = 2
+  path   = "manifest.json"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+data "dbtcloud_run_artifact" "test" {
+  run_id = 1
+  path   = "semantic_manifest.json"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
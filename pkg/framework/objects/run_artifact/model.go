@@ -0,0 +1,16 @@
+package run_artifact
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RunArtifactDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	RunID    types.Int64  `tfsdk:"run_id"`
+	JobID    types.Int64  `tfsdk:"job_id"`
+	Path     types.String `tfsdk:"path"`
+	Step     types.Int64  `tfsdk:"step"`
+	JSONPath types.String `tfsdk:"json_path"`
+	Content  types.String `tfsdk:"content"`
+	Result   types.String `tfsdk:"result"`
+}
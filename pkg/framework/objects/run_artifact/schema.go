@@ -0,0 +1,73 @@
+package run_artifact
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var supportedArtifacts = []string{
+	"manifest.json",
+	"run_results.json",
+	"catalog.json",
+	"sources.json",
+}
+
+var datasourceSchema = schema.Schema{
+	Description: helper.DocString(
+		`Downloads an artifact generated by a dbt Cloud run, either for a given run or for the latest successful run of a job.
+
+		Artifacts like ~~~manifest.json~~~ can be large, use ~~~json_path~~~ to only keep the part of the artifact that is needed in the state.`,
+	),
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the data source, built from the run or job ID and the artifact path",
+		},
+		"run_id": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The ID of the run to download the artifact from - Exactly one of `run_id` or `job_id` is required",
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("job_id")),
+			},
+		},
+		"job_id": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The ID of the job to download the artifact of its latest successful run from - Exactly one of `run_id` or `job_id` is required",
+		},
+		"path": schema.StringAttribute{
+			Required:    true,
+			Description: "The artifact to download, one of `manifest.json`, `run_results.json`, `catalog.json` or `sources.json`",
+			Validators: []validator.String{
+				stringvalidator.OneOf(supportedArtifacts...),
+			},
+		},
+		"step": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The index of the step of the run to download the artifact from, starting at 1 - Defaults to the last step of the run. Only supported with `run_id`",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+				int64validator.ConflictsWith(path.MatchRoot("job_id")),
+			},
+		},
+		"json_path": schema.StringAttribute{
+			Optional: true,
+			Description: helper.DocString(
+				`Path of the value to extract from the artifact, e.g. ~~~$.metadata.dbt_version~~~, ~~~$.nodes["model.my_project.my_model"].config~~~ or ~~~$.results[*].status~~~.
+				Keys containing dots need to use the bracket notation, ~~~*~~~ selects all the elements of an array or all the values of an object.
+				When set, ~~~content~~~ is not populated`,
+			),
+		},
+		"content": schema.StringAttribute{
+			Computed:    true,
+			Description: "The raw content of the artifact, only populated when `json_path` is not set",
+		},
+		"result": schema.StringAttribute{
+			Computed:    true,
+			Description: "The JSON encoded value extracted with `json_path`, to be decoded with `jsondecode()`",
+		},
+	},
+}
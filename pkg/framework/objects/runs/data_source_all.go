@@ -5,7 +5,6 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
@@ -47,9 +46,13 @@ func (d *runsDataSource) Read(
 		filter.PullRequestID = int(state.Filter.PullRequestID.ValueInt64())
 		filter.TriggerID = int(state.Filter.TriggerID.ValueInt64())
 		filter.Limit = int(state.Filter.Limit.ValueInt64())
+		filter.StatusIn = state.Filter.StatusIn.ValueString()
+		filter.OrderBy = state.Filter.OrderBy.ValueString()
 	}
+	filter.IncludeTrigger = true
+	filter.IncludeRunSteps = state.IncludeRunSteps.ValueBool()
 
-	runs, err := d.client.GetRuns(&filter)
+	runs, err := d.client.GetRuns(ctx, &filter)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -60,15 +63,7 @@ func (d *runsDataSource) Read(
 	}
 
 	for _, run := range *runs {
-		state.Runs = append(state.Runs, RunDataSourceModel{
-			ID:                  types.Int64Value(run.ID),
-			AccountID:           types.Int64Value(run.AccountID),
-			GitSHA:              types.StringValue(run.GitSHA),
-			GitBranch:           types.StringValue(run.GitBranch),
-			GitHubPullRequestID: types.StringValue(run.GitHubPullRequestID),
-			SchemaOverride:      types.StringValue(run.SchemaOverride),
-			Cause:               types.StringValue(run.Cause),
-		})
+		state.Runs = append(state.Runs, ConvertRunDataToModel(run, d.client.HostURL.String()))
 	}
 
 	diags := resp.State.Set(ctx, &state)
@@ -19,6 +19,11 @@ func TestAccDbtCloudUsersDataSource(t *testing.T) {
 
 	check := resource.ComposeAggregateTestCheckFunc(
 		resource.TestCheckResourceAttrSet("data.dbtcloud_runs.all", "runs.0.id"),
+		resource.TestCheckResourceAttrSet("data.dbtcloud_runs.all", "runs.0.status"),
+		resource.TestCheckResourceAttrSet("data.dbtcloud_runs.all", "runs.0.created_at"),
+		resource.TestCheckResourceAttrSet("data.dbtcloud_runs.all", "runs.0.trigger.cause"),
+		resource.TestCheckResourceAttr("data.dbtcloud_runs.all", "runs.#", "1"),
+		resource.TestCheckResourceAttrSet("data.dbtcloud_runs.all", "runs.0.run_steps.#"),
 	)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -38,7 +43,10 @@ func runs(envId int) string {
 data "dbtcloud_runs" "all" {
   filter = {
     	environment_id = %d
+    	limit          = 1
+    	order_by       = "-id"
 	}
+  include_run_steps = true
 }
 `, envId)
 }
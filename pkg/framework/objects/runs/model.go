@@ -1,18 +1,75 @@
 package runs
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type RunDataSourceModel struct {
+	ID                    types.Int64      `tfsdk:"id"`
+	AccountID             types.Int64      `tfsdk:"account_id"`
+	JobID                 types.Int64      `tfsdk:"job_id"`
+	EnvironmentID         types.Int64      `tfsdk:"environment_id"`
+	ProjectID             types.Int64      `tfsdk:"project_id"`
+	TriggerID             types.Int64      `tfsdk:"trigger_id"`
+	GitSHA                types.String     `tfsdk:"git_sha"`
+	GitBranch             types.String     `tfsdk:"git_branch"`
+	GitHubPullRequestID   types.String     `tfsdk:"github_pull_request_id"`
+	SchemaOverride        types.String     `tfsdk:"schema_override"`
+	Cause                 types.String     `tfsdk:"cause"`
+	DbtVersion            types.String     `tfsdk:"dbt_version"`
+	Status                types.Int64      `tfsdk:"status"`
+	StatusHumanized       types.String     `tfsdk:"status_humanized"`
+	StatusMessage         types.String     `tfsdk:"status_message"`
+	InProgress            types.Bool       `tfsdk:"in_progress"`
+	IsComplete            types.Bool       `tfsdk:"is_complete"`
+	IsSuccess             types.Bool       `tfsdk:"is_success"`
+	IsError               types.Bool       `tfsdk:"is_error"`
+	IsCancelled           types.Bool       `tfsdk:"is_cancelled"`
+	CreatedAt             types.String     `tfsdk:"created_at"`
+	UpdatedAt             types.String     `tfsdk:"updated_at"`
+	DequeuedAt            types.String     `tfsdk:"dequeued_at"`
+	StartedAt             types.String     `tfsdk:"started_at"`
+	FinishedAt            types.String     `tfsdk:"finished_at"`
+	DurationSeconds       types.Int64      `tfsdk:"duration_seconds"`
+	QueuedDurationSeconds types.Int64      `tfsdk:"queued_duration_seconds"`
+	RunDurationSeconds    types.Int64      `tfsdk:"run_duration_seconds"`
+	DurationHumanized     types.String     `tfsdk:"duration_humanized"`
+	ArtifactsSaved        types.Bool       `tfsdk:"artifacts_saved"`
+	ArtifactsURL          types.String     `tfsdk:"artifacts_url"`
+	HasDocsGenerated      types.Bool       `tfsdk:"has_docs_generated"`
+	HasSourcesGenerated   types.Bool       `tfsdk:"has_sources_generated"`
+	Href                  types.String     `tfsdk:"href"`
+	Trigger               *RunTriggerModel `tfsdk:"trigger"`
+	RunSteps              []RunStepModel   `tfsdk:"run_steps"`
+}
+
+type RunTriggerModel struct {
 	ID                  types.Int64  `tfsdk:"id"`
-	AccountID           types.Int64  `tfsdk:"account_id"`
-	JobID               types.Int64  `tfsdk:"job_id"`
-	GitSHA              types.String `tfsdk:"git_sha"`
+	Cause               types.String `tfsdk:"cause"`
+	CauseCategory       types.String `tfsdk:"cause_category"`
 	GitBranch           types.String `tfsdk:"git_branch"`
-	GitHubPullRequestID types.String `tfsdk:"github_pull_request_id"`
+	GitSHA              types.String `tfsdk:"git_sha"`
+	GitHubPullRequestID types.Int64  `tfsdk:"github_pull_request_id"`
 	SchemaOverride      types.String `tfsdk:"schema_override"`
-	Cause               types.String `tfsdk:"cause"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+}
+
+type RunStepModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Index             types.Int64  `tfsdk:"index"`
+	Name              types.String `tfsdk:"name"`
+	Status            types.Int64  `tfsdk:"status"`
+	StatusHumanized   types.String `tfsdk:"status_humanized"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	FinishedAt        types.String `tfsdk:"finished_at"`
+	DurationSeconds   types.Int64  `tfsdk:"duration_seconds"`
+	DurationHumanized types.String `tfsdk:"duration_humanized"`
 }
 
 type RunFilterModel struct {
@@ -24,9 +81,141 @@ type RunFilterModel struct {
 	PullRequestID   types.Int64  `tfsdk:"pull_request_id"`
 	Status          types.Int64  `tfsdk:"status"`
 	StatusIn        types.String `tfsdk:"status_in"`
+	OrderBy         types.String `tfsdk:"order_by"`
 }
 
 type RunsDataSourceModel struct {
-	Filter RunFilterModel       `tfsdk:"filter"`
-	Runs   []RunDataSourceModel `tfsdk:"runs"`
+	Filter          RunFilterModel       `tfsdk:"filter"`
+	IncludeRunSteps types.Bool           `tfsdk:"include_run_steps"`
+	Runs            []RunDataSourceModel `tfsdk:"runs"`
+}
+
+// timestamp layouts returned by the API, the first one is the most common
+var runTimestampLayouts = []string{
+	"2006-01-02 15:04:05.999999-07:00",
+	"2006-01-02 15:04:05.999999Z07:00",
+	time.RFC3339Nano,
+}
+
+// runDurationRegex matches durations returned by the API, which are formatted
+// like Python timedeltas: "00:01:23" or "1 day, 02:03:04"
+var runDurationRegex = regexp.MustCompile(`^(?:(\d+) days?, )?(\d+):(\d{2}):(\d{2})(?:\.\d+)?$`)
+
+// ConvertRunTimestamp converts a timestamp from the API to RFC 3339 so that it
+// can be used with the Terraform time functions. Timestamps in an unexpected
+// format are returned as is.
+func ConvertRunTimestamp(timestamp *string) types.String {
+	if timestamp == nil || *timestamp == "" {
+		return types.StringNull()
+	}
+	for _, layout := range runTimestampLayouts {
+		if parsed, err := time.Parse(layout, *timestamp); err == nil {
+			return types.StringValue(parsed.UTC().Format(time.RFC3339))
+		}
+	}
+	return types.StringValue(*timestamp)
+}
+
+// ConvertRunDuration converts a duration from the API to a number of seconds.
+func ConvertRunDuration(duration *string) types.Int64 {
+	if duration == nil {
+		return types.Int64Null()
+	}
+	matches := runDurationRegex.FindStringSubmatch(*duration)
+	if matches == nil {
+		return types.Int64Null()
+	}
+
+	var seconds int64
+	if matches[1] != "" {
+		days, _ := strconv.ParseInt(matches[1], 10, 64)
+		seconds += days * 24 * 3600
+	}
+	hours, _ := strconv.ParseInt(matches[2], 10, 64)
+	minutes, _ := strconv.ParseInt(matches[3], 10, 64)
+	secs, _ := strconv.ParseInt(matches[4], 10, 64)
+	return types.Int64Value(seconds + hours*3600 + minutes*60 + secs)
+}
+
+func ConvertRunDataToModel(run dbt_cloud.Run, hostURL string) RunDataSourceModel {
+	jobID := int64(run.JobID)
+	if jobID == 0 {
+		jobID = run.JobDefinitionID
+	}
+
+	artifactsURL := types.StringNull()
+	if run.ArtifactsSaved {
+		artifactsURL = types.StringValue(
+			fmt.Sprintf("%s/v2/accounts/%d/runs/%d/artifacts/", hostURL, run.AccountID, run.ID),
+		)
+	}
+
+	model := RunDataSourceModel{
+		ID:                    types.Int64Value(run.ID),
+		AccountID:             types.Int64Value(run.AccountID),
+		JobID:                 types.Int64Value(jobID),
+		EnvironmentID:         types.Int64Value(run.EnvironmentID),
+		ProjectID:             types.Int64Value(run.ProjectID),
+		TriggerID:             types.Int64Value(run.TriggerID),
+		GitSHA:                types.StringValue(run.GitSHA),
+		GitBranch:             types.StringValue(run.GitBranch),
+		GitHubPullRequestID:   types.StringValue(run.GitHubPullRequestID),
+		SchemaOverride:        types.StringValue(run.SchemaOverride),
+		Cause:                 types.StringValue(run.Cause),
+		DbtVersion:            types.StringValue(run.DbtVersion),
+		Status:                types.Int64Value(int64(run.Status)),
+		StatusHumanized:       types.StringValue(run.StatusHumanized),
+		StatusMessage:         types.StringPointerValue(run.StatusMessage),
+		InProgress:            types.BoolValue(run.InProgress),
+		IsComplete:            types.BoolValue(run.IsComplete),
+		IsSuccess:             types.BoolValue(run.IsSuccess),
+		IsError:               types.BoolValue(run.IsError),
+		IsCancelled:           types.BoolValue(run.IsCancelled),
+		CreatedAt:             ConvertRunTimestamp(run.CreatedAt),
+		UpdatedAt:             ConvertRunTimestamp(run.UpdatedAt),
+		DequeuedAt:            ConvertRunTimestamp(run.DequeuedAt),
+		StartedAt:             ConvertRunTimestamp(run.StartedAt),
+		FinishedAt:            ConvertRunTimestamp(run.FinishedAt),
+		DurationSeconds:       ConvertRunDuration(run.Duration),
+		QueuedDurationSeconds: ConvertRunDuration(run.QueuedDuration),
+		RunDurationSeconds:    ConvertRunDuration(run.RunDuration),
+		DurationHumanized:     types.StringValue(run.DurationHumanized),
+		ArtifactsSaved:        types.BoolValue(run.ArtifactsSaved),
+		ArtifactsURL:          artifactsURL,
+		HasDocsGenerated:      types.BoolValue(run.HasDocsGenerated),
+		HasSourcesGenerated:   types.BoolValue(run.HasSourcesGenerated),
+		Href:                  types.StringValue(run.Href),
+	}
+
+	if run.Trigger != nil {
+		model.Trigger = &RunTriggerModel{
+			ID:                  types.Int64Value(run.Trigger.ID),
+			Cause:               types.StringValue(run.Trigger.Cause),
+			CauseCategory:       types.StringPointerValue(run.Trigger.CauseCategory),
+			GitBranch:           types.StringPointerValue(run.Trigger.GitBranch),
+			GitSHA:              types.StringPointerValue(run.Trigger.GitSHA),
+			GitHubPullRequestID: types.Int64PointerValue(run.Trigger.GitHubPullRequestID),
+			SchemaOverride:      types.StringPointerValue(run.Trigger.SchemaOverride),
+			CreatedAt:           ConvertRunTimestamp(run.Trigger.CreatedAt),
+		}
+	}
+
+	if run.RunSteps != nil {
+		model.RunSteps = []RunStepModel{}
+		for _, step := range run.RunSteps {
+			model.RunSteps = append(model.RunSteps, RunStepModel{
+				ID:                types.Int64Value(step.ID),
+				Index:             types.Int64Value(step.Index),
+				Name:              types.StringValue(step.Name),
+				Status:            types.Int64Value(int64(step.Status)),
+				StatusHumanized:   types.StringValue(step.StatusHumanized),
+				CreatedAt:         ConvertRunTimestamp(step.CreatedAt),
+				FinishedAt:        ConvertRunTimestamp(step.FinishedAt),
+				DurationSeconds:   ConvertRunDuration(step.Duration),
+				DurationHumanized: types.StringValue(step.DurationHumanized),
+			})
+		}
+	}
+
+	return model
 }
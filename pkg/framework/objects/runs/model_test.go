@@ -0,0 +1,102 @@
+package runs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertRunTimestamp(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *string
+		expected types.String
+	}{
+		{"null", nil, types.StringNull()},
+		{"empty", strPtr(""), types.StringNull()},
+		{"api format", strPtr("2026-10-17 08:15:42.123456+00:00"), types.StringValue("2026-10-17T08:15:42Z")},
+		{"api format with offset", strPtr("2026-10-17 10:15:42.123456+02:00"), types.StringValue("2026-10-17T08:15:42Z")},
+		{"rfc3339", strPtr("2026-10-17T08:15:42Z"), types.StringValue("2026-10-17T08:15:42Z")},
+		{"unknown format", strPtr("yesterday"), types.StringValue("yesterday")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ConvertRunTimestamp(tt.input))
+		})
+	}
+}
+
+func TestConvertRunDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *string
+		expected types.Int64
+	}{
+		{"null", nil, types.Int64Null()},
+		{"seconds", strPtr("00:00:42"), types.Int64Value(42)},
+		{"hours", strPtr("01:02:03"), types.Int64Value(3723)},
+		{"microseconds", strPtr("00:01:00.5"), types.Int64Value(60)},
+		{"one day", strPtr("1 day, 00:00:10"), types.Int64Value(86410)},
+		{"days", strPtr("2 days, 01:00:00"), types.Int64Value(176400)},
+		{"unknown format", strPtr("a while"), types.Int64Null()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ConvertRunDuration(tt.input))
+		})
+	}
+}
+
+func TestConvertRunDataToModel(t *testing.T) {
+	body := `{
+		"id": 42,
+		"account_id": 1,
+		"job_definition_id": 7,
+		"environment_id": 3,
+		"project_id": 2,
+		"trigger_id": 99,
+		"status": 10,
+		"status_humanized": "Success",
+		"status_message": null,
+		"is_complete": true,
+		"is_success": true,
+		"finished_at": "2026-10-17 08:15:42.123456+00:00",
+		"duration": "00:05:00",
+		"run_duration": "00:04:30",
+		"queued_duration": "00:00:30",
+		"artifacts_saved": true,
+		"href": "https://cloud.getdbt.com/deploy/1/projects/2/runs/42/",
+		"trigger": {"id": 99, "cause": "Scheduled", "cause_category": "scheduled", "git_branch": null},
+		"run_steps": [
+			{"id": 1, "index": 1, "name": "Clone git repository", "status": 10, "status_humanized": "Success", "duration": "00:00:02"}
+		]
+	}`
+	var run dbt_cloud.Run
+	assert.NoError(t, json.Unmarshal([]byte(body), &run))
+
+	model := ConvertRunDataToModel(run, "https://cloud.getdbt.com/api")
+
+	assert.Equal(t, types.Int64Value(7), model.JobID)
+	assert.Equal(t, types.BoolValue(true), model.IsSuccess)
+	assert.Equal(t, types.StringNull(), model.StatusMessage)
+	assert.Equal(t, types.StringValue("2026-10-17T08:15:42Z"), model.FinishedAt)
+	assert.Equal(t, types.StringNull(), model.StartedAt)
+	assert.Equal(t, types.Int64Value(300), model.DurationSeconds)
+	assert.Equal(t, types.Int64Value(270), model.RunDurationSeconds)
+	assert.Equal(t, types.Int64Value(30), model.QueuedDurationSeconds)
+	assert.Equal(t, types.StringValue("https://cloud.getdbt.com/api/v2/accounts/1/runs/42/artifacts/"), model.ArtifactsURL)
+	if assert.NotNil(t, model.Trigger) {
+		assert.Equal(t, types.StringValue("scheduled"), model.Trigger.CauseCategory)
+		assert.Equal(t, types.StringNull(), model.Trigger.GitBranch)
+	}
+	if assert.Len(t, model.RunSteps, 1) {
+		assert.Equal(t, types.Int64Value(2), model.RunSteps[0].DurationSeconds)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
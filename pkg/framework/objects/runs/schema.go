@@ -42,13 +42,21 @@ var allDatasourceSchema = all_schema.Schema{
 				},
 				"status_in": all_schema.StringAttribute{
 					Optional:    true,
-					Description: "The status of the run",
+					Description: "List of statuses of the runs, e.g. `[10,20]` for the runs that succeeded or errored",
+				},
+				"order_by": all_schema.StringAttribute{
+					Optional:    true,
+					Description: "Field to order the runs by, prefixed with `-` for a descending order, e.g. `-finished_at` to get the most recent runs first. Combined with `limit`, it allows retrieving the last runs of a job",
 				},
 			},
 		},
-		"runs": all_schema.SetNestedAttribute{
+		"include_run_steps": all_schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether to retrieve the steps of each run in `run_steps` - Defaults to `false`",
+		},
+		"runs": all_schema.ListNestedAttribute{
 			Computed:    true,
-			Description: "Set of users with their internal ID end email",
+			Description: "List of runs with their details, in the order of `order_by` when it is set",
 			NestedObject: all_schema.NestedAttributeObject{
 				Attributes: map[string]all_schema.Attribute{
 					"id": datasource_schema.Int64Attribute{
@@ -60,31 +68,215 @@ var allDatasourceSchema = all_schema.Schema{
 						Description: "The ID of the account",
 					},
 					"job_id": datasource_schema.Int64Attribute{
-						Required:    true,
+						Computed:    true,
 						Description: "The ID of the job",
 					},
+					"environment_id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the environment the run was executed in",
+					},
+					"project_id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the project",
+					},
+					"trigger_id": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the trigger of the run",
+					},
 					"git_sha": datasource_schema.StringAttribute{
-						Required:    true,
+						Computed:    true,
 						Description: "The SHA of the commit",
 					},
 					"git_branch": datasource_schema.StringAttribute{
-						Required:    true,
+						Computed:    true,
 						Description: "The branch of the commit",
 					},
 					"github_pull_request_id": datasource_schema.StringAttribute{
-						Required:    true,
+						Computed:    true,
 						Description: "The ID of the pull request",
 					},
 					"schema_override": datasource_schema.StringAttribute{
-						Optional:    true,
 						Computed:    true,
 						Description: "The schema override",
 					},
 					"cause": datasource_schema.StringAttribute{
-						Optional:    true,
 						Computed:    true,
 						Description: "The cause of the run",
 					},
+					"dbt_version": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The version of dbt used for the run",
+					},
+					"status": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "The status of the run: 1 (queued), 2 (starting), 3 (running), 10 (success), 20 (error) or 30 (cancelled)",
+					},
+					"status_humanized": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The status of the run, in a human readable format",
+					},
+					"status_message": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "The message explaining the status of the run, usually set when the run errored",
+					},
+					"in_progress": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the run is queued, starting or running",
+					},
+					"is_complete": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the run is finished, whatever its outcome",
+					},
+					"is_success": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the run succeeded",
+					},
+					"is_error": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the run errored",
+					},
+					"is_cancelled": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the run got cancelled",
+					},
+					"created_at": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "When the run was created, in RFC 3339 format",
+					},
+					"updated_at": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "When the run was last updated, in RFC 3339 format",
+					},
+					"dequeued_at": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "When the run left the queue, in RFC 3339 format",
+					},
+					"started_at": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "When the run started, in RFC 3339 format",
+					},
+					"finished_at": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "When the run finished, in RFC 3339 format. Can be used with `timecmp()` and `timeadd()` to check the freshness of a job",
+					},
+					"duration_seconds": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "Total duration of the run, including the time spent in the queue, in seconds",
+					},
+					"queued_duration_seconds": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "Time spent by the run in the queue, in seconds",
+					},
+					"run_duration_seconds": datasource_schema.Int64Attribute{
+						Computed:    true,
+						Description: "Time spent executing the run, in seconds",
+					},
+					"duration_humanized": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "Total duration of the run, in a human readable format",
+					},
+					"artifacts_saved": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the artifacts of the run were saved",
+					},
+					"artifacts_url": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "API URL listing the artifacts of the run, null when the artifacts were not saved",
+					},
+					"has_docs_generated": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the run generated the docs",
+					},
+					"has_sources_generated": datasource_schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the run generated the sources freshness",
+					},
+					"href": datasource_schema.StringAttribute{
+						Computed:    true,
+						Description: "URL of the run in dbt Cloud",
+					},
+					"trigger": datasource_schema.SingleNestedAttribute{
+						Computed:    true,
+						Description: "What triggered the run",
+						Attributes: map[string]datasource_schema.Attribute{
+							"id": datasource_schema.Int64Attribute{
+								Computed:    true,
+								Description: "The ID of the trigger",
+							},
+							"cause": datasource_schema.StringAttribute{
+								Computed:    true,
+								Description: "The cause of the run",
+							},
+							"cause_category": datasource_schema.StringAttribute{
+								Computed:    true,
+								Description: "The category of the cause, e.g. `scheduled`, `api` or `github_pull_request`",
+							},
+							"git_branch": datasource_schema.StringAttribute{
+								Computed:    true,
+								Description: "The branch requested for the run",
+							},
+							"git_sha": datasource_schema.StringAttribute{
+								Computed:    true,
+								Description: "The commit SHA requested for the run",
+							},
+							"github_pull_request_id": datasource_schema.Int64Attribute{
+								Computed:    true,
+								Description: "The ID of the pull request that triggered the run",
+							},
+							"schema_override": datasource_schema.StringAttribute{
+								Computed:    true,
+								Description: "The schema override requested for the run",
+							},
+							"created_at": datasource_schema.StringAttribute{
+								Computed:    true,
+								Description: "When the run was triggered, in RFC 3339 format",
+							},
+						},
+					},
+					"run_steps": datasource_schema.ListNestedAttribute{
+						Computed:    true,
+						Description: "The steps of the run, in order of execution. Only retrieved when `include_run_steps` is set to `true`",
+						NestedObject: datasource_schema.NestedAttributeObject{
+							Attributes: map[string]datasource_schema.Attribute{
+								"id": datasource_schema.Int64Attribute{
+									Computed:    true,
+									Description: "The ID of the run step",
+								},
+								"index": datasource_schema.Int64Attribute{
+									Computed:    true,
+									Description: "The position of the step in the run",
+								},
+								"name": datasource_schema.StringAttribute{
+									Computed:    true,
+									Description: "The name of the step, e.g. `Invoke dbt with dbt build`",
+								},
+								"status": datasource_schema.Int64Attribute{
+									Computed:    true,
+									Description: "The status of the step, using the same values as the run status",
+								},
+								"status_humanized": datasource_schema.StringAttribute{
+									Computed:    true,
+									Description: "The status of the step, in a human readable format",
+								},
+								"created_at": datasource_schema.StringAttribute{
+									Computed:    true,
+									Description: "When the step was created, in RFC 3339 format",
+								},
+								"finished_at": datasource_schema.StringAttribute{
+									Computed:    true,
+									Description: "When the step finished, in RFC 3339 format",
+								},
+								"duration_seconds": datasource_schema.Int64Attribute{
+									Computed:    true,
+									Description: "Duration of the step, in seconds",
+								},
+								"duration_humanized": datasource_schema.StringAttribute{
+									Computed:    true,
+									Description: "Duration of the step, in a human readable format",
+								},
+							},
+						},
+					},
 				},
 			},
 		},
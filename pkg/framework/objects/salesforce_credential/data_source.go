@@ -3,7 +3,6 @@ package salesforce_credential
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -78,9 +77,9 @@ func (d *salesforceCredentialDataSource) Read(
 	projectID := int(config.ProjectID.ValueInt64())
 	credentialID := int(config.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetSalesforceCredential(projectID, credentialID)
+	credential, err := d.client.GetSalesforceCredential(ctx, projectID, credentialID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		if dbt_cloud.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Salesforce credential not found",
 				fmt.Sprintf("Could not find Salesforce credential with ID %d in project %d", credentialID, projectID),
@@ -94,6 +94,7 @@ func (r *salesforceCredentialResource) Create(
 	numThreads := int(plan.NumThreads.ValueInt64())
 
 	credential, err := r.client.CreateSalesforceCredential(
+		ctx,
 		projectID,
 		username,
 		clientID,
@@ -130,7 +131,7 @@ func (r *salesforceCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetSalesforceCredential(projectID, credentialID)
+	credential, err := r.client.GetSalesforceCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "salesforce credential") {
 			return
@@ -194,7 +195,7 @@ func (r *salesforceCredentialResource) Update(
 		CredentialDetails: credentialDetails,
 	}
 
-	_, err = r.client.UpdateSalesforceCredential(projectID, credentialID, salesforceCredential)
+	_, err = r.client.UpdateSalesforceCredential(ctx, projectID, credentialID, salesforceCredential)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Salesforce credential",
@@ -225,6 +226,7 @@ func (r *salesforceCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
@@ -269,7 +271,7 @@ func (r *salesforceCredentialResource) ImportState(
 		return
 	}
 
-	credential, err := r.client.GetSalesforceCredential(projectID, credentialID)
+	credential, err := r.client.GetSalesforceCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting Salesforce credential", err.Error())
 		return
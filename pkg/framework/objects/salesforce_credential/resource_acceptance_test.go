@@ -1,6 +1,7 @@
 package salesforce_credential_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -261,7 +262,7 @@ func testAccCheckDbtCloudSalesforceCredentialDestroy(s *terraform.State) error {
 		fmt.Sscanf(idParts[0], "%d", &projectID)
 		fmt.Sscanf(idParts[1], "%d", &credentialID)
 
-		_, err := apiClient.GetSalesforceCredential(projectID, credentialID)
+		_, err := apiClient.GetSalesforceCredential(context.Background(), projectID, credentialID)
 		if err == nil {
 			return fmt.Errorf("Salesforce credential still exists")
 		}
@@ -73,7 +73,7 @@ func (r *scimConfigResource) Create(
 		return
 	}
 
-	cfg, err := r.client.UpdateSCIMConfig(modelToSCIMConfig(plan))
+	cfg, err := r.client.UpdateSCIMConfig(ctx, modelToSCIMConfig(plan))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to configure SCIM", err.Error())
 		return
@@ -96,7 +96,7 @@ func (r *scimConfigResource) Read(
 		return
 	}
 
-	cfg, err := r.client.GetSCIMConfig()
+	cfg, err := r.client.GetSCIMConfig(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading SCIM config", err.Error())
 		return
@@ -119,7 +119,7 @@ func (r *scimConfigResource) Update(
 		return
 	}
 
-	cfg, err := r.client.UpdateSCIMConfig(modelToSCIMConfig(plan))
+	cfg, err := r.client.UpdateSCIMConfig(ctx, modelToSCIMConfig(plan))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update SCIM config", err.Error())
 		return
@@ -135,11 +135,11 @@ func (r *scimConfigResource) Update(
 // SCIM disabled, manual updates allowed, license type not SCIM-controlled.
 
 func (r *scimConfigResource) Delete(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.DeleteRequest,
 	resp *resource.DeleteResponse,
 ) {
-	_, err := r.client.UpdateSCIMConfig(dbt_cloud.SCIMConfig{
+	_, err := r.client.UpdateSCIMConfig(ctx, dbt_cloud.SCIMConfig{
 		Enabled:                   false,
 		ManualUpdatesAllowed:      false,
 		SCIMControlledLicenseType: false,
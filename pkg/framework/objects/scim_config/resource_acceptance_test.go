@@ -1,6 +1,7 @@
 package scim_config_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -87,7 +88,7 @@ func testAccCheckDbtCloudSCIMConfigDestroy(s *terraform.State) error {
 			continue
 		}
 
-		cfg, err := apiClient.GetSCIMConfig()
+		cfg, err := apiClient.GetSCIMConfig(context.Background())
 		if err != nil {
 			return fmt.Errorf("error reading SCIM config after destroy: %w", err)
 		}
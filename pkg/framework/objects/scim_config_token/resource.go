@@ -57,7 +57,7 @@ func (r *scimConfigTokenResource) Create(
 		return
 	}
 
-	created, err := r.client.CreateSCIMConfigToken(plan.Name.ValueString())
+	created, err := r.client.CreateSCIMConfigToken(ctx, plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create SCIM config token", "Error: "+err.Error())
 		return
@@ -88,7 +88,7 @@ func (r *scimConfigTokenResource) Read(
 		return
 	}
 
-	token, err := r.client.GetSCIMConfigToken(state.ID.ValueInt64())
+	token, err := r.client.GetSCIMConfigToken(ctx, state.ID.ValueInt64())
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "SCIM config token") {
 			return
@@ -142,7 +142,7 @@ func (r *scimConfigTokenResource) Delete(
 		return
 	}
 
-	if err := r.client.DeleteSCIMConfigToken(state.ID.ValueInt64()); err != nil {
+	if err := r.client.DeleteSCIMConfigToken(ctx, state.ID.ValueInt64()); err != nil {
 		resp.Diagnostics.AddError("Issue deleting SCIM config token", "Error: "+err.Error())
 	}
 }
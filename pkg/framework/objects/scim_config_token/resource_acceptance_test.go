@@ -1,6 +1,7 @@
 package scim_config_token_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -92,7 +93,7 @@ func testAccCheckDbtCloudSCIMConfigTokenDestroy(s *terraform.State) error {
 			return fmt.Errorf("could not parse SCIM config token ID %q: %w", rs.Primary.ID, err)
 		}
 
-		_, err = apiClient.GetSCIMConfigToken(tokenID)
+		_, err = apiClient.GetSCIMConfigToken(context.Background(), tokenID)
 		if err == nil {
 			return fmt.Errorf("SCIM config token %d still exists after destroy", tokenID)
 		}
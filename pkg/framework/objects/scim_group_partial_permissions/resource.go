@@ -3,7 +3,6 @@ package scim_group_partial_permissions
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -48,9 +47,9 @@ func (r *scimGroupPartialPermissionsResource) Create(
 	groupID := int(plan.GroupID.ValueInt64())
 
 	// Verify the group exists
-	existingGroup, err := r.client.GetGroup(groupID)
+	existingGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		if dbt_cloud.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Group Not Found",
 				fmt.Sprintf("Group with ID %d does not exist. This resource only manages permissions for existing groups and does not create groups.", groupID),
@@ -114,7 +113,7 @@ func (r *scimGroupPartialPermissionsResource) Create(
 	)
 
 	// Update the group with the combined permissions
-	_, err = r.client.UpdateGroupPermissions(groupID, allPermissionsRequest)
+	_, err = r.client.UpdateGroupPermissions(ctx, groupID, allPermissionsRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group permissions",
@@ -140,7 +139,7 @@ func (r *scimGroupPartialPermissionsResource) Read(
 	}
 
 	groupID := int(state.GroupID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "scim group") {
@@ -191,7 +190,7 @@ func (r *scimGroupPartialPermissionsResource) Update(
 	}
 
 	groupID := int(state.GroupID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving group",
@@ -243,7 +242,7 @@ func (r *scimGroupPartialPermissionsResource) Update(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(groupID, allPermissionsRequest)
+		_, err = r.client.UpdateGroupPermissions(ctx, groupID, allPermissionsRequest)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating group permissions",
@@ -273,9 +272,9 @@ func (r *scimGroupPartialPermissionsResource) Delete(
 
 	tflog.Info(ctx, fmt.Sprintf("Removing partial permissions from group %d (group will remain)", groupID))
 
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		if dbt_cloud.IsNotFound(err) {
 			// Group is already gone, nothing to do
 			tflog.Info(ctx, fmt.Sprintf("Group %d not found, assuming already deleted", groupID))
 			return
@@ -317,7 +316,7 @@ func (r *scimGroupPartialPermissionsResource) Delete(
 		retrievedGroup.AccountID,
 	)
 
-	_, err = r.client.UpdateGroupPermissions(groupID, allPermissionsRequest)
+	_, err = r.client.UpdateGroupPermissions(ctx, groupID, allPermissionsRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error removing partial permissions from group",
@@ -69,6 +69,9 @@ func (r *scimGroupPartialPermissionsResource) Schema(
 			"permissions": schema.SetNestedAttribute{
 				Description: "Partial set of permissions to apply to the group. These permissions will be added to any existing permissions. Other permissions on the group will not be affected.",
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						helper.PermissionSetValidator(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"permission_set": schema.StringAttribute{
 							Required: true,
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -50,9 +49,9 @@ func (r *scimGroupPermissionsResource) Create(
 	groupID := int(plan.GroupID.ValueInt64())
 
 	// Verify the group exists and we're not trying to create it
-	existingGroup, err := r.client.GetGroup(groupID)
+	existingGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		if dbt_cloud.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Group Not Found",
 				fmt.Sprintf("Group with ID %d does not exist. This resource only manages permissions for existing groups and does not create groups.", groupID),
@@ -76,7 +75,7 @@ func (r *scimGroupPermissionsResource) Create(
 	)
 
 	// Update the group permissions
-	_, err = r.client.UpdateGroupPermissions(groupID, allPermissions)
+	_, err = r.client.UpdateGroupPermissions(ctx, groupID, allPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group permissions",
@@ -104,7 +103,7 @@ func (r *scimGroupPermissionsResource) Read(
 	}
 
 	groupID := int(state.GroupID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "group") {
@@ -146,7 +145,7 @@ func (r *scimGroupPermissionsResource) Update(
 	)
 
 	// Update the group permissions
-	_, err := r.client.UpdateGroupPermissions(groupID, allPermissions)
+	_, err := r.client.UpdateGroupPermissions(ctx, groupID, allPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group permissions",
@@ -176,7 +175,7 @@ func (r *scimGroupPermissionsResource) Delete(
 
 	// Remove all permissions by setting an empty permissions array
 	emptyPermissions := []dbt_cloud.GroupPermission{}
-	_, err := r.client.UpdateGroupPermissions(groupID, emptyPermissions)
+	_, err := r.client.UpdateGroupPermissions(ctx, groupID, emptyPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error removing group permissions",
@@ -216,7 +215,7 @@ func (r *scimGroupPermissionsResource) ImportState(
 	}
 
 	// Verify the group exists
-	existingGroup, err := r.client.GetGroup(groupID)
+	existingGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing scim_group_permissions",
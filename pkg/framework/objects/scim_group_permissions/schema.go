@@ -56,6 +56,9 @@ func (r *scimGroupPermissionsResource) Schema(
 			"permissions": schema.SetNestedAttribute{
 				Description: "Set of permissions to apply to the group. This will replace all existing permissions for the group.",
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						helper.PermissionSetValidator(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"permission_set": schema.StringAttribute{
 							Required: true,
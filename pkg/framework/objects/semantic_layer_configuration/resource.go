@@ -42,7 +42,7 @@ func (r *semanticLayerConfigurationResource) Read(
 
 	configID := state.ID.ValueInt64()
 	projectID := state.ProjectID.ValueInt64()
-	retrievedConfig, err := r.client.GetSemanticLayerConfiguration(projectID, configID)
+	retrievedConfig, err := r.client.GetSemanticLayerConfiguration(ctx, projectID, configID)
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "semantic layer configuration") {
@@ -81,7 +81,7 @@ func (r *semanticLayerConfigurationResource) Create(
 		Status:        10,
 	}
 
-	runs, err := r.client.GetRuns(&filter)
+	runs, err := r.client.GetRuns(ctx, &filter)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue when retrieving runs",
@@ -99,6 +99,7 @@ func (r *semanticLayerConfigurationResource) Create(
 	}
 
 	createdConfig, err := r.client.CreateSemanticLayerConfiguration(
+		ctx,
 		projectID,
 		environmentID,
 	)
@@ -113,7 +114,7 @@ func (r *semanticLayerConfigurationResource) Create(
 	plan.ID = types.Int64Value(createdConfig.ID)
 
 	// Fetch associated project
-	project, err := r.client.GetProject(strconv.FormatInt(projectID, 10))
+	project, err := r.client.GetProject(ctx, strconv.FormatInt(projectID, 10))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to retrieve project",
@@ -124,7 +125,7 @@ func (r *semanticLayerConfigurationResource) Create(
 
 	// Update Project with Semantic Layer configuration ID
 	project.SemanticLayerConfigID = &createdConfig.ID
-	_, err = r.client.UpdateProject(strconv.FormatInt(projectID, 10), *project)
+	_, err = r.client.UpdateProject(ctx, strconv.FormatInt(projectID, 10), *project)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update project with Semantic Layer configuration ID",
@@ -151,7 +152,7 @@ func (r *semanticLayerConfigurationResource) Delete(
 	configID := state.ID
 	projectID := state.ProjectID.ValueInt64()
 
-	err := r.client.DeleteSemanticLayerConfiguration(projectID, configID.ValueInt64())
+	err := r.client.DeleteSemanticLayerConfiguration(ctx, projectID, configID.ValueInt64())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -185,7 +186,7 @@ func (r *semanticLayerConfigurationResource) Update(
 	configID := state.ID.ValueInt64()
 	projectID := state.ProjectID.ValueInt64()
 
-	retrievedConfigID, err := r.client.GetSemanticLayerConfiguration(projectID, configID)
+	retrievedConfigID, err := r.client.GetSemanticLayerConfiguration(ctx, projectID, configID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Semantic Layer configuration",
@@ -197,6 +198,7 @@ func (r *semanticLayerConfigurationResource) Update(
 	retrievedConfigID.EnvironmentID = plan.EnvironmentID.ValueInt64()
 
 	_, err = r.client.UpdateSemanticLayerConfiguration(
+		ctx,
 		projectID,
 		configID,
 		*retrievedConfigID,
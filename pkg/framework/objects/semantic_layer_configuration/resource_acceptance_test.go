@@ -1,6 +1,7 @@
 package semantic_layer_configuration_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -90,7 +91,7 @@ func testAccCheckDbtCloudSemanticLayerConfigurationDestroy(s *terraform.State) e
 		if err != nil {
 			return fmt.Errorf("failed to convert resource ID to int64: %s", err)
 		}
-		_, err = apiClient.GetSemanticLayerConfiguration(int64(projectID), id)
+		_, err = apiClient.GetSemanticLayerConfiguration(context.Background(), int64(projectID), id)
 		if err == nil {
 			return fmt.Errorf("Semantic Layer Configuration still exists")
 		}
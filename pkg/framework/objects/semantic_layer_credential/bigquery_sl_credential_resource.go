@@ -42,7 +42,7 @@ func (r *bigQuerySemanticLayerCredentialResource) Read(
 
 	id := state.ID.ValueInt64()
 
-	credential, err := r.client.GetSemanticLayerCredential(id)
+	credential, err := r.client.GetSemanticLayerCredential(ctx, id)
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "bigquery semantic layer credential") {
@@ -125,6 +125,7 @@ func (r *bigQuerySemanticLayerCredentialResource) Create(
 	}
 
 	createdCredential, err := r.client.CreateSemanticLayerCredential(
+		ctx,
 		projectID,
 		values,
 		plan.Configuration.Name.ValueString(),
@@ -162,7 +163,7 @@ func (r *bigQuerySemanticLayerCredentialResource) Delete(
 	id := state.ID
 	projectID := state.Credential.ProjectID.ValueInt64()
 
-	err := r.client.DeleteSemanticLayerCredential(projectID, id.ValueInt64())
+	err := r.client.DeleteSemanticLayerCredential(ctx, projectID, id.ValueInt64())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -202,7 +203,7 @@ func (r *bigQuerySemanticLayerCredentialResource) Update(
 
 	id := state.ID.ValueInt64()
 
-	credential, err := r.client.GetSemanticLayerCredential(id)
+	credential, err := r.client.GetSemanticLayerCredential(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Semantic Layer configuration",
@@ -232,6 +233,7 @@ func (r *bigQuerySemanticLayerCredentialResource) Update(
 	credential.Values = values
 
 	_, err = r.client.UpdateSemanticLayerCredential(
+		ctx,
 		id,
 		*credential,
 	)
@@ -243,7 +245,7 @@ func (r *bigQuerySemanticLayerCredentialResource) Update(
 		return
 	}
 
-	updatedCredential, err := r.client.GetSemanticLayerCredential(id)
+	updatedCredential, err := r.client.GetSemanticLayerCredential(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Semantic Layer configuration",
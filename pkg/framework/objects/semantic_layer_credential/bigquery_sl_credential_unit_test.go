@@ -33,6 +33,7 @@ func newTestClient(t *testing.T, serverURL string) *dbt_cloud.Client {
 	token := "test-token"
 
 	client, err := dbt_cloud.NewClient(
+		context.Background(),
 		&accountID,
 		strPtr(token),
 		strPtr(hostURL),
@@ -148,7 +149,7 @@ func TestCreateBigQuerySLCredentialWithExecutionProject(t *testing.T) {
 		"execution_project":           "my-execution-project",
 	}
 
-	_, err := client.CreateSemanticLayerCredential(100, values, "test-credential", "dbt-bigquery>=1.0.0,<2.0.0")
+	_, err := client.CreateSemanticLayerCredential(context.Background(), 100, values, "test-credential", "dbt-bigquery>=1.0.0,<2.0.0")
 	if err != nil {
 		t.Fatalf("CreateSemanticLayerCredential failed: %v", err)
 	}
@@ -198,7 +199,7 @@ func TestCreateBigQuerySLCredentialWithoutExecutionProject(t *testing.T) {
 		// no execution_project
 	}
 
-	_, err := client.CreateSemanticLayerCredential(100, values, "test-credential", "dbt-bigquery>=1.0.0,<2.0.0")
+	_, err := client.CreateSemanticLayerCredential(context.Background(), 100, values, "test-credential", "dbt-bigquery>=1.0.0,<2.0.0")
 	if err != nil {
 		t.Fatalf("CreateSemanticLayerCredential failed: %v", err)
 	}
@@ -227,7 +228,7 @@ func TestReadBigQuerySLCredentialWithExecutionProject(t *testing.T) {
 	server := testutil.SetupMockServer(t, handlers)
 	defer server.Close()
 
-	credential, err := newTestClient(t, server.URL).GetSemanticLayerCredential(int64(credentialID))
+	credential, err := newTestClient(t, server.URL).GetSemanticLayerCredential(context.Background(), int64(credentialID))
 	if err != nil {
 		t.Fatalf("GetSemanticLayerCredential failed: %v", err)
 	}
@@ -256,7 +257,7 @@ func TestReadBigQuerySLCredentialWithoutExecutionProject(t *testing.T) {
 	server := testutil.SetupMockServer(t, handlers)
 	defer server.Close()
 
-	credential, err := newTestClient(t, server.URL).GetSemanticLayerCredential(int64(credentialID))
+	credential, err := newTestClient(t, server.URL).GetSemanticLayerCredential(context.Background(), int64(credentialID))
 	if err != nil {
 		t.Fatalf("GetSemanticLayerCredential failed: %v", err)
 	}
@@ -308,7 +309,7 @@ func TestUpdateBigQuerySLCredentialWithExecutionProject(t *testing.T) {
 		},
 	}
 
-	_, err := newTestClient(t, server.URL).UpdateSemanticLayerCredential(int64(credentialID), credential)
+	_, err := newTestClient(t, server.URL).UpdateSemanticLayerCredential(context.Background(), int64(credentialID), credential)
 	if err != nil {
 		t.Fatalf("UpdateSemanticLayerCredential failed: %v", err)
 	}
@@ -364,7 +365,7 @@ func TestUpdateBigQuerySLCredentialRemoveExecutionProject(t *testing.T) {
 		},
 	}
 
-	_, err := newTestClient(t, server.URL).UpdateSemanticLayerCredential(int64(credentialID), credential)
+	_, err := newTestClient(t, server.URL).UpdateSemanticLayerCredential(context.Background(), int64(credentialID), credential)
 	if err != nil {
 		t.Fatalf("UpdateSemanticLayerCredential failed: %v", err)
 	}
@@ -42,7 +42,7 @@ func (r *databricksSemanticLayerCredentialResource) Read(
 		return
 	}
 
-	credential, err := r.client.GetSemanticLayerCredential(state.ID.ValueInt64())
+	credential, err := r.client.GetSemanticLayerCredential(ctx, state.ID.ValueInt64())
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "databricks semantic layer credential") {
 			return
@@ -79,6 +79,7 @@ func (r *databricksSemanticLayerCredentialResource) Create(
 	}
 
 	createdCredential, err := r.client.CreateSemanticLayerCredential(
+		ctx,
 		plan.Credential.ProjectID.ValueInt64(),
 		values,
 		plan.Configuration.Name.ValueString(),
@@ -112,7 +113,7 @@ func (r *databricksSemanticLayerCredentialResource) Delete(
 		return
 	}
 
-	err := r.client.DeleteSemanticLayerCredential(state.Credential.ProjectID.ValueInt64(), state.ID.ValueInt64())
+	err := r.client.DeleteSemanticLayerCredential(ctx, state.Credential.ProjectID.ValueInt64(), state.ID.ValueInt64())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -143,7 +144,7 @@ func (r *databricksSemanticLayerCredentialResource) Update(
 	}
 
 	id := state.ID.ValueInt64()
-	credential, err := r.client.GetSemanticLayerCredential(id)
+	credential, err := r.client.GetSemanticLayerCredential(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Semantic Layer configuration",
@@ -161,6 +162,7 @@ func (r *databricksSemanticLayerCredentialResource) Update(
 	credential.Name = plan.Configuration.Name.ValueString()
 
 	_, err = r.client.UpdateSemanticLayerCredential(
+		ctx,
 		id,
 		*credential,
 	)
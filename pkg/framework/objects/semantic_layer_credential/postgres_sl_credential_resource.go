@@ -43,7 +43,7 @@ func (r *postgresSemanticLayerCredentialResource) Read(
 
 	id := state.ID.ValueInt64()
 
-	credential, err := r.client.GetSemanticLayerCredential(id)
+	credential, err := r.client.GetSemanticLayerCredential(ctx, id)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "postgres semantic layer credential") {
 			return
@@ -87,6 +87,7 @@ func (r *postgresSemanticLayerCredentialResource) Create(
 	}
 
 	createdCredential, err := r.client.CreateSemanticLayerCredential(
+		ctx,
 		projectID,
 		values,
 		plan.Configuration.Name.ValueString(),
@@ -122,7 +123,7 @@ func (r *postgresSemanticLayerCredentialResource) Delete(
 	id := state.ID
 	projectID := state.Credential.ProjectID.ValueInt64()
 
-	err := r.client.DeleteSemanticLayerCredential(projectID, id.ValueInt64())
+	err := r.client.DeleteSemanticLayerCredential(ctx, projectID, id.ValueInt64())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -155,7 +156,7 @@ func (r *postgresSemanticLayerCredentialResource) Update(
 
 	id := state.ID.ValueInt64()
 
-	credential, err := r.client.GetSemanticLayerCredential(id)
+	credential, err := r.client.GetSemanticLayerCredential(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Semantic Layer Credential",
@@ -174,6 +175,7 @@ func (r *postgresSemanticLayerCredentialResource) Update(
 	credential.Values = values
 
 	_, err = r.client.UpdateSemanticLayerCredential(
+		ctx,
 		id,
 		*credential,
 	)
@@ -1,6 +1,7 @@
 package semantic_layer_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -152,7 +153,7 @@ func testAccCheckDbtCloudSemanticLayerCredentialPostgresDestroy(s *terraform.Sta
 		if err != nil {
 			return fmt.Errorf("failed to convert resource ID to int64: %s", err)
 		}
-		_, err = apiClient.GetSemanticLayerCredential(id)
+		_, err = apiClient.GetSemanticLayerCredential(context.Background(), id)
 		if err == nil {
 			return fmt.Errorf("Semantic Layer Configuration still exists")
 		}
@@ -43,7 +43,7 @@ func (r *snowflakeSemanticLayerCredentialResource) Read(
 
 	id := state.ID.ValueInt64()
 
-	credential, err := r.client.GetSemanticLayerCredential(id)
+	credential, err := r.client.GetSemanticLayerCredential(ctx, id)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "snowflake semantic layer credential") {
 			return
@@ -88,6 +88,7 @@ func (r *snowflakeSemanticLayerCredentialResource) Create(
 	}
 
 	createdCredential, err := r.client.CreateSemanticLayerCredential(
+		ctx,
 		projectID,
 		values,
 		plan.Configuration.Name.ValueString(),
@@ -126,7 +127,7 @@ func (r *snowflakeSemanticLayerCredentialResource) Delete(
 	id := state.ID
 	projectID := state.Credential.ProjectID.ValueInt64()
 
-	err := r.client.DeleteSemanticLayerCredential(projectID, id.ValueInt64())
+	err := r.client.DeleteSemanticLayerCredential(ctx, projectID, id.ValueInt64())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -159,7 +160,7 @@ func (r *snowflakeSemanticLayerCredentialResource) Update(
 
 	id := state.ID.ValueInt64()
 
-	credential, err := r.client.GetSemanticLayerCredential(id)
+	credential, err := r.client.GetSemanticLayerCredential(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Semantic Layer configuration",
@@ -182,6 +183,7 @@ func (r *snowflakeSemanticLayerCredentialResource) Update(
 	credential.Values = values
 
 	_, err = r.client.UpdateSemanticLayerCredential(
+		ctx,
 		id,
 		*credential,
 	)
@@ -1,6 +1,7 @@
 package semantic_layer_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -261,7 +262,7 @@ func testAccCheckDbtCloudSemanticLayerCredentialDestroy(s *terraform.State) erro
 		if err != nil {
 			return fmt.Errorf("failed to convert resource ID to int64: %s", err)
 		}
-		_, err = apiClient.GetSemanticLayerCredential(id)
+		_, err = apiClient.GetSemanticLayerCredential(context.Background(), id)
 		if err == nil {
 			return fmt.Errorf("Semantic Layer Configuration still exists")
 		}
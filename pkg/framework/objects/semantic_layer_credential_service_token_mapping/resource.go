@@ -96,6 +96,7 @@ func (r *semanticLayerCredentialServiceTokenMappingResource) Create(ctx context.
 
 	// Create the semantic layer credential service token mapping
 	mapping, err := r.client.CreateSemanticLayerCredentialServiceTokenMapping(
+		ctx,
 		int(project_id),
 		int(cred_id),
 		int(token_id),
@@ -145,7 +146,7 @@ func (r *semanticLayerCredentialServiceTokenMappingResource) Read(ctx context.Co
 	}
 
 	// Read the semantic layer credential service token mapping
-	mapping, err := r.client.GetSemanticLayerCredentialServiceTokenMapping(sm)
+	mapping, err := r.client.GetSemanticLayerCredentialServiceTokenMapping(ctx, sm)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "semantic layer credential service token mapping") {
 			return
@@ -186,7 +187,7 @@ func (r *semanticLayerCredentialServiceTokenMappingResource) Delete(ctx context.
 	sm_id := int(state.ID.ValueInt64())
 
 	// Delete the semantic layer credential service token mapping
-	err := r.client.DeleteSemanticLayerCredentialServiceTokenMapping(sm_id)
+	err := r.client.DeleteSemanticLayerCredentialServiceTokenMapping(ctx, sm_id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Semantic Layer Credential Service Token Mapping",
@@ -1,6 +1,7 @@
 package semantic_layer_credential_service_token_mapping_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -140,7 +141,7 @@ func testAccCheckDbtCloudSLCredServiceTokenMappingDestroy(s *terraform.State) er
 			ProjectID:                 project_id,
 		}
 
-		_, err = apiClient.GetSemanticLayerCredentialServiceTokenMapping(sm)
+		_, err = apiClient.GetSemanticLayerCredentialServiceTokenMapping(context.Background(), sm)
 		if err == nil {
 			return fmt.Errorf("Mapping still exists")
 		}
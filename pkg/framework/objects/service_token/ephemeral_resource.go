@@ -0,0 +1,229 @@
+package service_token
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &serviceTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &serviceTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &serviceTokenEphemeralResource{}
+)
+
+// privateKeyServiceTokenID is the key used to hand the ID of the token created
+// in Open over to Close, so that the token can be revoked at the end of the run.
+const privateKeyServiceTokenID = "service_token_id"
+
+func ServiceTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &serviceTokenEphemeralResource{}
+}
+
+type serviceTokenEphemeralResource struct {
+	client *dbt_cloud.Client
+}
+
+type serviceTokenPrivateData struct {
+	ID int `json:"id"`
+}
+
+// Metadata implements ephemeral.EphemeralResource.
+func (st *serviceTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_token"
+}
+
+// Configure implements ephemeral.EphemeralResourceWithConfigure.
+func (st *serviceTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	switch c := req.ProviderData.(type) {
+	case nil: // do nothing
+	case *dbt_cloud.Client:
+		st.client = c
+	default:
+		resp.Diagnostics.AddError("Missing client", "A client is required to configure the service token ephemeral resource")
+	}
+}
+
+// Schema implements ephemeral.EphemeralResource.
+func (st *serviceTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helper.DocString(
+			`Creates a short-lived service token for the duration of a Terraform run.
+			The token is created when Terraform opens the ephemeral resource and is deleted in dbt Cloud when Terraform closes it, so ~~~token_string~~~ is never persisted in the state or in the plan.
+			Use it to hand credentials to other providers or to write-only attributes that only need to be valid during the run.`,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the service token",
+			},
+			"uid": schema.StringAttribute{
+				Computed:    true,
+				Description: "Service token UID (part of the token)",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Service token name",
+			},
+			"token_string": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Service token secret value. It is only valid until Terraform closes the ephemeral resource at the end of the run.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"service_token_permissions": schema.SetNestedBlock{
+				Description: "Permissions set for the service token",
+				NestedObject: schema.NestedBlockObject{
+					Validators: []validator.Object{
+						helper.ServiceTokenPermissionSetValidator(),
+					},
+					Attributes: map[string]schema.Attribute{
+						"permission_set": schema.StringAttribute{
+							Description: "Set of permissions to apply",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(dbt_cloud.PermissionSets...),
+							},
+						},
+						"all_projects": schema.BoolAttribute{
+							Description: "Whether or not to apply this permission to all projects for this service token",
+							Required:    true,
+						},
+						"project_id": schema.Int64Attribute{
+							Description: "Project ID to apply this permission to for this service token",
+							Optional:    true,
+						},
+						"writable_environment_categories": schema.SetAttribute{
+							Description: helper.DocString(
+								`What types of environments to apply Write permissions to.
+								Even if Write access is restricted to some environment types, the permission set will have Read access to all environments.
+								The values allowed are ~~~all~~~, ~~~development~~~, ~~~staging~~~, ~~~production~~~ and ~~~other~~~.
+								Not setting a value (or setting an empty list) means the permission set has no Write access to any environment — only Read access. To grant Write access to all environments, set this to ~~~["all"]~~~.
+								Not all permission sets support environment level write settings, only ~~~analyst~~~, ~~~database_admin~~~, ~~~developer~~~, ~~~git_admin~~~ and ~~~team_admin~~~.`,
+							),
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(
+									stringvalidator.OneOf(dbt_cloud.EnvironmentCategories...),
+								),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Open implements ephemeral.EphemeralResource.
+func (st *serviceTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ServiceTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdSrvTok, err := st.client.CreateServiceToken(ctx, config.Name.ValueString(), dbt_cloud.STATE_ACTIVE)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create the service token", err.Error())
+		return
+	}
+
+	if createdSrvTok == nil || createdSrvTok.ID == nil {
+		resp.Diagnostics.AddError("Error creating the service token", "The created service token or its ID is null")
+		return
+	}
+
+	// from here on, any failure needs to revoke the token we just created, as
+	// Terraform will not call Close when Open returns an error
+	if createdSrvTok.TokenString == nil {
+		resp.Diagnostics.AddError("Error creating the service token", "The secret of the created service token is null")
+		st.revoke(ctx, *createdSrvTok.ID, &resp.Diagnostics)
+		return
+	}
+
+	srvTokPermissions, diags := ConvertServiceTokenPermissionModelToData(ctx, config.ServiceTokenPermissions, *createdSrvTok.ID, st.client.AccountID)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		st.revoke(ctx, *createdSrvTok.ID, &resp.Diagnostics)
+		return
+	}
+
+	if len(srvTokPermissions) > 0 {
+		_, err = st.client.UpdateServiceTokenPermissions(ctx, *createdSrvTok.ID, srvTokPermissions)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to assign permissions to the service token", err.Error())
+			st.revoke(ctx, *createdSrvTok.ID, &resp.Diagnostics)
+			return
+		}
+	}
+
+	privateData, err := json.Marshal(serviceTokenPrivateData{ID: *createdSrvTok.ID})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to store the service token ID", err.Error())
+		st.revoke(ctx, *createdSrvTok.ID, &resp.Diagnostics)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyServiceTokenID, privateData)...)
+	if resp.Diagnostics.HasError() {
+		st.revoke(ctx, *createdSrvTok.ID, &resp.Diagnostics)
+		return
+	}
+
+	config.ID = types.StringValue(strconv.Itoa(*createdSrvTok.ID))
+	config.UID = types.StringValue(createdSrvTok.UID)
+	config.TokenString = types.StringValue(*createdSrvTok.TokenString)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// Close implements ephemeral.EphemeralResourceWithClose.
+func (st *serviceTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, privateKeyServiceTokenID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if privateBytes == nil {
+		// nothing was created in Open
+		return
+	}
+
+	var privateData serviceTokenPrivateData
+	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
+		resp.Diagnostics.AddError("Unable to read the service token ID", err.Error())
+		return
+	}
+
+	st.revoke(ctx, privateData.ID, &resp.Diagnostics)
+}
+
+// revoke deletes the service token, treating a token that is already gone as
+// successfully revoked.
+func (st *serviceTokenEphemeralResource) revoke(ctx context.Context, serviceTokenID int, diags *diag.Diagnostics) {
+	tflog.Debug(ctx, "Revoking ephemeral service token", map[string]any{"service_token_id": serviceTokenID})
+
+	_, err := st.client.DeleteServiceToken(ctx, serviceTokenID)
+	if err != nil && !dbt_cloud.IsNotFound(err) {
+		diags.AddError(
+			"Unable to revoke the service token",
+			fmt.Sprintf("The service token %d could not be deleted and needs to be removed manually: %s", serviceTokenID, err.Error()),
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package service_token_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDbtCloudServiceTokenEphemeralResource(t *testing.T) {
+
+	serviceTokenName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest_helper.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"dbtcloud": acctest_helper.TestAccProtoV6ProviderFactories["dbtcloud"],
+			"echo":     echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudServiceTokenEphemeralResourceConfig(serviceTokenName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test_service_token",
+						tfjsonpath.New("data").AtMapKey("name"),
+						knownvalue.StringExact(serviceTokenName),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test_service_token",
+						tfjsonpath.New("data").AtMapKey("token_string"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^dbtc_`)),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudServiceTokenEphemeralResourceConfig(serviceTokenName string) string {
+	return fmt.Sprintf(`
+ephemeral "dbtcloud_service_token" "test_service_token" {
+    name = "%s"
+    service_token_permissions {
+        permission_set = "job_runner"
+        all_projects = true
+    }
+}
+
+provider "echo" {
+    data = ephemeral.dbtcloud_service_token.test_service_token
+}
+
+resource "echo" "test_service_token" {}
+`, serviceTokenName)
+}
@@ -28,6 +28,15 @@ type ServiceTokenDataSourceModel struct {
 	ServiceTokenPermissions []ServiceTokenPermission `tfsdk:"service_token_permissions"`
 }
 
+type ServiceTokenEphemeralResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UID         types.String `tfsdk:"uid"`
+	Name        types.String `tfsdk:"name"`
+	TokenString types.String `tfsdk:"token_string"`
+
+	ServiceTokenPermissions []ServiceTokenPermission `tfsdk:"service_token_permissions"`
+}
+
 type ServiceTokenPermission struct {
 	PermissionSet                 types.String `tfsdk:"permission_set"`
 	AllProjects                   types.Bool   `tfsdk:"all_projects"`
@@ -94,6 +94,9 @@ func (st *serviceTokenResource) Schema(_ context.Context, _ resource.SchemaReque
 					setplanmodifier.RequiresReplace(),
 				},
 				NestedObject: schema.NestedBlockObject{
+					Validators: []validator.Object{
+						helper.ServiceTokenPermissionSetValidator(),
+					},
 					Attributes: map[string]schema.Attribute{
 						"permission_set": schema.StringAttribute{
 							Description: "Set of permissions to apply",
@@ -113,7 +116,6 @@ func (st *serviceTokenResource) Schema(_ context.Context, _ resource.SchemaReque
 							},
 						},
 						// TODO(cwalden): Would this be better as a Set of Int64?
-						// TODO(cwalden): Add a validator to ensure that the project ID is set if `all_projects` is false
 						"project_id": schema.Int64Attribute{
 							Description: "Project ID to apply this permission to for this service token",
 							Optional:    true,
@@ -121,7 +123,6 @@ func (st *serviceTokenResource) Schema(_ context.Context, _ resource.SchemaReque
 								int64planmodifier.RequiresReplace(),
 							},
 						},
-						// TODO(cwalden): Add validator to ensure that this is configurable for the given `permission_set`
 						"writable_environment_categories": schema.SetAttribute{
 							Description: helper.DocString(
 								`What types of environments to apply Write permissions to.
@@ -169,7 +170,7 @@ func (st *serviceTokenResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	svcTok, err := st.client.GetServiceToken(svcTokID)
+	svcTok, err := st.client.GetServiceToken(ctx, svcTokID)
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "service token") {
@@ -184,7 +185,7 @@ func (st *serviceTokenResource) Read(ctx context.Context, req resource.ReadReque
 	state.Name = types.StringValue(svcTok.Name)
 	state.State = types.Int64Value(int64(svcTok.State))
 
-	svcTokPerms, err := st.client.GetServiceTokenPermissions(int(svcTokID))
+	svcTokPerms, err := st.client.GetServiceTokenPermissions(ctx, int(svcTokID))
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the service token permissions", err.Error())
@@ -216,7 +217,7 @@ func (st *serviceTokenResource) Create(ctx context.Context, req resource.CreateR
 	name := plan.Name.ValueString()
 	state := plan.State.ValueInt64()
 
-	createdSrvTok, err := st.client.CreateServiceToken(name, int(state))
+	createdSrvTok, err := st.client.CreateServiceToken(ctx, name, int(state))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create the service token", err.Error())
 		return
@@ -233,7 +234,7 @@ func (st *serviceTokenResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	updatedSvcTokPerms, err := st.client.UpdateServiceTokenPermissions(*createdSrvTok.ID, srvTokPermissions)
+	updatedSvcTokPerms, err := st.client.UpdateServiceTokenPermissions(ctx, *createdSrvTok.ID, srvTokPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to assign permissions to the service token", err.Error())
 		return
@@ -280,7 +281,7 @@ func (st *serviceTokenResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	if _, err := st.client.DeleteServiceToken(svcTokID); err != nil {
+	if _, err := st.client.DeleteServiceToken(ctx, svcTokID); err != nil {
 		resp.Diagnostics.AddError("Unable to delete the service token", err.Error())
 		return
 	}
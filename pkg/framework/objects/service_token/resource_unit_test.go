@@ -0,0 +1,89 @@
+package service_token_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestServiceTokenResourceFakeAPI runs the create, replace, import and delete
+// cycle of the service token resource against the in-memory fake of the dbt
+// Cloud API, service tokens can't be updated.
+func TestServiceTokenResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if tokens := server.List(fakeapi.ServiceTokens); len(tokens) != 0 {
+				return fmt.Errorf("expected the service token to be deleted, got %v", tokens)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testServiceTokenResourceFakeAPIConfig("CI", "job_admin", `"all"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_service_token.test", "name", "CI"),
+					resource.TestCheckResourceAttrSet("dbtcloud_service_token.test", "token_string"),
+					resource.TestCheckResourceAttrSet("dbtcloud_service_token.test", "uid"),
+					resource.TestCheckResourceAttr("dbtcloud_service_token.test", "service_token_permissions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"dbtcloud_service_token.test",
+						"service_token_permissions.*",
+						map[string]string{"permission_set": "job_admin", "all_projects": "false"},
+					),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testServiceTokenResourceFakeAPIConfig("CI v2", "developer", `"development"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_service_token.test", "name", "CI v2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"dbtcloud_service_token.test",
+						"service_token_permissions.*",
+						map[string]string{
+							"permission_set":                    "developer",
+							"writable_environment_categories.#": "1",
+							"writable_environment_categories.0": "development",
+						},
+					),
+				),
+			},
+			{
+				ResourceName:            "dbtcloud_service_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token_string"},
+			},
+		},
+	})
+}
+
+func testServiceTokenResourceFakeAPIConfig(name, permissionSet, writableEnvironmentCategories string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test" {
+  name = "analytics"
+}
+
+resource "dbtcloud_service_token" "test" {
+  name = %q
+
+  service_token_permissions {
+    permission_set = "git_admin"
+    all_projects   = true
+  }
+
+  service_token_permissions {
+    permission_set                  = %q
+    all_projects                    = false
+    project_id                      = dbtcloud_project.test.id
+    writable_environment_categories = [%s]
+  }
+}
+`, name, permissionSet, writableEnvironmentCategories)
+}
@@ -84,7 +84,7 @@ func (d *snowflakeCredentialDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetSnowflakeCredential(projectID, credentialID)
+	credential, err := d.client.GetSnowflakeCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Snowflake credential",
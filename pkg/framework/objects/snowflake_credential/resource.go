@@ -111,6 +111,7 @@ func (r *snowflakeCredentialResource) Create(
 
 	// Create new credential
 	credential, err := r.client.CreateSnowflakeCredential(
+		ctx,
 		project_id,
 		"snowflake",
 		is_active,
@@ -163,7 +164,7 @@ func (r *snowflakeCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetSnowflakeCredential(projectID, credentialID)
+	credential, err := r.client.GetSnowflakeCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "snowflake credential") {
 			return
@@ -233,7 +234,7 @@ func (r *snowflakeCredentialResource) Update(
 		(state.PrivateKeyPassphraseWoVersion != plan.PrivateKeyPassphraseWoVersion) ||
 		(state.IsActive != plan.IsActive) ||
 		(state.NumThreads != plan.NumThreads) {
-		credential, err := r.client.GetSnowflakeCredential(projectID, credentialID)
+		credential, err := r.client.GetSnowflakeCredential(ctx, projectID, credentialID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error reading Snowflake credential",
@@ -264,7 +265,7 @@ func (r *snowflakeCredentialResource) Update(
 			credential.State = 0
 		}
 
-		_, err = r.client.UpdateSnowflakeCredential(projectID, credentialID, *credential)
+		_, err = r.client.UpdateSnowflakeCredential(ctx, projectID, credentialID, *credential)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating Snowflake credential",
@@ -302,6 +303,7 @@ func (r *snowflakeCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
@@ -358,7 +360,7 @@ func (r *snowflakeCredentialResource) ImportState(
 	}
 
 	// Get the credential from the API to populate all required fields
-	credential, err := r.client.GetSnowflakeCredential(projectID, credentialID)
+	credential, err := r.client.GetSnowflakeCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Snowflake credential",
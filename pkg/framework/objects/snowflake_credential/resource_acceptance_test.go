@@ -1,6 +1,7 @@
 package snowflake_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -334,7 +335,7 @@ func testAccCheckDbtCloudSnowflakeCredentialExists(resource string) resource.Tes
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetSnowflakeCredential(projectId, credentialId)
+		_, err = apiClient.GetSnowflakeCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -360,7 +361,7 @@ func testAccCheckDbtCloudSnowflakeCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetSnowflakeCredential(projectId, credentialId)
+		_, err = apiClient.GetSnowflakeCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Snowflake credential still exists")
 		}
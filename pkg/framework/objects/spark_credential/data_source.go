@@ -57,7 +57,7 @@ func (d *sparkCredentialDataSource) Read(ctx context.Context, req datasource.Rea
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetSparkCredential(projectID, credentialID)
+	credential, err := d.client.GetSparkCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading Apache Spark credential", "Could not read Apache Spark credential ID "+state.ID.ValueString()+": "+err.Error())
 		return
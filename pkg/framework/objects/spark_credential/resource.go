@@ -55,7 +55,7 @@ func (d *sparkCredentialResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
-	credentialResponse, err := d.client.GetSparkCredential(projectID, credentialID)
+	credentialResponse, err := d.client.GetSparkCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting Apache Spark credential", err.Error())
 		return
@@ -147,6 +147,7 @@ func (d *sparkCredentialResource) createGlobal(ctx context.Context, plan *SparkC
 	targetName := plan.TargetName.ValueString()
 
 	sparkCredential, err := d.client.CreateSparkCredential(
+		ctx,
 		projectID,
 		token,
 		schema,
@@ -178,17 +179,18 @@ func (d *sparkCredentialResource) Delete(ctx context.Context, req resource.Delet
 	d.deleteGlobal(ctx, &state, resp)
 }
 
-func (d *sparkCredentialResource) deleteGlobal(_ context.Context, state *SparkCredentialResourceModel, resp *resource.DeleteResponse) {
+func (d *sparkCredentialResource) deleteGlobal(ctx context.Context, state *SparkCredentialResourceModel, resp *resource.DeleteResponse) {
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := d.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
 	if err != nil {
 		// If the resource is already deleted (404), treat as success
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		if dbt_cloud.IsNotFound(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Error deleting Apache Spark credential", err.Error())
@@ -211,7 +213,7 @@ func (d *sparkCredentialResource) Read(ctx context.Context, req resource.ReadReq
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetSparkCredential(projectID, credentialID)
+	credential, err := d.client.GetSparkCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading Apache Spark credential", "Could not read Apache Spark credential ID "+state.ID.ValueString()+": "+err.Error())
 		return
@@ -307,7 +309,7 @@ func (d *sparkCredentialResource) updateGlobal(ctx context.Context, plan, state
 			CredentialDetails: patchCredentialsDetails,
 		}
 
-		_, err = d.client.UpdateSparkCredentialGlobConn(projectID, credentialID, sparkPatch)
+		_, err = d.client.UpdateSparkCredentialGlobConn(ctx, projectID, credentialID, sparkPatch)
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating Apache Spark credential", err.Error())
 			return
@@ -1,6 +1,7 @@
 package spark_credential_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -250,7 +251,7 @@ func testAccCheckDbtCloudSparkCredentialExists(resource string) resource.TestChe
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetSparkCredential(projectId, credentialId)
+		_, err = apiClient.GetSparkCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -276,7 +277,7 @@ func testAccCheckDbtCloudSparkCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetSparkCredential(projectId, credentialId)
+		_, err = apiClient.GetSparkCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Apache Spark credential still exists")
 		}
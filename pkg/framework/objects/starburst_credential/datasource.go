@@ -84,7 +84,7 @@ func (d *starburstCredentialDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetStarburstCredential(projectID, credentialID)
+	credential, err := d.client.GetStarburstCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Starburst credential",
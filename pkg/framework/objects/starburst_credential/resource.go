@@ -148,7 +148,7 @@ func (r *starburstCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetStarburstCredential(projectID, credentialID)
+	credential, err := r.client.GetStarburstCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "starburst credential") {
 			return
@@ -238,6 +238,7 @@ func (r *starburstCredentialResource) Update(
 
 	// Update credential
 	_, err = r.client.UpdateStarburstCredential(
+		ctx,
 		projectID,
 		credentialID,
 		updateCredential,
@@ -277,6 +278,7 @@ func (r *starburstCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
@@ -332,7 +334,7 @@ func (r *starburstCredentialResource) ImportState(
 		return
 	}
 
-	credentialResponse, err := r.client.GetStarburstCredential(projectID, credentialID)
+	credentialResponse, err := r.client.GetStarburstCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting starburst credential", err.Error())
 		return
@@ -1,6 +1,7 @@
 package starburst_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -129,7 +130,7 @@ func testAccCheckDbtCloudStarburstCredentialExists(resource string) resource.Tes
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetStarburstCredential(projectID, credentialID)
+		_, err = apiClient.GetStarburstCredential(context.Background(), projectID, credentialID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -262,7 +263,7 @@ func testAccCheckDbtCloudStarburstCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetStarburstCredential(projectID, credentialID)
+		_, err = apiClient.GetStarburstCredential(context.Background(), projectID, credentialID)
 		if err == nil {
 			return fmt.Errorf("Starburst credential still exists")
 		}
@@ -84,7 +84,7 @@ func (d *synapseCredentialDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetSynapseCredential(projectID, credentialID)
+	credential, err := d.client.GetSynapseCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Synapse credential",
@@ -115,6 +115,7 @@ func (r *synapseCredentialResource) Create(
 
 	// Create new credential
 	credential, err := r.client.CreateSynapseCredential(
+		ctx,
 		projectID,
 		authentication,
 		user,
@@ -163,7 +164,7 @@ func (r *synapseCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetSynapseCredential(projectID, credentialID)
+	credential, err := r.client.GetSynapseCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "synapse credential") {
 			return
@@ -270,6 +271,7 @@ func (r *synapseCredentialResource) Update(
 
 	// Update credential
 	_, err = r.client.UpdateSynapseCredential(
+		ctx,
 		projectID,
 		credentialID,
 		updateCredential,
@@ -309,6 +311,7 @@ func (r *synapseCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
@@ -365,7 +368,7 @@ func (r *synapseCredentialResource) ImportState(
 	}
 
 	// Get credential details from API
-	credential, err := r.client.GetSynapseCredential(projectID, credentialID)
+	credential, err := r.client.GetSynapseCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting synapse credential", err.Error())
 		return
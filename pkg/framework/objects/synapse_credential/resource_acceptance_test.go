@@ -1,6 +1,7 @@
 package synapse_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -392,7 +393,7 @@ func testAccCheckDbtCloudSynapseCredentialExists(resource string) resource.TestC
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetSynapseCredential(projectId, credentialId)
+		_, err = apiClient.GetSynapseCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -418,7 +419,7 @@ func testAccCheckDbtCloudSynapseCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetSynapseCredential(projectId, credentialId)
+		_, err = apiClient.GetSynapseCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Synapse credential still exists")
 		}
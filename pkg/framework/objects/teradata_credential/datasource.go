@@ -84,7 +84,7 @@ func (d *teradataCredentialDataSource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := d.client.GetTeradataCredential(projectID, credentialID)
+	credential, err := d.client.GetTeradataCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Teradata credential",
@@ -148,7 +148,7 @@ func (r *teradataCredentialResource) Read(
 	projectID := int(state.ProjectID.ValueInt64())
 	credentialID := int(state.CredentialID.ValueInt64())
 
-	credential, err := r.client.GetTeradataCredential(projectID, credentialID)
+	credential, err := r.client.GetTeradataCredential(ctx, projectID, credentialID)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "teradata credential") {
 			return
@@ -237,6 +237,7 @@ func (r *teradataCredentialResource) Update(
 
 	// Update credential
 	_, err = r.client.UpdateTeradataCredential(
+		ctx,
 		projectID,
 		credentialID,
 		updateCredential,
@@ -276,6 +277,7 @@ func (r *teradataCredentialResource) Delete(
 	credentialID := int(state.CredentialID.ValueInt64())
 
 	_, err := r.client.DeleteCredential(
+		ctx,
 		strconv.Itoa(credentialID),
 		strconv.Itoa(projectID),
 	)
@@ -331,7 +333,7 @@ func (r *teradataCredentialResource) ImportState(
 		return
 	}
 
-	credentialResponse, err := r.client.GetTeradataCredential(projectID, credentialID)
+	credentialResponse, err := r.client.GetTeradataCredential(ctx, projectID, credentialID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting teradata credential", err.Error())
 		return
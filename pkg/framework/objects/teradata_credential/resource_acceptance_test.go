@@ -1,6 +1,7 @@
 package teradata_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -119,7 +120,7 @@ func testAccCheckDbtCloudTeradataCredentialExists(resource string) resource.Test
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetTeradataCredential(projectID, credentialID)
+		_, err = apiClient.GetTeradataCredential(context.Background(), projectID, credentialID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -220,7 +221,7 @@ func testAccCheckDbtCloudTeradataCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetTeradataCredential(projectID, credentialID)
+		_, err = apiClient.GetTeradataCredential(context.Background(), projectID, credentialID)
 		if err == nil {
 			return fmt.Errorf("Teradata credential still exists")
 		}
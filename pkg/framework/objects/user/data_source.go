@@ -6,7 +6,6 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
@@ -39,7 +38,7 @@ func (d *userDataSource) Read(
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	user, err := d.client.GetUser(string(state.Email.ValueString()))
+	user, err := d.client.GetUser(ctx, string(state.Email.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Did not find user with email: %s", state.Email.ValueString()),
@@ -48,10 +47,21 @@ func (d *userDataSource) Read(
 		return
 	}
 
-	state.ID = types.Int64Value(int64(user.ID))
-	state.Email = types.StringValue(user.Email)
+	groups, err := d.client.GetAllGroups(ctx, "", "", "")
+	if err != nil {
+		resp.Diagnostics.AddWarning(userGroupsWarning(err))
+		groups = nil
+	} else if groups == nil {
+		groups = []dbt_cloud.Group{}
+	}
+
+	state, diags := newUserDataSourceModel(ctx, *user, d.client.AccountID, groups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
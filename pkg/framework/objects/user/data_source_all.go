@@ -2,10 +2,11 @@ package user
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
@@ -38,7 +39,7 @@ func (d *usersDataSource) Read(
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	users, err := d.client.GetUsers()
+	users, err := d.client.GetUsers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error retrieving users",
@@ -47,11 +48,33 @@ func (d *usersDataSource) Read(
 		return
 	}
 
+	groups, err := d.client.GetAllGroups(ctx, "", "", "")
+	if err != nil {
+		// the groups are only required to filter on the permission sets
+		if !state.PermissionSet.IsNull() {
+			resp.Diagnostics.AddError(
+				"Error retrieving the groups of the users",
+				"The groups are required to filter the users on permission_set: "+err.Error(),
+			)
+			return
+		}
+		resp.Diagnostics.AddWarning(userGroupsWarning(err))
+		groups = nil
+	} else if groups == nil {
+		groups = []dbt_cloud.Group{}
+	}
+
+	now := time.Now()
+	state.Users = []userDataSourceModel{}
 	for _, user := range users {
-		state.Users = append(state.Users, userDataSourceModel{
-			ID:    types.Int64Value(int64(user.ID)),
-			Email: types.StringValue(user.Email),
-		})
+		userModel, diags := newUserDataSourceModel(ctx, user, d.client.AccountID, groups)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if matchesUsersFilters(state, user, userModel, now) {
+			state.Users = append(state.Users, userModel)
+		}
 	}
 
 	diags := resp.State.Set(ctx, &state)
@@ -61,6 +84,36 @@ func (d *usersDataSource) Read(
 	}
 }
 
+// matchesUsersFilters reports whether the user matches all the filters set
+func matchesUsersFilters(
+	filters usersDataSourceModel,
+	user dbt_cloud.User,
+	userModel userDataSourceModel,
+	now time.Time,
+) bool {
+	if !filters.LicenseType.IsNull() && !filters.LicenseType.Equal(userModel.LicenseType) {
+		return false
+	}
+	if !filters.IsActive.IsNull() && !filters.IsActive.Equal(userModel.IsActive) {
+		return false
+	}
+	if !filters.SSOManaged.IsNull() && !filters.SSOManaged.Equal(userModel.SSOManaged) {
+		return false
+	}
+	if !filters.PermissionSet.IsNull() &&
+		!slices.ContainsFunc(userModel.PermissionSets.Elements(), filters.PermissionSet.Equal) {
+		return false
+	}
+	if !filters.NotLoggedInForDays.IsNull() {
+		lastLogin, ok := user.LastLoginTime()
+		cutoff := now.AddDate(0, 0, -int(filters.NotLoggedInForDays.ValueInt64()))
+		if ok && lastLogin.After(cutoff) {
+			return false
+		}
+	}
+	return true
+}
+
 func (d *usersDataSource) Configure(
 	_ context.Context,
 	req datasource.ConfigureRequest,
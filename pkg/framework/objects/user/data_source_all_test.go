@@ -0,0 +1,131 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMatchesUsersFilters(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	recentLogin := "2026-10-01T09:00:00+00:00"
+	oldLogin := "2026-05-01T09:00:00+00:00"
+	groupID := 10
+	groups := []dbt_cloud.Group{{
+		ID:          &groupID,
+		Permissions: []dbt_cloud.GroupPermission{{Set: "developer", ProjectID: 100}},
+	}}
+
+	newUser := func(licenseType string, lastLogin *string, groupIDs ...int) dbt_cloud.User {
+		permission := dbt_cloud.Permission{AccountID: 1, LicenseType: licenseType, State: dbt_cloud.STATE_ACTIVE}
+		for _, id := range groupIDs {
+			permission.Groups = append(permission.Groups, dbt_cloud.Group{ID: &id})
+		}
+		return dbt_cloud.User{
+			ID:          1,
+			Email:       "ada@example.com",
+			IsActive:    true,
+			LastLogin:   lastLogin,
+			Permissions: []dbt_cloud.Permission{permission},
+		}
+	}
+	noFilters := usersDataSourceModel{
+		LicenseType:        types.StringNull(),
+		IsActive:           types.BoolNull(),
+		SSOManaged:         types.BoolNull(),
+		PermissionSet:      types.StringNull(),
+		NotLoggedInForDays: types.Int64Null(),
+	}
+	withFilter := func(set func(*usersDataSourceModel)) usersDataSourceModel {
+		filters := noFilters
+		set(&filters)
+		return filters
+	}
+	idleDevelopers := withFilter(func(f *usersDataSourceModel) {
+		f.LicenseType = types.StringValue("developer")
+		f.NotLoggedInForDays = types.Int64Value(90)
+	})
+
+	tests := []struct {
+		name     string
+		filters  usersDataSourceModel
+		user     dbt_cloud.User
+		expected bool
+	}{
+		{name: "no filters", filters: noFilters, user: newUser("developer", &recentLogin), expected: true},
+		{name: "idle developer", filters: idleDevelopers, user: newUser("developer", &oldLogin), expected: true},
+		{name: "developer who never logged in", filters: idleDevelopers, user: newUser("developer", nil), expected: true},
+		{name: "recently active developer", filters: idleDevelopers, user: newUser("developer", &recentLogin), expected: false},
+		{name: "idle read only user", filters: idleDevelopers, user: newUser("read_only", &oldLogin), expected: false},
+		{
+			name:     "inactive users",
+			filters:  withFilter(func(f *usersDataSourceModel) { f.IsActive = types.BoolValue(false) }),
+			user:     newUser("developer", &recentLogin),
+			expected: false,
+		},
+		{
+			name:     "users managed by SSO",
+			filters:  withFilter(func(f *usersDataSourceModel) { f.SSOManaged = types.BoolValue(true) }),
+			user:     newUser("developer", &recentLogin),
+			expected: false,
+		},
+		{
+			name:     "permission set of a group",
+			filters:  withFilter(func(f *usersDataSourceModel) { f.PermissionSet = types.StringValue("developer") }),
+			user:     newUser("read_only", &recentLogin, groupID),
+			expected: true,
+		},
+		{
+			name:     "permission set without group",
+			filters:  withFilter(func(f *usersDataSourceModel) { f.PermissionSet = types.StringValue("developer") }),
+			user:     newUser("developer", &recentLogin),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userModel, diags := newUserDataSourceModel(context.Background(), tt.user, 1, groups)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := matchesUsersFilters(tt.filters, tt.user, userModel, now); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewUserDataSourceModelWithoutGroups(t *testing.T) {
+	user := dbt_cloud.User{
+		ID:    1,
+		Email: "ada@example.com",
+		Permissions: []dbt_cloud.Permission{
+			{AccountID: 1, LicenseType: "developer", State: dbt_cloud.STATE_ACTIVE},
+		},
+	}
+
+	userModel, diags := newUserDataSourceModel(context.Background(), user, 1, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if userModel.LicenseType.ValueString() != "developer" {
+		t.Errorf("expected the license type of the user, got %s", userModel.LicenseType)
+	}
+	if !userModel.PermissionSets.IsNull() || !userModel.AllProjects.IsNull() || !userModel.ProjectIDs.IsNull() {
+		t.Errorf(
+			"expected a null access without groups, got %s, %s and %s",
+			userModel.PermissionSets,
+			userModel.AllProjects,
+			userModel.ProjectIDs,
+		)
+	}
+
+	userModel, _ = newUserDataSourceModel(context.Background(), user, 1, []dbt_cloud.Group{})
+	if userModel.PermissionSets.IsNull() || userModel.AllProjects.ValueBool() {
+		t.Errorf("expected an empty access without group, got %s and %s", userModel.PermissionSets, userModel.AllProjects)
+	}
+}
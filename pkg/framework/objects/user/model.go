@@ -1,14 +1,84 @@
 package user
 
 import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type userDataSourceModel struct {
-	ID    types.Int64  `tfsdk:"id"`
-	Email types.String `tfsdk:"email"`
+	ID             types.Int64  `tfsdk:"id"`
+	Email          types.String `tfsdk:"email"`
+	FirstName      types.String `tfsdk:"first_name"`
+	LastName       types.String `tfsdk:"last_name"`
+	LicenseType    types.String `tfsdk:"license_type"`
+	IsActive       types.Bool   `tfsdk:"is_active"`
+	SSOManaged     types.Bool   `tfsdk:"sso_managed"`
+	LastLogin      types.String `tfsdk:"last_login"`
+	PermissionSets types.Set    `tfsdk:"permission_sets"`
+	AllProjects    types.Bool   `tfsdk:"all_projects"`
+	ProjectIDs     types.Set    `tfsdk:"project_ids"`
 }
 
 type usersDataSourceModel struct {
-	Users []userDataSourceModel `tfsdk:"users"`
+	LicenseType        types.String          `tfsdk:"license_type"`
+	IsActive           types.Bool            `tfsdk:"is_active"`
+	SSOManaged         types.Bool            `tfsdk:"sso_managed"`
+	PermissionSet      types.String          `tfsdk:"permission_set"`
+	NotLoggedInForDays types.Int64           `tfsdk:"not_logged_in_for_days"`
+	Users              []userDataSourceModel `tfsdk:"users"`
+}
+
+// newUserDataSourceModel returns the model of the user in the account, with
+// the access they get from the groups of the account. groups is nil when the
+// groups couldn't be retrieved, the access of the user is then null.
+func newUserDataSourceModel(
+	ctx context.Context,
+	user dbt_cloud.User,
+	accountID int64,
+	groups []dbt_cloud.Group,
+) (userDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := userDataSourceModel{
+		ID:             types.Int64Value(int64(user.ID)),
+		Email:          types.StringValue(user.Email),
+		FirstName:      types.StringValue(user.FirstName),
+		LastName:       types.StringValue(user.LastName),
+		LicenseType:    types.StringNull(),
+		IsActive:       types.BoolValue(user.IsActive),
+		SSOManaged:     types.BoolValue(user.IsSSOManaged),
+		LastLogin:      types.StringNull(),
+		PermissionSets: types.SetNull(types.StringType),
+		AllProjects:    types.BoolNull(),
+		ProjectIDs:     types.SetNull(types.Int64Type),
+	}
+	if permission := user.AccountPermission(accountID); permission != nil {
+		model.LicenseType = types.StringValue(permission.LicenseType)
+	}
+	if _, ok := user.LastLoginTime(); ok {
+		model.LastLogin = types.StringPointerValue(user.LastLogin)
+	}
+	if groups == nil {
+		return model, diags
+	}
+
+	access := user.EffectiveAccess(accountID, groups)
+	model.AllProjects = types.BoolValue(access.AllProjects)
+	var setDiags diag.Diagnostics
+	model.PermissionSets, setDiags = types.SetValueFrom(ctx, types.StringType, access.PermissionSets)
+	diags.Append(setDiags...)
+	model.ProjectIDs, setDiags = types.SetValueFrom(ctx, types.Int64Type, access.ProjectIDs)
+	diags.Append(setDiags...)
+
+	return model, diags
+}
+
+// userGroupsWarning is the warning returned when the groups of the account
+// can't be retrieved, e.g. with a token without access to them
+func userGroupsWarning(err error) (string, string) {
+	return "Unable to retrieve the groups of the account",
+		"permission_sets, all_projects and project_ids are null as they are computed from the permissions of the groups of the users: " + err.Error()
 }
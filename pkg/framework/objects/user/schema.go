@@ -2,29 +2,82 @@ package user
 
 import (
 	"context"
+	"maps"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// userDetailsAttributes are the attributes describing a user, besides their
+// ID and email
+func userDetailsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"first_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "First name of the user",
+		},
+		"last_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Last name of the user",
+		},
+		"license_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "License type of the user in the account, e.g. `developer`, `read_only`, `analyst` or `it`. Null when the user is not an active member of the account",
+		},
+		"is_active": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user account is active",
+		},
+		"sso_managed": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the user is managed by the SSO or SCIM identity provider of the account",
+		},
+		"last_login": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the user last logged in, as returned by dbt Cloud. Null when the user never logged in",
+		},
+		"permission_sets": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The permission sets the user gets from the permissions of their groups. Null when the groups of the account can't be retrieved",
+		},
+		"all_projects": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether one of the permissions of the groups of the user applies to all projects. Null when the groups of the account can't be retrieved",
+		},
+		"project_ids": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: "The IDs of the projects the permissions of the groups of the user apply to, besides the ones for all projects. Null when the groups of the account can't be retrieved",
+		},
+	}
+}
+
 func (d *userDataSource) Schema(
 	_ context.Context,
 	_ datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
-	resp.Schema = schema.Schema{
-		Description: "Retrieve user details",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Computed:    true,
-				Description: "ID of the user",
-			},
-			"email": schema.StringAttribute{
-				Required:    true,
-				Description: "Email for the user",
-			},
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "ID of the user",
+		},
+		"email": schema.StringAttribute{
+			Required:    true,
+			Description: "Email for the user",
 		},
 	}
+	maps.Copy(attributes, userDetailsAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Retrieve user details, with their license type and the access they get from their groups",
+		Attributes:  attributes,
+	}
 }
 
 func (d *usersDataSource) Schema(
@@ -32,23 +85,53 @@ func (d *usersDataSource) Schema(
 	_ datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
+	userAttributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "ID of the user",
+		},
+		"email": schema.StringAttribute{
+			Computed:    true,
+			Description: "Email for the user",
+		},
+	}
+	maps.Copy(userAttributes, userDetailsAttributes())
+
 	resp.Schema = schema.Schema{
-		Description: "Retrieve all users",
+		Description: helper.DocString(
+			`Retrieve all users, optionally filtered by their license type, status, last login or permission sets.
+
+			The filters are combined, e.g. setting ~~~license_type~~~ to ~~~developer~~~ and ~~~not_logged_in_for_days~~~ to ~~~90~~~ returns the developers who didn't log in for 90 days.`,
+		),
 		Attributes: map[string]schema.Attribute{
+			"license_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the users with this license type in the account",
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return the active users when `true`, or the inactive ones when `false`",
+			},
+			"sso_managed": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return the users managed by SSO or SCIM when `true`, or the other ones when `false`",
+			},
+			"permission_set": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the users getting this permission set from their groups, e.g. `developer` or `account_admin`",
+			},
+			"not_logged_in_for_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return the users who didn't log in for this number of days, including the ones who never logged in",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"users": schema.SetNestedAttribute{
 				Computed:    true,
 				Description: "Set of users with their internal ID end email",
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.Int64Attribute{
-							Computed:    true,
-							Description: "ID of the user",
-						},
-						"email": schema.StringAttribute{
-							Computed:    true,
-							Description: "Email for the user",
-						},
-					},
+					Attributes: userAttributes,
 				},
 			},
 		},
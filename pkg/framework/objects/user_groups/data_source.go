@@ -65,7 +65,7 @@ func (d *userGroupDataSource) Read(
 	}
 
 	userID := data.UserID.ValueInt64()
-	retrievedUserGroups, err := d.client.GetUserGroups(int(userID))
+	retrievedUserGroups, err := d.client.GetUserGroups(ctx, int(userID))
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "user groups") {
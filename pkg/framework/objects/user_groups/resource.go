@@ -65,7 +65,7 @@ func (u *userGroupsResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	groupsAssigned, err := u.client.AssignUserGroups(int(userID), groupIDs)
+	groupsAssigned, err := u.client.AssignUserGroups(ctx, int(userID), groupIDs)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error assigning user groups",
@@ -118,7 +118,7 @@ func (u *userGroupsResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	userID := state.UserID.ValueInt64()
-	retrievedUserGroups, err := u.client.GetUserGroups(int(userID))
+	retrievedUserGroups, err := u.client.GetUserGroups(ctx, int(userID))
 
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "user groups") {
@@ -176,7 +176,7 @@ func (u *userGroupsResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 
-		groupsAssigned, err := u.client.AssignUserGroups(int(userID), groupIDs)
+		groupsAssigned, err := u.client.AssignUserGroups(ctx, int(userID), groupIDs)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error assigning user to groups",
@@ -0,0 +1,16 @@
+package user_invite
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserInviteResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Email                   types.String `tfsdk:"email"`
+	LicenseType             types.String `tfsdk:"license_type"`
+	GroupIDs                types.Set    `tfsdk:"group_ids"`
+	ResendTrigger           types.String `tfsdk:"resend_trigger"`
+	DeactivateUserOnDestroy types.Bool   `tfsdk:"deactivate_user_on_destroy"`
+	Status                  types.String `tfsdk:"status"`
+	UserID                  types.Int64  `tfsdk:"user_id"`
+}
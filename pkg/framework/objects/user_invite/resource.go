@@ -0,0 +1,324 @@
+package user_invite
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &userInviteResource{}
+	_ resource.ResourceWithConfigure   = &userInviteResource{}
+	_ resource.ResourceWithImportState = &userInviteResource{}
+	_ resource.ResourceWithModifyPlan  = &userInviteResource{}
+
+	licenseTypes = []string{
+		"developer",
+		"read_only",
+		"analyst",
+		"it",
+	}
+)
+
+func UserInviteResource() resource.Resource {
+	return &userInviteResource{}
+}
+
+type userInviteResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *userInviteResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_user_invite"
+}
+
+func (r *userInviteResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resourceSchema
+}
+
+func (r *userInviteResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dbt_cloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *dbt_cloud.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan replaces pending invitations when their license type or groups
+// change, as invitations can't be edited, and keeps the status and the user of
+// the invitation otherwise
+func (r *userInviteResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state UserInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Email.Equal(state.Email) {
+		// replaced by the plan modifier of email
+		return
+	}
+
+	if state.Status.ValueString() != dbt_cloud.INVITE_STATUS_ACCEPTED {
+		for _, attribute := range []struct {
+			name    string
+			changed bool
+		}{
+			{name: "license_type", changed: !plan.LicenseType.Equal(state.LicenseType)},
+			{name: "group_ids", changed: !plan.GroupIDs.Equal(state.GroupIDs)},
+		} {
+			if attribute.changed {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root(attribute.name))
+			}
+		}
+		if len(resp.RequiresReplace) > 0 {
+			return
+		}
+	} else if !plan.GroupIDs.Equal(state.GroupIDs) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("group_ids"),
+			"The groups of the invitation won't be updated",
+			fmt.Sprintf(
+				"The invitation was accepted by the user %d, changing group_ids doesn't change their groups anymore. Use dbtcloud_user_groups to manage them.",
+				state.UserID.ValueInt64(),
+			),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), state.Status)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_id"), state.UserID)...)
+}
+
+func (r *userInviteResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state UserInviteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inviteID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid invitation ID", err.Error())
+		return
+	}
+
+	invite, err := r.client.GetUserInvite(ctx, inviteID)
+	if err != nil {
+		// dbt Cloud can remove the invitations once accepted, the membership of
+		// the user is then checked with the user ID
+		if !dbt_cloud.IsNotFound(err) || state.UserID.IsNull() {
+			if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "user invite") {
+				return
+			}
+			resp.Diagnostics.AddError("Error getting the user invite", err.Error())
+			return
+		}
+		state.Status = types.StringValue(dbt_cloud.INVITE_STATUS_ACCEPTED)
+	} else {
+		state.Email = types.StringValue(invite.Email)
+		state.Status = types.StringValue(invite.Status)
+		state.UserID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(invite.UserID))
+		if invite.Status != dbt_cloud.INVITE_STATUS_ACCEPTED {
+			state.LicenseType = types.StringValue(invite.LicenseType)
+			if len(invite.GroupIDs) > 0 || !state.GroupIDs.IsNull() {
+				var diags diag.Diagnostics
+				state.GroupIDs, diags = types.SetValueFrom(ctx, types.Int64Type, invite.GroupIDs)
+				resp.Diagnostics.Append(diags...)
+			}
+		}
+	}
+
+	if !state.UserID.IsNull() {
+		permission, err := r.client.GetUserPermission(ctx, int(state.UserID.ValueInt64()))
+		if err != nil {
+			// the user was deactivated outside of Terraform, they are invited again
+			if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "user invite") {
+				return
+			}
+			resp.Diagnostics.AddError("Error getting the user of the invite", err.Error())
+			return
+		}
+		state.LicenseType = types.StringValue(permission.LicenseType)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *userInviteResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan UserInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var groupIDs []int
+	resp.Diagnostics.Append(plan.GroupIDs.ElementsAs(ctx, &groupIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invite, err := r.client.CreateUserInvite(
+		ctx,
+		plan.Email.ValueString(),
+		plan.LicenseType.ValueString(),
+		groupIDs,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to invite the user",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(*invite.ID))
+	plan.Status = types.StringValue(invite.Status)
+	plan.UserID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(invite.UserID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userInviteResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state UserInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() == dbt_cloud.INVITE_STATUS_ACCEPTED {
+		if !plan.LicenseType.Equal(state.LicenseType) {
+			err := r.client.UpdateUserLicenseType(
+				ctx,
+				int(state.UserID.ValueInt64()),
+				plan.LicenseType.ValueString(),
+			)
+			if err != nil {
+				resp.Diagnostics.AddError("Error updating the license type of the user", err.Error())
+				return
+			}
+		}
+	} else if !plan.ResendTrigger.Equal(state.ResendTrigger) {
+		inviteID, err := strconv.Atoi(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid invitation ID", err.Error())
+			return
+		}
+		if _, err := r.client.ResendUserInvite(ctx, inviteID); err != nil {
+			resp.Diagnostics.AddError("Error resending the user invite", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userInviteResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state UserInviteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inviteID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid invitation ID", err.Error())
+		return
+	}
+
+	// the invitation might have been accepted since the last refresh
+	userID := state.UserID
+	invite, err := r.client.GetUserInvite(ctx, inviteID)
+	switch {
+	case err != nil && !dbt_cloud.IsNotFound(err):
+		resp.Diagnostics.AddError("Error getting the user invite", err.Error())
+		return
+	case err == nil && invite.Status != dbt_cloud.INVITE_STATUS_ACCEPTED:
+		if err := r.client.RevokeUserInvite(ctx, inviteID); err != nil && !dbt_cloud.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error revoking the user invite", err.Error())
+		}
+		return
+	case err == nil:
+		userID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(invite.UserID))
+	}
+
+	if userID.IsNull() {
+		return
+	}
+	if !state.DeactivateUserOnDestroy.ValueBool() {
+		tflog.Info(ctx, "Keeping the user who accepted the invitation in the account", map[string]any{
+			"user_id": userID.ValueInt64(),
+		})
+		return
+	}
+	if err := r.client.DeactivateUser(ctx, int(userID.ValueInt64())); err != nil {
+		resp.Diagnostics.AddError("Error deactivating the user", err.Error())
+		return
+	}
+}
+
+func (r *userInviteResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deactivate_user_on_destroy"), true)...)
+}
@@ -0,0 +1,126 @@
+package user_invite_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud/fakeapi"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestUserInviteResourceFakeAPI follows an invitation from its creation to
+// the deactivation of the user who accepted it, against the in-memory fake of
+// the dbt Cloud API.
+func TestUserInviteResourceFakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	group := server.Seed(fakeapi.Groups, fakeapi.Object{"name": "Contractors"})
+	groupID := fmt.Sprint(group["id"])
+
+	checkInvite := func(check func(invite fakeapi.Object) error) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			invites := server.List(fakeapi.Invites)
+			if len(invites) != 1 {
+				return fmt.Errorf("expected 1 invite, got %v", invites)
+			}
+			return check(invites[0])
+		}
+	}
+	var userID any
+	checkLicense := func(licenseType string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			permissions := server.List(fakeapi.UserPermissions)
+			if len(permissions) != 1 || permissions[0]["license_type"] != licenseType {
+				return fmt.Errorf("expected a %s license for the user, got %v", licenseType, permissions)
+			}
+			return nil
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if permissions := server.List(fakeapi.UserPermissions); len(permissions) != 0 {
+				return fmt.Errorf("expected the user to be deactivated, got %v", permissions)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testUserInviteConfig("developer", groupID, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_user_invite.test", "status", "pending"),
+					resource.TestCheckNoResourceAttr("dbtcloud_user_invite.test", "user_id"),
+					resource.TestCheckResourceAttr("dbtcloud_user_invite.test", "deactivate_user_on_destroy", "true"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testUserInviteConfig("developer", groupID, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dbtcloud_user_invite.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: checkInvite(func(invite fakeapi.Object) error {
+					if fmt.Sprint(invite["sent_count"]) != "2" {
+						return fmt.Errorf("expected the invite to be resent, got %v", invite)
+					}
+					return nil
+				}),
+			},
+			{
+				// pending invitations can't be edited
+				Config: server.ProviderConfig() + testUserInviteConfig("read_only", groupID, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dbtcloud_user_invite.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: checkInvite(func(invite fakeapi.Object) error {
+					if invite["license_type"] != "read_only" {
+						return fmt.Errorf("expected a new read_only invite, got %v", invite)
+					}
+					return nil
+				}),
+			},
+			{
+				PreConfig: func() {
+					invite := server.List(fakeapi.Invites)[0]
+					userID = server.AcceptInvite(invite["id"])["id"]
+				},
+				Config: server.ProviderConfig() + testUserInviteConfig("developer", groupID, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dbtcloud_user_invite.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("dbtcloud_user_invite.test", "status", "accepted"),
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("dbtcloud_user_invite.test", "user_id", fmt.Sprint(userID))(s)
+					},
+					checkLicense("developer"),
+				),
+			},
+			{
+				ResourceName:            "dbtcloud_user_invite.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group_ids", "resend_trigger"},
+			},
+		},
+	})
+}
+
+func testUserInviteConfig(licenseType, groupID, resendTrigger string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_user_invite" "test" {
+  email          = "contractor@example.com"
+  license_type   = %q
+  group_ids      = [%s]
+  resend_trigger = %q
+}
+`, licenseType, groupID, resendTrigger)
+}
@@ -0,0 +1,66 @@
+package user_invite
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var resourceSchema = schema.Schema{
+	Description: helper.DocString(
+		`Invite a user to the dbt Cloud account by email, with a license type and the groups they get once they accept the invitation.
+
+		While the invitation is pending, changing ~~~license_type~~~ or ~~~group_ids~~~ revokes it and sends a new one, and destroying the resource revokes it. Once accepted, the resource manages the membership of the user: ~~~license_type~~~ is updated in place and, unless ~~~deactivate_user_on_destroy~~~ is set to ~~~false~~~, destroying the resource deactivates the user in the account.`,
+	),
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the invitation",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"email": schema.StringAttribute{
+			Required:    true,
+			Description: "The email address to send the invitation to",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"license_type": schema.StringAttribute{
+			Required:    true,
+			Description: "The license type of the user, one of `developer`, `read_only`, `analyst` and `it`",
+			Validators: []validator.String{
+				stringvalidator.OneOf(licenseTypes...),
+			},
+		},
+		"group_ids": schema.SetAttribute{
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Description: "The IDs of the groups the user is added to when accepting the invitation. Changes made after the invitation is accepted are ignored, use `dbtcloud_user_groups` to manage the groups of the user afterwards",
+		},
+		"resend_trigger": schema.StringAttribute{
+			Optional:    true,
+			Description: "An arbitrary value, changing it sends the invitation email again while the invitation is pending",
+		},
+		"deactivate_user_on_destroy": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Whether destroying the resource deactivates the user once the invitation is accepted - Default `true`. When `false`, the user stays a member of the account",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the invitation, `pending` or `accepted`",
+		},
+		"user_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the user, set once the invitation is accepted",
+		},
+	},
+}
@@ -41,7 +41,7 @@ func (d *webhookDataSource) Read(
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	webhook, err := d.client.GetWebhook(state.WebhookID.ValueString())
+	webhook, err := d.client.GetWebhook(ctx, state.WebhookID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Did not find webhook with id: %s", state.WebhookID.ValueString()),
@@ -94,7 +94,7 @@ func (r *webhookResource) Read(
 	}
 	webhookId := state.ID.ValueString()
 
-	retrievedWebhook, err := r.client.GetWebhook(webhookId)
+	retrievedWebhook, err := r.client.GetWebhook(ctx, webhookId)
 	if err != nil {
 		if helper.HandleResourceNotFound(ctx, err, &resp.Diagnostics, &resp.State, "webhook") {
 			return
@@ -144,6 +144,7 @@ func (r *webhookResource) Create(
 	nonTypedEventTypes := helper.TypesListStringToStringSlice(eventTypes)
 
 	createdWebhook, err := r.client.CreateWebhook(
+		ctx,
 		webhookId,
 		name,
 		description,
@@ -219,7 +220,7 @@ func (r *webhookResource) Update(
 
 	if nameChanged || descriptionChanged || clientUrlChanged || eventTypesChanged || jobIdsChanged || activeChanged {
 		var webhookId = state.WebhookID.ValueString()
-		retrievedWebhook, err := r.client.GetWebhook(webhookId)
+		retrievedWebhook, err := r.client.GetWebhook(ctx, webhookId)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Issue getting webhook",
@@ -238,7 +239,7 @@ func (r *webhookResource) Update(
 			Active:      helper.TernaryOperator(activeChanged, plan.Active.ValueBool(), retrievedWebhook.Active),
 		}
 
-		_, err = r.client.UpdateWebhook(webhookId, updateWebhook)
+		_, err = r.client.UpdateWebhook(ctx, webhookId, updateWebhook)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -248,7 +249,7 @@ func (r *webhookResource) Update(
 			return
 		}
 
-		postUpdateRetrievedWebhook, err := r.client.GetWebhook(webhookId)
+		postUpdateRetrievedWebhook, err := r.client.GetWebhook(ctx, webhookId)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Issue getting webhook post update",
@@ -324,7 +325,7 @@ func (r *webhookResource) Delete(
 		return
 	}
 
-	_, err := r.client.DeleteWebhook(state.WebhookID.ValueString())
+	_, err := r.client.DeleteWebhook(ctx, state.WebhookID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting webhook",
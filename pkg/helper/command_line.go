@@ -0,0 +1,63 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SplitCommandLine splits a command like `dbt run --select "tag:daily model_a"`
+// into its arguments the way a POSIX shell would, handling single quotes,
+// double quotes and backslash escapes. Variables and globs are not expanded.
+func SplitCommandLine(line string) ([]string, error) {
+	args := []string{}
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, char := range line {
+		switch {
+		case escaped:
+			current.WriteRune(char)
+			escaped = false
+		case quote == '\'':
+			if char == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(char)
+			}
+		case char == '\\':
+			escaped = true
+			inArg = true
+		case quote == '"':
+			if char == '"' {
+				quote = 0
+			} else {
+				current.WriteRune(char)
+			}
+		case char == '\'' || char == '"':
+			quote = char
+			inArg = true
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(char)
+			inArg = true
+		}
+	}
+
+	if escaped {
+		return nil, fmt.Errorf("the command ends with an unfinished escape: %s", line)
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("the command has an unclosed %c quote: %s", quote, line)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
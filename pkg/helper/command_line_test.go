@@ -0,0 +1,78 @@
+package helper
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitCommandLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		line        string
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:     "simple command",
+			line:     "dbt run --select my_model",
+			expected: []string{"dbt", "run", "--select", "my_model"},
+		},
+		{
+			name:     "extra spaces",
+			line:     "  dbt   build\t-s  tag:daily ",
+			expected: []string{"dbt", "build", "-s", "tag:daily"},
+		},
+		{
+			name:     "double quotes",
+			line:     `dbt run --select "tag:daily model_a"`,
+			expected: []string{"dbt", "run", "--select", "tag:daily model_a"},
+		},
+		{
+			name:     "single quotes keep backslashes",
+			line:     `dbt run --vars '{"key": "a\b"}'`,
+			expected: []string{"dbt", "run", "--vars", `{"key": "a\b"}`},
+		},
+		{
+			name:     "escaped quote",
+			line:     `dbt run --vars "{\"key\": 1}"`,
+			expected: []string{"dbt", "run", "--vars", `{"key": 1}`},
+		},
+		{
+			name:     "empty quoted argument",
+			line:     `dbt run --target ""`,
+			expected: []string{"dbt", "run", "--target", ""},
+		},
+		{
+			name:        "unclosed quote",
+			line:        `dbt run --select "my_model`,
+			expectError: true,
+		},
+		{
+			name:        "unfinished escape",
+			line:        `dbt run \`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args, err := SplitCommandLine(tc.line)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got %q", args)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(args, tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, args)
+			}
+		})
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
@@ -15,6 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// DefaultOperationTimeout is the time given to the create, read, update and
+// delete operations of the resources supporting a `timeouts` block when the
+// block doesn't set it.
+const DefaultOperationTimeout = 20 * time.Minute
+
 func EmptySetDefault(elemType attr.Type) defaults.Set {
 	return setdefault.StaticValue(
 		types.SetValueMust(
@@ -184,12 +191,12 @@ func ConvertStringPointer(s *string) types.String {
 	return types.StringNull()
 }
 
-// HandleResourceNotFound checks if err is a "resource-not-found" error.
+// HandleResourceNotFound checks if err is a dbt_cloud.NotFoundError.
 // If so, it adds a warning diagnostic, removes the resource from state, and returns true.
 // The caller should return immediately when this returns true.
 // resourceName is used in the warning message, e.g. "project", "webhook".
 func HandleResourceNotFound(ctx context.Context, err error, diagnostics *diag.Diagnostics, state *tfsdk.State, resourceName string) bool {
-	if strings.HasPrefix(err.Error(), "resource-not-found") {
+	if dbt_cloud.IsNotFound(err) {
 		diagnostics.AddWarning(
 			"Resource not found",
 			fmt.Sprintf("The %s was not found and has been removed from the state.", resourceName),
@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// IPAddressValidator returns a validator that ensures the value is an IPv4 or
+// IPv6 address, without a prefix length.
+func IPAddressValidator() validator.String {
+	return ipAddressValidator{}
+}
+
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v ipAddressValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be an IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := netip.ParseAddr(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("%q is not an IPv4 or IPv6 address: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,44 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIPAddressValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		val         types.String
+		expectError bool
+	}{
+		{name: "ipv4", val: types.StringValue("203.0.113.10")},
+		{name: "ipv6", val: types.StringValue("2001:db8::1")},
+		{name: "null", val: types.StringNull()},
+		{name: "unknown", val: types.StringUnknown()},
+		{name: "cidr", val: types.StringValue("203.0.113.0/24"), expectError: true},
+		{name: "hostname", val: types.StringValue("example.com"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("egress_ip"),
+				ConfigValue: tc.val,
+			}
+			resp := &validator.StringResponse{}
+			IPAddressValidator().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type jsonPathStepKind int
+
+const (
+	jsonPathKey jsonPathStepKind = iota
+	jsonPathIndex
+	jsonPathWildcard
+)
+
+type jsonPathStep struct {
+	kind  jsonPathStepKind
+	key   string
+	index int
+}
+
+// ExtractJSONPath returns the part of a JSON document selected by a path
+// using a subset of the JSONPath syntax:
+//   - `.key` or `["key"]` to select a key of an object, the bracket notation
+//     is required for keys containing dots, like the unique IDs of dbt nodes
+//   - `[0]` to select an element of an array
+//   - `.*` or `[*]` to select all the elements of an array or all the values
+//     of an object, ordered by key
+//
+// The path can start with `$`. Once a wildcard has been used, the result is a
+// list and the elements not matching the rest of the path are skipped.
+func ExtractJSONPath(document []byte, path string) (any, error) {
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	var root any
+	if err := decoder.Decode(&root); err != nil {
+		return nil, fmt.Errorf("the document is not valid JSON: %w", err)
+	}
+
+	values := []any{root}
+	isList := false
+	for _, step := range steps {
+		next := []any{}
+		for _, value := range values {
+			selected, err := applyJSONPathStep(value, step)
+			if err != nil {
+				if isList {
+					// elements not matching the path are skipped after a wildcard
+					continue
+				}
+				return nil, err
+			}
+			next = append(next, selected...)
+		}
+		values = next
+		if step.kind == jsonPathWildcard {
+			isList = true
+		}
+	}
+
+	if isList {
+		return values, nil
+	}
+	return values[0], nil
+}
+
+func applyJSONPathStep(value any, step jsonPathStep) ([]any, error) {
+	switch step.kind {
+	case jsonPathKey:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("can't select the key %q of a value that is not an object", step.key)
+		}
+		selected, ok := object[step.key]
+		if !ok {
+			return nil, fmt.Errorf("the key %q doesn't exist", step.key)
+		}
+		return []any{selected}, nil
+
+	case jsonPathIndex:
+		array, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("can't select the index %d of a value that is not an array", step.index)
+		}
+		if step.index < 0 || step.index >= len(array) {
+			return nil, fmt.Errorf("the index %d is out of range, the array has %d elements", step.index, len(array))
+		}
+		return []any{array[step.index]}, nil
+
+	default:
+		switch typed := value.(type) {
+		case []any:
+			return typed, nil
+		case map[string]any:
+			keys := make([]string, 0, len(typed))
+			for key := range typed {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			selected := make([]any, 0, len(keys))
+			for _, key := range keys {
+				selected = append(selected, typed[key])
+			}
+			return selected, nil
+		default:
+			return nil, fmt.Errorf("can't use a wildcard on a value that is not an array or an object")
+		}
+	}
+}
+
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	remaining := strings.TrimPrefix(strings.TrimSpace(path), "$")
+	steps := []jsonPathStep{}
+
+	for remaining != "" {
+		switch remaining[0] {
+		case '.':
+			remaining = remaining[1:]
+			end := strings.IndexAny(remaining, ".[")
+			if end == -1 {
+				end = len(remaining)
+			}
+			key := remaining[:end]
+			if key == "" {
+				return nil, fmt.Errorf("invalid path %q: empty key", path)
+			}
+			if key == "*" {
+				steps = append(steps, jsonPathStep{kind: jsonPathWildcard})
+			} else {
+				steps = append(steps, jsonPathStep{kind: jsonPathKey, key: key})
+			}
+			remaining = remaining[end:]
+
+		case '[':
+			end := strings.Index(remaining, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid path %q: missing ]", path)
+			}
+			content := remaining[1:end]
+			// quoted keys can contain a ], look for the closing quote first
+			if len(content) > 0 && (content[0] == '"' || content[0] == '\'') {
+				closing := strings.IndexByte(remaining[2:], content[0])
+				if closing == -1 || len(remaining) < closing+4 || remaining[closing+3] != ']' {
+					return nil, fmt.Errorf("invalid path %q: unterminated quoted key", path)
+				}
+				steps = append(steps, jsonPathStep{kind: jsonPathKey, key: remaining[2 : closing+2]})
+				remaining = remaining[closing+4:]
+				continue
+			}
+
+			if content == "*" {
+				steps = append(steps, jsonPathStep{kind: jsonPathWildcard})
+			} else {
+				index, err := strconv.Atoi(content)
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: %q is not an index, keys need to be quoted", path, content)
+				}
+				steps = append(steps, jsonPathStep{kind: jsonPathIndex, index: index})
+			}
+			remaining = remaining[end+1:]
+
+		default:
+			// allow omitting the leading dot, e.g. "nodes.model"
+			if len(steps) == 0 {
+				remaining = "." + remaining
+				continue
+			}
+			return nil, fmt.Errorf("invalid path %q: unexpected character %q", path, remaining[0])
+		}
+	}
+
+	return steps, nil
+}
@@ -0,0 +1,101 @@
+package helper
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testManifest = `{
+  "metadata": {"dbt_version": "1.9.0", "project_name": "analytics"},
+  "nodes": {
+    "model.analytics.orders": {"name": "orders", "config": {"materialized": "table"}},
+    "model.analytics.customers": {"name": "customers", "config": {"materialized": "view"}},
+    "test.analytics.not_null": {"name": "not_null_orders_id"}
+  },
+  "results": [
+    {"unique_id": "model.analytics.orders", "status": "success", "execution_time": 1.5},
+    {"unique_id": "model.analytics.customers", "status": "error"}
+  ]
+}`
+
+func TestExtractJSONPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{path: "$", expected: testManifestCompact(t)},
+		{path: "$.metadata.dbt_version", expected: `"1.9.0"`},
+		{path: "metadata.project_name", expected: `"analytics"`},
+		{path: `$.nodes["model.analytics.orders"].config.materialized`, expected: `"table"`},
+		{path: `$.nodes['model.analytics.customers'].name`, expected: `"customers"`},
+		{path: "$.results[0].execution_time", expected: `1.5`},
+		{path: "$.results[*].status", expected: `["success","error"]`},
+		{path: "$.nodes.*.name", expected: `["customers","orders","not_null_orders_id"]`},
+		{path: "$.nodes[*].config.materialized", expected: `["view","table"]`},
+		{path: "$.results[*].missing", expected: `[]`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := ExtractJSONPath([]byte(testManifest), tc.path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := json.Marshal(value)
+			if err != nil {
+				t.Fatalf("encoding the result: %v", err)
+			}
+			if string(got) != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestExtractJSONPath_Errors(t *testing.T) {
+	t.Parallel()
+
+	paths := []string{
+		"$.missing",
+		"$.results[2]",
+		"$.results.status",
+		"$.metadata[0]",
+		"$.metadata.dbt_version.*",
+		"$.nodes[model.analytics.orders]",
+		`$.nodes["model.analytics.orders"`,
+		"$.results[0",
+		"$..nodes",
+	}
+
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := ExtractJSONPath([]byte(testManifest), path); err == nil {
+				t.Errorf("expected an error for the path %q", path)
+			}
+		})
+	}
+
+	if _, err := ExtractJSONPath([]byte("not json"), "$"); err == nil {
+		t.Error("expected an error for an invalid document")
+	}
+}
+
+func testManifestCompact(t *testing.T) string {
+	t.Helper()
+
+	var value any
+	if err := json.Unmarshal([]byte(testManifest), &value); err != nil {
+		t.Fatalf("decoding the manifest: %v", err)
+	}
+	compact, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("encoding the manifest: %v", err)
+	}
+	return string(compact)
+}
@@ -0,0 +1,101 @@
+package helper
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PopulateListResult fills the resource of a list result the same way
+// Terraform does when importing a resource: ImportState is called with
+// importID and Read is then called with the imported state.
+//
+// It returns false when the resource was removed from the state by Read,
+// e.g. because it got deleted between the listing and the read.
+func PopulateListResult(
+	ctx context.Context,
+	r resource.ResourceWithImportState,
+	importID string,
+	result *list.ListResult,
+) bool {
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: result.Resource.Schema,
+			Raw:    tftypes.NewValue(result.Resource.Schema.Type().TerraformType(ctx), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: result.Identity.Schema,
+			Raw:    result.Identity.Raw.Copy(),
+		},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: importID}, &importResp)
+	result.Diagnostics.Append(importResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return false
+	}
+
+	readResp := resource.ReadResponse{
+		State:    importResp.State,
+		Identity: importResp.Identity,
+	}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if result.Diagnostics.HasError() || readResp.State.Raw.IsNull() {
+		return false
+	}
+
+	result.Resource.Raw = readResp.State.Raw
+	if readResp.Identity != nil && !readResp.Identity.Raw.IsNull() {
+		result.Identity.Raw = readResp.Identity.Raw
+	}
+	return true
+}
+
+// ListResultItem describes how an element returned by the API maps to a
+// list result.
+type ListResultItem struct {
+	DisplayName string
+	ImportID    string
+	Identity    any
+}
+
+// ListResults returns the iterator pushing one result per item, respecting
+// the limit of the request and reading the full resource only when Terraform
+// asked for it.
+func ListResults[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	r resource.ResourceWithImportState,
+	items []T,
+	describe func(T) ListResultItem,
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var pushed int64
+		for _, item := range items {
+			if req.Limit > 0 && pushed >= req.Limit {
+				return
+			}
+
+			desc := describe(item)
+			result := req.NewListResult(ctx)
+			result.DisplayName = desc.DisplayName
+			result.Diagnostics.Append(result.Identity.Set(ctx, desc.Identity)...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				if !PopulateListResult(ctx, r, desc.ImportID, &result) && !result.Diagnostics.HasError() {
+					// the resource was deleted after being listed
+					continue
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+			pushed++
+		}
+	}
+}
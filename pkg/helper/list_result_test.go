@@ -0,0 +1,186 @@
+package helper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type fakeModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type fakeIdentityModel struct {
+	ID types.Int64 `tfsdk:"id"`
+}
+
+// fakeResource reads the names of its objects from a map, objects missing
+// from the map are considered deleted.
+type fakeResource struct {
+	names map[int64]string
+	reads int
+}
+
+func (r *fakeResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "fake"
+}
+
+func (r *fakeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fakeSchema
+}
+
+func (r *fakeResource) Create(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+}
+
+func (r *fakeResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *fakeResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *fakeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *fakeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.reads++
+
+	var state fakeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	name, ok := r.names[state.ID.ValueInt64()]
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Name = types.StringValue(name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, fakeIdentityModel{ID: state.ID})...)
+}
+
+var fakeSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":   schema.Int64Attribute{Computed: true},
+		"name": schema.StringAttribute{Computed: true},
+	},
+}
+
+var fakeIdentitySchema = identityschema.Schema{
+	Attributes: map[string]identityschema.Attribute{
+		"id": identityschema.Int64Attribute{RequiredForImport: true},
+	},
+}
+
+func TestListResults(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		listed          []int64
+		includeResource bool
+		limit           int64
+		expectedIDs     []int64
+		expectedNames   []string
+		expectedReads   int
+	}{
+		{
+			name:          "identities only",
+			listed:        []int64{1, 2, 3},
+			expectedIDs:   []int64{1, 2, 3},
+			expectedReads: 0,
+		},
+		{
+			name:            "with resources",
+			listed:          []int64{1, 2},
+			includeResource: true,
+			expectedIDs:     []int64{1, 2},
+			expectedNames:   []string{"one", "two"},
+			expectedReads:   2,
+		},
+		{
+			name:            "deleted after listing",
+			listed:          []int64{1, 4, 2},
+			includeResource: true,
+			expectedIDs:     []int64{1, 2},
+			expectedNames:   []string{"one", "two"},
+			expectedReads:   3,
+		},
+		{
+			name:          "limit",
+			listed:        []int64{1, 2, 3},
+			limit:         2,
+			expectedIDs:   []int64{1, 2},
+			expectedReads: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := &fakeResource{names: map[int64]string{1: "one", 2: "two", 3: "three"}}
+			req := list.ListRequest{
+				IncludeResource:        tc.includeResource,
+				Limit:                  tc.limit,
+				ResourceSchema:         fakeSchema,
+				ResourceIdentitySchema: fakeIdentitySchema,
+			}
+
+			results := ListResults(ctx, req, r, tc.listed, func(id int64) ListResultItem {
+				return ListResultItem{
+					DisplayName: strconv.FormatInt(id, 10),
+					ImportID:    strconv.FormatInt(id, 10),
+					Identity:    fakeIdentityModel{ID: types.Int64Value(id)},
+				}
+			})
+
+			var ids []int64
+			var names []string
+			for result := range results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", result.Diagnostics)
+				}
+
+				var identity fakeIdentityModel
+				if diags := result.Identity.Get(ctx, &identity); diags.HasError() {
+					t.Fatalf("unexpected error reading the identity: %v", diags)
+				}
+				ids = append(ids, identity.ID.ValueInt64())
+
+				if tc.includeResource {
+					var model fakeModel
+					if diags := result.Resource.Get(ctx, &model); diags.HasError() {
+						t.Fatalf("unexpected error reading the resource: %v", diags)
+					}
+					names = append(names, model.Name.ValueString())
+				}
+			}
+
+			if !slices.Equal(ids, tc.expectedIDs) {
+				t.Errorf("expected IDs %v, got %v", tc.expectedIDs, ids)
+			}
+			if !slices.Equal(names, tc.expectedNames) {
+				t.Errorf("expected names %v, got %v", tc.expectedNames, names)
+			}
+			if r.reads != tc.expectedReads {
+				t.Errorf("expected %d reads, got %d", tc.expectedReads, r.reads)
+			}
+		})
+	}
+}
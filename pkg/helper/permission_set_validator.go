@@ -0,0 +1,116 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PermissionSetValidator returns a validator for the permissions of groups and
+// service tokens, objects with permission_set, project_id, all_projects and
+// writable_environment_categories attributes. Based on the permission set
+// catalog, it warns when an account level permission set is set on a project,
+// and ensures that the project level ones are set on a project when not on all
+// projects and that only the permission sets supporting it restrict Write
+// access to some environment categories.
+func PermissionSetValidator() validator.Object {
+	return permissionSetValidator{}
+}
+
+// ServiceTokenPermissionSetValidator returns PermissionSetValidator also
+// warning about the permission sets only available on Enterprise plans: unlike
+// the permissions of groups, service tokens are available on all plans.
+func ServiceTokenPermissionSetValidator() validator.Object {
+	return permissionSetValidator{warnEnterprise: true}
+}
+
+type permissionSetValidator struct {
+	warnEnterprise bool
+}
+
+func (v permissionSetValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v permissionSetValidator) MarkdownDescription(_ context.Context) string {
+	return "`project_id` and `writable_environment_categories` must be supported by the `permission_set`"
+}
+
+func (v permissionSetValidator) ValidateObject(
+	_ context.Context,
+	req validator.ObjectRequest,
+	resp *validator.ObjectResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	name, _ := attributes["permission_set"].(types.String)
+	if name.IsNull() || name.IsUnknown() {
+		return
+	}
+	// unknown permission sets are reported by the validator of permission_set
+	permissionSet, ok := dbt_cloud.LookupPermissionSet(name.ValueString())
+	if !ok {
+		return
+	}
+
+	if v.warnEnterprise && permissionSet.Enterprise {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path.AtName("permission_set"),
+			"Enterprise Permission Set",
+			fmt.Sprintf(
+				"The permission set `%s` is only available on dbt Cloud Enterprise plans, it is rejected by the API on the other plans.",
+				permissionSet.Name,
+			),
+		)
+	}
+
+	projectID, _ := attributes["project_id"].(types.Int64)
+	allProjects, _ := attributes["all_projects"].(types.Bool)
+	if !permissionSet.ProjectScoped && !projectID.IsNull() && !projectID.IsUnknown() {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path.AtName("project_id"),
+			"Account Level Permission Set",
+			fmt.Sprintf(
+				"The permission set `%s` applies to the whole account, setting it on a project doesn't restrict it. Remove `project_id` and set `all_projects` to true.",
+				permissionSet.Name,
+			),
+		)
+	}
+	if permissionSet.ProjectScoped && projectID.IsNull() &&
+		!allProjects.IsNull() && !allProjects.IsUnknown() && !allProjects.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("project_id"),
+			"Missing Attribute Configuration",
+			fmt.Sprintf(
+				"`project_id` must be set for the permission set `%s` when `all_projects` is false.",
+				permissionSet.Name,
+			),
+		)
+	}
+
+	writableEnvironmentCategories, _ := attributes["writable_environment_categories"].(types.Set)
+	if permissionSet.WritableEnvironmentCategories ||
+		writableEnvironmentCategories.IsNull() || writableEnvironmentCategories.IsUnknown() {
+		return
+	}
+	// no categories or all of them is the same as not restricting Write access
+	for _, category := range writableEnvironmentCategories.Elements() {
+		if !category.Equal(types.StringValue(dbt_cloud.EnvironmentCategory_All)) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("writable_environment_categories"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf(
+					"The permission set `%s` doesn't support environment level write settings, `writable_environment_categories` can only be empty or `[\"all\"]`.",
+					permissionSet.Name,
+				),
+			)
+			return
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPermissionSetValidator(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"permission_set":                  types.StringType,
+		"project_id":                      types.Int64Type,
+		"all_projects":                    types.BoolType,
+		"writable_environment_categories": types.SetType{ElemType: types.StringType},
+	}
+	permission := func(permissionSet string, projectID types.Int64, allProjects bool, categories ...string) types.Object {
+		writableEnvironmentCategories := types.SetNull(types.StringType)
+		if categories != nil {
+			elements := []attr.Value{}
+			for _, category := range categories {
+				elements = append(elements, types.StringValue(category))
+			}
+			writableEnvironmentCategories = types.SetValueMust(types.StringType, elements)
+		}
+		return types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"permission_set":                  types.StringValue(permissionSet),
+			"project_id":                      projectID,
+			"all_projects":                    types.BoolValue(allProjects),
+			"writable_environment_categories": writableEnvironmentCategories,
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		val           types.Object
+		serviceToken  bool
+		expectError   bool
+		expectWarning bool
+	}{
+		{name: "null", val: types.ObjectNull(attributeTypes)},
+		{name: "account level", val: permission("account_admin", types.Int64Null(), true)},
+		{name: "project level", val: permission("job_admin", types.Int64Value(1), false)},
+		{name: "project level unknown project", val: permission("job_admin", types.Int64Unknown(), false)},
+		{name: "project level on all projects", val: permission("developer", types.Int64Null(), true)},
+		{name: "unknown permission set", val: permission("superuser", types.Int64Value(1), false, "production")},
+		{name: "writable categories", val: permission("developer", types.Int64Value(1), false, "development", "staging")},
+		{name: "writable categories not supported but all", val: permission("job_admin", types.Int64Value(1), false, "all")},
+		{name: "writable categories not supported but empty", val: permission("job_admin", types.Int64Value(1), false, []string{}...)},
+		{name: "account level on a project", val: permission("member", types.Int64Value(1), false), expectWarning: true},
+		{name: "project level without project", val: permission("job_runner", types.Int64Null(), false), expectError: true},
+		{name: "writable categories not supported", val: permission("job_admin", types.Int64Value(1), false, "production"), expectError: true},
+		{name: "enterprise on a group", val: permission("developer", types.Int64Value(1), false)},
+		{name: "enterprise on a service token", val: permission("developer", types.Int64Value(1), false), serviceToken: true, expectWarning: true},
+		{name: "all plans on a service token", val: permission("job_admin", types.Int64Value(1), false), serviceToken: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.ObjectRequest{
+				Path:        path.Root("group_permissions"),
+				ConfigValue: tc.val,
+			}
+			resp := &validator.ObjectResponse{}
+			permissionSetValidator := PermissionSetValidator()
+			if tc.serviceToken {
+				permissionSetValidator = ServiceTokenPermissionSetValidator()
+			}
+			permissionSetValidator.ValidateObject(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got %v", tc.expectError, resp.Diagnostics)
+			}
+			if (resp.Diagnostics.WarningsCount() > 0) != tc.expectWarning {
+				t.Errorf("expected warning %t, got %v", tc.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
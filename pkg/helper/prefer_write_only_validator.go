@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
@@ -52,8 +54,16 @@ func (v PreferWriteOnlyAttributeValidator) ValidateString(ctx context.Context, r
 
 	writeOnlyPath := req.Path.ParentPath().AtName(v.WriteOnlyAttributeName)
 
-	resp.Diagnostics.AddAttributeWarning(
-		req.Path,
+	AddPreferWriteOnlyAttributeWarning(&resp.Diagnostics, req.Path, writeOnlyPath)
+}
+
+// AddPreferWriteOnlyAttributeWarning adds the warning of
+// PreferWriteOnlyAttributeValidator. It is used directly by the resources
+// recommending the write-only attribute only for some values, like the
+// environment variables which are secrets.
+func AddPreferWriteOnlyAttributeWarning(diags *diag.Diagnostics, attributePath, writeOnlyPath path.Path) {
+	diags.AddAttributeWarning(
+		attributePath,
 		"Available Write-Only Attribute Alternative",
 		fmt.Sprintf(
 			"This attribute has a WriteOnly version %s available. "+
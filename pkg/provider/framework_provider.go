@@ -8,10 +8,12 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/environment_variable"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/environment_variable_job_override"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/environment_variables"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/extended_attributes"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group_users"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/partial_environment_variable"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/privatelink_endpoint"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/run_artifact"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/runs"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/salesforce_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/semantic_layer_configuration"
@@ -21,8 +23,11 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/synapse_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/teradata_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/user_groups"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/user_invite"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/functions"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/account_features"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/athena_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/azure_dev_ops_project"
@@ -34,15 +39,22 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/environment"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/fabric_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/connection_ssh_tunnel"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group_partial_permissions"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/ip_restrictions_rule"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job_run"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job_completion_trigger"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job_plan_check"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/effective_permissions"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/permission_sets"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job_graph"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/license_map"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/lineage_integration"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/model_notifications"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/notification"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/notification_rule"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/notification_setting"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/auth_provider"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/oauth_configuration"
@@ -67,9 +79,14 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/user"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/webhook"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -80,7 +97,11 @@ import (
 )
 
 var (
-	_ provider.Provider = &dbtCloudProvider{}
+	_ provider.Provider                       = &dbtCloudProvider{}
+	_ provider.ProviderWithEphemeralResources = &dbtCloudProvider{}
+	_ provider.ProviderWithFunctions          = &dbtCloudProvider{}
+	_ provider.ProviderWithListResources      = &dbtCloudProvider{}
+	_ provider.ProviderWithActions            = &dbtCloudProvider{}
 )
 
 func New() provider.Provider {
@@ -119,7 +140,7 @@ func (p *dbtCloudProvider) Schema(
 			},
 			"retry_interval_seconds": schema.Int64Attribute{
 				Optional:    true,
-				Description: "The number of seconds to wait before retrying a request that failed due to rate limiting. Defaults to 10 seconds.",
+				Description: "The number of seconds to wait before retrying a request that failed due to rate limiting, doubled after each retry with some random jitter. The wait requested by the API through the `Retry-After` header takes precedence. Defaults to 10 seconds.",
 			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
@@ -150,20 +171,36 @@ func (p *dbtCloudProvider) Schema(
 				Optional:    true,
 				Description: "The timeout duration in seconds for HTTP requests to the dbt Cloud API. Defaults to 30 seconds.",
 			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum number of requests per second sent to the dbt Cloud API, shared by all the resources regardless of Terraform's parallelism. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_MAX_REQUESTS_PER_SECOND`. Defaults to no limit, the provider still waits when the API reports that its rate limit has been reached.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"egress_ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "The public IP address Terraform reaches dbt Cloud from, used by `dbtcloud_ip_restrictions_rule` to check that enabling the IP restrictions doesn't lock Terraform out of the account. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_EGRESS_IP`. Detected when not set.",
+				Validators: []validator.String{
+					helper.IPAddressValidator(),
+				},
+			},
 		},
 	}
 }
 
 type dbtCloudProviderModel struct {
-	Token                     types.String `tfsdk:"token"`
-	AccountID                 types.Int64  `tfsdk:"account_id"`
-	HostURL                   types.String `tfsdk:"host_url"`
-	MaxRetries                types.Int64  `tfsdk:"max_retries"`
-	RetryIntervalSeconds      types.Int64  `tfsdk:"retry_interval_seconds"`
-	DisableRetry              types.Bool   `tfsdk:"disable_retry"`
-	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
-	RetriableStatusCodes      types.List   `tfsdk:"retriable_status_codes"`
-	TimeoutSeconds            types.Int64  `tfsdk:"timeout_seconds"`
+	Token                     types.String  `tfsdk:"token"`
+	AccountID                 types.Int64   `tfsdk:"account_id"`
+	HostURL                   types.String  `tfsdk:"host_url"`
+	MaxRetries                types.Int64   `tfsdk:"max_retries"`
+	RetryIntervalSeconds      types.Int64   `tfsdk:"retry_interval_seconds"`
+	DisableRetry              types.Bool    `tfsdk:"disable_retry"`
+	SkipCredentialsValidation types.Bool    `tfsdk:"skip_credentials_validation"`
+	RetriableStatusCodes      types.List    `tfsdk:"retriable_status_codes"`
+	TimeoutSeconds            types.Int64   `tfsdk:"timeout_seconds"`
+	MaxRequestsPerSecond      types.Float64 `tfsdk:"max_requests_per_second"`
+	EgressIP                  types.String  `tfsdk:"egress_ip"`
 }
 
 func (p *dbtCloudProvider) Configure(
@@ -287,7 +324,12 @@ func (p *dbtCloudProvider) Configure(
 		timeoutSeconds = int(config.TimeoutSeconds.ValueInt64())
 	}
 
-	client, err := dbt_cloud.NewClient(&accountID, &token, &hostURL, &maxRetries, &retryIntervalSeconds, retriableStatusCodes, skipCredentialsValidation, &timeoutSeconds)
+	maxRequestsPerSecond, _ := strconv.ParseFloat(os.Getenv("DBT_CLOUD_MAX_REQUESTS_PER_SECOND"), 64)
+	if !config.MaxRequestsPerSecond.IsNull() {
+		maxRequestsPerSecond = config.MaxRequestsPerSecond.ValueFloat64()
+	}
+
+	client, err := dbt_cloud.NewClient(ctx, &accountID, &token, &hostURL, &maxRetries, &retryIntervalSeconds, retriableStatusCodes, skipCredentialsValidation, &timeoutSeconds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create dbt Cloud API Client",
@@ -297,9 +339,18 @@ func (p *dbtCloudProvider) Configure(
 		)
 		return
 	}
+	client.SetMaxRequestsPerSecond(maxRequestsPerSecond)
+
+	client.EgressIP = os.Getenv("DBT_CLOUD_EGRESS_IP")
+	if !config.EgressIP.IsNull() {
+		client.EgressIP = config.EgressIP.ValueString()
+	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
+	resp.ActionData = client
 
 	tflog.Info(ctx, "Configured dbt Cloud client", map[string]any{"success": true})
 }
@@ -317,6 +368,10 @@ func (p *dbtCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		group.GroupsDataSource,
 		job.JobDataSource,
 		job.JobsDataSource,
+		job_plan_check.JobPlanCheckDataSource,
+		job_graph.JobGraphDataSource,
+		effective_permissions.EffectivePermissionsDataSource,
+		permission_sets.PermissionSetsDataSource,
 		model_notifications.ModelNotificationsDataSource,
 		notification.NotificationDataSource,
 		project.ProjectsDataSource,
@@ -343,8 +398,10 @@ func (p *dbtCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		profile.ProfilesDataSource,
 		group_users.GroupUsersDataSource,
 		runs.RunsDataSource,
+		run_artifact.RunArtifactDataSource,
 		synapse_credential.SynapseCredentialDataSource,
 		salesforce_credential.SalesforceCredentialDataSource,
+		ip_restrictions_rule.IPRestrictionsRulesDataSourceAll,
 	}
 }
 
@@ -356,6 +413,7 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		azure_ad_application.AzureADApplicationResource,
 		connection_catalog_config.ConnectionCatalogConfigResource,
 		global_connection.GlobalConnectionResource,
+		connection_ssh_tunnel.ConnectionSSHTunnelResource,
 		group_partial_permissions.GroupPartialPermissionsResource,
 		group.GroupResource,
 		ip_restrictions_rule.IPRestrictionsRuleResource,
@@ -363,6 +421,7 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		lineage_integration.LineageIntegrationResource,
 		model_notifications.ModelNotificationsResource,
 		notification.NotificationResource,
+		notification_rule.NotificationRuleResource,
 		notification_setting.NotificationSettingResource,
 		oauth_configuration.OAuthConfigurationResource,
 		openai_integration.OpenAIIntegrationResource,
@@ -385,6 +444,7 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		postgres_credential.PostgresCredentialResource,
 		fabric_credential.FabricCredentialResource,
 		user_groups.UserGroupsResource,
+		user_invite.UserInviteResource,
 		webhook.WebhookResource,
 		databricks_credential.DatabricksCredentialResource,
 		environment.EnvironmentResource,
@@ -396,6 +456,7 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		job_completion_trigger.JobCompletionTriggerResource,
 		project_repository.ProjectRepositoryResource,
 		environment_variable.EnvironmentVariableResource,
+		environment_variables.EnvironmentVariablesResource,
 		environment_variable_job_override.EnvironmentVariableJobOverrideResource,
 		project.ProjectResource,
 		semantic_layer_configuration.SemanticLayerConfigurationResource,
@@ -409,3 +470,35 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		salesforce_credential.SalesforceCredentialResource,
 	}
 }
+
+func (p *dbtCloudProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		service_token.ServiceTokenEphemeralResource,
+	}
+}
+
+func (p *dbtCloudProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		environment.EnvironmentListResource,
+		global_connection.GlobalConnectionListResource,
+		group.GroupListResource,
+		job.JobListResource,
+		project.ProjectListResource,
+	}
+}
+
+func (p *dbtCloudProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		job_run.JobRunAction,
+	}
+}
+
+func (p *dbtCloudProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.SplitIDFunction,
+		functions.SplitNumericIDFunction,
+		functions.JoinIDFunction,
+		functions.JobScheduleCronFunction,
+		functions.ParseJobScheduleFunction,
+	}
+}